@@ -1,6 +1,8 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"log"
 	"waste-space/internal/app"
 )
@@ -11,11 +13,21 @@ import (
 // @host localhost:8080
 // @BasePath /
 func main() {
+	seed := flag.Bool("seed", false, "load demo data and exit (requires SEED_ENABLED=true)")
+	flag.Parse()
+
 	application, err := app.New()
 	if err != nil {
 		log.Fatalf("Failed to initialize app: %v", err)
 	}
 
+	if *seed {
+		if err := application.Seed(context.Background()); err != nil {
+			log.Fatalf("Failed to seed data: %v", err)
+		}
+		return
+	}
+
 	if err := application.Run(); err != nil {
 		log.Fatalf("Failed to run app: %v", err)
 	}
@@ -13,11 +13,20 @@ import (
 	"waste-space/internal/config"
 	"waste-space/internal/controller/v1"
 	"waste-space/internal/middleware"
+	"waste-space/internal/model"
 	"waste-space/internal/service"
 	"waste-space/internal/storage/cache"
 	"waste-space/internal/storage/repository"
+	"waste-space/internal/worker"
 	"waste-space/pkg/auth"
 	"waste-space/pkg/db"
+	"waste-space/pkg/geo"
+	"waste-space/pkg/impact"
+	"waste-space/pkg/money"
+	"waste-space/pkg/objectstore"
+	"waste-space/pkg/payment"
+	"waste-space/pkg/seed"
+	"waste-space/pkg/tax"
 
 	"github.com/gin-gonic/gin"
 	"github.com/pressly/goose/v3"
@@ -26,8 +35,11 @@ import (
 )
 
 type App struct {
-	server *http.Server
-	db     *gorm.DB
+	server    *http.Server
+	db        *gorm.DB
+	replica   *gorm.DB
+	scheduler *worker.Scheduler
+	seeder    *seed.Loader
 }
 
 func New() (*App, error) {
@@ -36,6 +48,107 @@ func New() (*App, error) {
 		return nil, fmt.Errorf("failed to load config: %w", err)
 	}
 
+	if !repository.IsValidDumpsterSort(cfg.Dumpster.DefaultSort) {
+		return nil, fmt.Errorf("invalid DUMPSTER_DEFAULT_SORT %q", cfg.Dumpster.DefaultSort)
+	}
+
+	if cfg.Dumpster.RecentlyViewedLimit <= 0 {
+		return nil, fmt.Errorf("DUMPSTER_RECENTLY_VIEWED_LIMIT must be positive, got %d", cfg.Dumpster.RecentlyViewedLimit)
+	}
+
+	if cfg.Dumpster.FlagThreshold <= 0 {
+		return nil, fmt.Errorf("DUMPSTER_FLAG_THRESHOLD must be positive, got %d", cfg.Dumpster.FlagThreshold)
+	}
+
+	if cfg.Dumpster.FeaturedLimit <= 0 {
+		return nil, fmt.Errorf("DUMPSTER_FEATURED_LIMIT must be positive, got %d", cfg.Dumpster.FeaturedLimit)
+	}
+
+	if cfg.Server.MaxPageOffset < 0 {
+		return nil, fmt.Errorf("PAGINATION_MAX_OFFSET must not be negative, got %d", cfg.Server.MaxPageOffset)
+	}
+
+	if cfg.Booking.HoldTTL <= 0 {
+		return nil, fmt.Errorf("BOOKING_HOLD_TTL must be positive, got %s", cfg.Booking.HoldTTL)
+	}
+
+	if cfg.Dumpster.AttentionMinRating < 0 || cfg.Dumpster.AttentionNegativeReviews < 0 ||
+		cfg.Dumpster.AttentionOpenFlags < 0 || cfg.Dumpster.AttentionInactivity < 0 {
+		return nil, fmt.Errorf("DUMPSTER_ATTENTION_* thresholds must not be negative")
+	}
+
+	sizeGuide := make(map[string]string, len(cfg.Dumpster.SizeGuide))
+	for project, size := range cfg.Dumpster.SizeGuide {
+		key := service.NormalizeSizeGuideProject(project)
+		if key == "" || !model.DumpsterSize(size).IsValid() {
+			return nil, fmt.Errorf("invalid DUMPSTER_SIZE_GUIDE entry %q:%q", project, size)
+		}
+		sizeGuide[key] = size
+	}
+
+	if cfg.Review.LowRatingThreshold < 0 || cfg.Review.LowRatingThreshold > 5 {
+		return nil, fmt.Errorf("REVIEW_LOW_RATING_THRESHOLD must be between 0 and 5, got %d", cfg.Review.LowRatingThreshold)
+	}
+
+	if cfg.Review.LowRatingMinComment < 0 {
+		return nil, fmt.Errorf("REVIEW_LOW_RATING_MIN_COMMENT must not be negative, got %d", cfg.Review.LowRatingMinComment)
+	}
+
+	for size, rate := range cfg.Impact.DiversionRates {
+		if !model.DumpsterSize(size).IsValid() || rate < 0 || rate > 1 {
+			return nil, fmt.Errorf("invalid IMPACT_DIVERSION_RATES entry %q:%v", size, rate)
+		}
+	}
+
+	if cfg.Impact.DefaultDiversionRate < 0 || cfg.Impact.DefaultDiversionRate > 1 {
+		return nil, fmt.Errorf("IMPACT_DEFAULT_DIVERSION_RATE must be between 0 and 1, got %v", cfg.Impact.DefaultDiversionRate)
+	}
+
+	if cfg.Statement.PlatformFeeRate < 0 || cfg.Statement.PlatformFeeRate > 1 {
+		return nil, fmt.Errorf("STATEMENT_PLATFORM_FEE_RATE must be between 0 and 1, got %v", cfg.Statement.PlatformFeeRate)
+	}
+
+	if cfg.Impact.CO2ePerKg < 0 {
+		return nil, fmt.Errorf("IMPACT_CO2E_PER_KG must not be negative, got %v", cfg.Impact.CO2ePerKg)
+	}
+
+	if cfg.Dumpster.CacheTTL < 0 || cfg.Dumpster.NegativeCacheTTL < 0 {
+		return nil, fmt.Errorf("DUMPSTER_CACHE_TTL and DUMPSTER_CACHE_NEGATIVE_TTL must not be negative")
+	}
+
+	if cfg.Dumpster.AttentionNegativeReviews > 0 && cfg.Dumpster.AttentionNegativeWindow <= 0 {
+		return nil, fmt.Errorf("DUMPSTER_ATTENTION_NEGATIVE_WINDOW must be positive when DUMPSTER_ATTENTION_NEGATIVE_REVIEWS is set")
+	}
+
+	if err := money.SetCurrency(cfg.Money.Currency); err != nil {
+		return nil, fmt.Errorf("invalid CURRENCY: %w", err)
+	}
+
+	if err := money.SetLocale(cfg.Money.Locale); err != nil {
+		return nil, fmt.Errorf("invalid MONEY_LOCALE: %w", err)
+	}
+
+	if cfg.Review.EditWindow < 0 {
+		return nil, fmt.Errorf("REVIEW_EDIT_WINDOW must not be negative, got %s", cfg.Review.EditWindow)
+	}
+
+	if cfg.RateLimit.Window <= 0 {
+		return nil, fmt.Errorf("RATE_LIMIT_WINDOW must be positive, got %s", cfg.RateLimit.Window)
+	}
+
+	if cfg.Lockout.Threshold < 0 {
+		return nil, fmt.Errorf("LOGIN_LOCKOUT_THRESHOLD must not be negative, got %d", cfg.Lockout.Threshold)
+	}
+
+	if cfg.Lockout.Threshold > 0 && (cfg.Lockout.Window <= 0 || cfg.Lockout.Cooldown <= 0) {
+		return nil, fmt.Errorf("LOGIN_LOCKOUT_WINDOW and LOGIN_LOCKOUT_COOLDOWN must be positive when lockout is enabled")
+	}
+
+	ownershipPolicy := service.OwnershipPolicy(cfg.Authz.OwnershipPolicy)
+	if !ownershipPolicy.IsValid() {
+		return nil, fmt.Errorf("invalid OWNERSHIP_POLICY %q", cfg.Authz.OwnershipPolicy)
+	}
+
 	database, err := db.NewPostgres(db.Config{
 		Host:     cfg.Database.Host,
 		Port:     cfg.Database.Port,
@@ -48,6 +161,14 @@ func New() (*App, error) {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 
+	var replica *gorm.DB
+	if cfg.Database.ReplicaDSN != "" {
+		replica, err = db.NewPostgresDSN(cfg.Database.ReplicaDSN)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to read replica: %w", err)
+		}
+	}
+
 	sqlDB, err := database.DB()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get sql.DB: %w", err)
@@ -73,34 +194,208 @@ func New() (*App, error) {
 	}
 
 	router := gin.New()
+	if err := router.SetTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+		return nil, fmt.Errorf("failed to set trusted proxies: %w", err)
+	}
 	router.Use(gin.Recovery())
-	router.Use(middleware.Logger())
+	router.Use(middleware.Logger(middleware.LoggerConfig{
+		LogBodies:    cfg.Logging.LogBodies,
+		RedactFields: cfg.Logging.RedactFields,
+	}))
+	router.Use(middleware.Timeout(cfg.Server.RequestTimeout, cfg.Server.RequestTimeoutOverrides))
+	router.Use(middleware.ReadConsistency())
 
 	tokenService := auth.NewJWTService(cfg.JWT.Secret)
 	tokenCache := cache.NewTokenCache(redisClient)
-	userRepo := repository.NewUserRepository(database)
-	userService := service.NewUserService(userRepo, tokenService, tokenCache, logger)
-	dumpsterRepo := repository.NewDumpsterRepository(database)
-	dumpsterService := service.NewDumpsterService(dumpsterRepo, logger)
-	reviewRepo := repository.NewReviewRepository(database)
-	reviewService := service.NewReviewService(reviewRepo, dumpsterRepo, logger)
-	usageRepo := repository.NewUsageRepository(database)
-	usageService := service.NewUsageService(usageRepo, dumpsterRepo, logger)
-
-	handler := v1.NewHandler(userService, dumpsterService, reviewService, usageService, tokenService)
+	geocoder := geo.NewNopGeocoder()
+	if cfg.Geocoder.URL != "" {
+		geocoder = geo.NewNominatimGeocoder(cfg.Geocoder.URL, cfg.Geocoder.UserAgent, cfg.Geocoder.Timeout)
+	}
+	userRepo := repository.NewUserRepository(database, repository.UserRepositoryConfig{
+		HardDelete: cfg.Deletion.HardDeleteUsers,
+	})
+	dumpsterRepo := repository.NewDumpsterRepository(database, replica, repository.DumpsterRepositoryConfig{
+		AvailabilityTracksUsage: cfg.Dumpster.AvailabilityTracksUsage,
+		DefaultSort:             cfg.Dumpster.DefaultSort,
+		HardDelete:              cfg.Deletion.HardDeleteDumpsters,
+		MaxOffset:               cfg.Server.MaxPageOffset,
+	})
+	usageRepo := repository.NewUsageRepository(database, repository.UsageRepositoryConfig{
+		HardDelete: cfg.Deletion.HardDeleteUsages,
+		MaxOffset:  cfg.Server.MaxPageOffset,
+	})
+	bookingRepo := repository.NewBookingRepository(database)
+	reviewRepo := repository.NewReviewRepository(database, repository.ReviewRepositoryConfig{
+		HardDelete: cfg.Deletion.HardDeleteReviews,
+		MaxOffset:  cfg.Server.MaxPageOffset,
+	})
+	priceChangeRepo := repository.NewPriceChangeRepository(database)
+	recentlyViewedCache := cache.NewRecentlyViewedCache(redisClient)
+	bookingHoldCache := cache.NewBookingHoldCache(redisClient)
+	dumpsterCache := cache.NewDumpsterCache(redisClient)
+	taxCalc := tax.NewTableCalculator(cfg.Tax.Rates, cfg.Tax.DefaultRate)
+	impactEstimator := impact.NewTableEstimator(cfg.Impact.DiversionRates, cfg.Impact.DefaultDiversionRate, cfg.Impact.CO2ePerKg)
+	routeEstimator := geo.NewStraightLineEstimator()
+	ownership := service.NewOwnershipGuard(ownershipPolicy)
+	notificationRepo := repository.NewNotificationRepository(database)
+	notificationPreferenceRepo := repository.NewNotificationPreferenceRepository(database)
+	notificationService := service.NewNotificationService(notificationRepo, notificationPreferenceRepo, logger)
+	alertRepo := repository.NewAvailabilityAlertRepository(database)
+	alertService := service.NewAvailabilityAlertService(alertRepo, dumpsterRepo, notificationService, logger)
+	flagRepo := repository.NewDumpsterFlagRepository(database)
+	revisionRepo := repository.NewDumpsterRevisionRepository(database)
+	blockRepo := repository.NewOwnerBlockRepository(database)
+	pricingRuleRepo := repository.NewPricingRuleRepository(database)
+	pricingRuleService := service.NewPricingRuleService(pricingRuleRepo, dumpsterRepo, ownership, logger)
+	dumpsterService := service.NewDumpsterService(dumpsterRepo, usageRepo, bookingRepo, reviewRepo, priceChangeRepo, flagRepo, revisionRepo, blockRepo, recentlyViewedCache, bookingHoldCache, dumpsterCache, alertService, notificationService, pricingRuleService, taxCalc, routeEstimator, geocoder, ownership, service.DumpsterServiceConfig{
+		AvailabilityTracksUsage: cfg.Dumpster.AvailabilityTracksUsage,
+		RecentlyViewedLimit:     cfg.Dumpster.RecentlyViewedLimit,
+		FlagThreshold:           cfg.Dumpster.FlagThreshold,
+		FeaturedLimit:           cfg.Dumpster.FeaturedLimit,
+		HoldTTL:                 cfg.Booking.HoldTTL,
+		CacheTTL:                cfg.Dumpster.CacheTTL,
+		NegativeCacheTTL:        cfg.Dumpster.NegativeCacheTTL,
+		SizeGuide:               sizeGuide,
+		Attention: service.AttentionThresholds{
+			MinRating:            cfg.Dumpster.AttentionMinRating,
+			NegativeReviews:      cfg.Dumpster.AttentionNegativeReviews,
+			NegativeReviewWindow: cfg.Dumpster.AttentionNegativeWindow,
+			OpenFlags:            cfg.Dumpster.AttentionOpenFlags,
+			Inactivity:           cfg.Dumpster.AttentionInactivity,
+		},
+	}, logger)
+	reviewVoteRepo := repository.NewReviewVoteRepository(database)
+	reviewService := service.NewReviewService(reviewRepo, reviewVoteRepo, dumpsterRepo, dumpsterCache, ownership, service.ReviewServiceConfig{
+		EditWindow:          cfg.Review.EditWindow,
+		LowRatingThreshold:  cfg.Review.LowRatingThreshold,
+		LowRatingMinComment: cfg.Review.LowRatingMinComment,
+	}, logger)
+	usageService := service.NewUsageService(usageRepo, dumpsterRepo, blockRepo, dumpsterCache, pricingRuleService, taxCalc, impactEstimator, ownership, alertService, logger)
+	paymentRepo := repository.NewPaymentRepository(database)
+	paymentProcessor := payment.NewStubProcessor()
+	bookingService := service.NewBookingService(bookingRepo, dumpsterRepo, paymentRepo, notificationService, pricingRuleService, paymentProcessor, ownership, service.BookingServiceConfig{
+		PendingTTL: cfg.Booking.PendingTTL,
+	}, logger)
+	objectStore := objectstore.NewStubStore(cfg.Storage.PublicURL)
+	if cfg.Storage.Endpoint != "" {
+		objectStore, err = objectstore.NewS3Store(objectstore.S3Config{
+			Endpoint:  cfg.Storage.Endpoint,
+			Region:    cfg.Storage.Region,
+			Bucket:    cfg.Storage.Bucket,
+			AccessKey: cfg.Storage.AccessKey,
+			SecretKey: cfg.Storage.SecretKey,
+			PublicURL: cfg.Storage.PublicURL,
+			Timeout:   cfg.Storage.Timeout,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure object storage: %w", err)
+		}
+	}
+	imageRepo := repository.NewDumpsterImageRepository(database)
+	imageService := service.NewDumpsterImageService(imageRepo, dumpsterRepo, dumpsterCache, objectStore, ownership, service.DumpsterImageServiceConfig{
+		UploadTTL: cfg.Storage.UploadTTL,
+	}, logger)
+	ownerBlockService := service.NewOwnerBlockService(blockRepo, userRepo, logger)
+	searchService := service.NewSearchService(dumpsterRepo, reviewRepo, logger)
+	paymentService := service.NewPaymentService(paymentRepo, bookingRepo, paymentProcessor, ownership, logger)
+
+	dashboardService := service.NewDashboardService(dumpsterRepo, usageRepo, bookingRepo, service.DashboardServiceConfig{
+		PlatformFeeRate: cfg.Statement.PlatformFeeRate,
+	}, logger)
+
+	loginAttemptCache := cache.NewLoginAttemptCache(redisClient)
+	apiKeyRepo := repository.NewAPIKeyRepository(database)
+	userService := service.NewUserService(userRepo, dumpsterRepo, usageRepo, apiKeyRepo, geocoder, tokenService, tokenCache, loginAttemptCache,
+		bookingService, usageService, notificationService, dashboardService, service.UserServiceConfig{
+			RememberMeRefreshTTL: cfg.JWT.RememberMeRefreshTTL,
+			LockoutThreshold:     cfg.Lockout.Threshold,
+			LockoutWindow:        cfg.Lockout.Window,
+			LockoutCooldown:      cfg.Lockout.Cooldown,
+			UniqueVerifiedPhones: cfg.Account.UniqueVerifiedPhones,
+		}, logger)
+
+	maintenanceCache := cache.NewMaintenanceCache(redisClient)
+	maintenanceService := service.NewMaintenanceService(maintenanceCache, cfg.Maintenance.RefreshInterval, logger)
+
+	rateLimitCache := cache.NewRateLimitCache(redisClient)
+	browseRateLimit := middleware.RateLimit(rateLimitCache, middleware.RateLimitPolicy{
+		Name:          "browse",
+		Window:        cfg.RateLimit.Window,
+		Anonymous:     cfg.RateLimit.Anonymous,
+		Authenticated: cfg.RateLimit.Authenticated,
+	})
+	authRateLimit := middleware.RateLimit(rateLimitCache, middleware.RateLimitPolicy{
+		Name:      "auth",
+		Window:    cfg.RateLimit.Window,
+		Anonymous: cfg.RateLimit.Auth,
+	})
+	geocodeRateLimit := middleware.RateLimit(rateLimitCache, middleware.RateLimitPolicy{
+		Name:          "geocode",
+		Window:        cfg.RateLimit.Window,
+		Anonymous:     cfg.RateLimit.Geocode,
+		Authenticated: cfg.RateLimit.Geocode,
+	})
+	resendRateLimit := middleware.RateLimit(rateLimitCache, middleware.RateLimitPolicy{
+		Name:          "resend",
+		Window:        cfg.RateLimit.Window,
+		Anonymous:     cfg.RateLimit.Resend,
+		Authenticated: cfg.RateLimit.Resend,
+	})
+
+	handler := v1.NewHandler(
+		userService,
+		dumpsterService,
+		reviewService,
+		usageService,
+		bookingService,
+		paymentService,
+		notificationService,
+		dashboardService,
+		alertService,
+		pricingRuleService,
+		imageService,
+		ownerBlockService,
+		searchService,
+		maintenanceService,
+		tokenService,
+		cfg.JWT.ServiceToken,
+		browseRateLimit,
+		authRateLimit,
+		geocodeRateLimit,
+		resendRateLimit,
+		cfg.Server.LegacyListEnvelope)
 	handler.InitRoutes(router)
 
+	// Slow routes with a longer request timeout also need the server to keep
+	// the connection writable long enough to send their response.
+	writeTimeout := 10 * time.Second
+	for _, timeout := range cfg.Server.RequestTimeoutOverrides {
+		writeTimeout = max(writeTimeout, timeout+2*time.Second)
+	}
+
 	server := &http.Server{
 		Addr:         ":" + cfg.Server.Port,
 		Handler:      router,
 		ReadTimeout:  10 * time.Second,
-		WriteTimeout: 10 * time.Second,
+		WriteTimeout: writeTimeout,
 		IdleTimeout:  60 * time.Second,
 	}
 
+	scheduler := worker.NewScheduler(logger,
+		worker.NewBookingExpirer(bookingService, cfg.Booking.ExpiryInterval, logger),
+	)
+
+	var seeder *seed.Loader
+	if cfg.Seed.Enabled {
+		seeder = seed.NewLoader(userService, dumpsterService, usageService, reviewService, userRepo, dumpsterRepo, logger)
+	}
+
 	return &App{
-		server: server,
-		db:     database,
+		server:    server,
+		db:        database,
+		replica:   replica,
+		scheduler: scheduler,
+		seeder:    seeder,
 	}, nil
 }
 
@@ -108,6 +403,8 @@ func (a *App) Run() error {
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 
+	a.scheduler.Start()
+
 	go func() {
 		log.Printf("Starting server on %s", a.server.Addr)
 		if err := a.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -125,13 +422,43 @@ func (a *App) Run() error {
 		return err
 	}
 
+	a.scheduler.Stop()
+	a.closeDatabases()
+
+	log.Println("Server stopped")
+	return nil
+}
+
+// Seed loads the demo dataset and returns without starting the server. It
+// refuses to run unless SEED_ENABLED is set.
+func (a *App) Seed(ctx context.Context) error {
+	defer a.closeDatabases()
+
+	if a.seeder == nil {
+		return fmt.Errorf("seeding is disabled; set SEED_ENABLED=true to load demo data")
+	}
+
+	result, err := a.seeder.Load(ctx)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Seed complete: %d users, %d dumpsters, %d usages, %d reviews created",
+		result.Users, result.Dumpsters, result.Usages, result.Reviews)
+	return nil
+}
+
+func (a *App) closeDatabases() {
 	sqlDB, err := a.db.DB()
 	if err == nil {
 		sqlDB.Close()
 	}
 
-	log.Println("Server stopped")
-	return nil
+	if a.replica != nil {
+		if replicaDB, err := a.replica.DB(); err == nil {
+			replicaDB.Close()
+		}
+	}
 }
 
 func runMigrations(db *sql.DB) error {
@@ -1,19 +1,55 @@
 package config
 
 import (
+	"time"
+
 	"github.com/caarlos0/env/v11"
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	Redis    RedisConfig
-	JWT      JWTConfig
+	Server      ServerConfig
+	Database    DatabaseConfig
+	Redis       RedisConfig
+	JWT         JWTConfig
+	Dumpster    DumpsterConfig
+	Maintenance MaintenanceConfig
+	Tax         TaxConfig
+	Impact      ImpactConfig
+	Money       MoneyConfig
+	Statement   StatementConfig
+	Booking     BookingConfig
+	Review      ReviewConfig
+	Geocoder    GeocoderConfig
+	Authz       AuthzConfig
+	RateLimit   RateLimitConfig
+	Lockout     LoginLockoutConfig
+	Account     AccountConfig
+	Storage     ObjectStorageConfig
+	Deletion    DeletionConfig
+	Seed        SeedConfig
+	Logging     LoggingConfig
 }
 
 type ServerConfig struct {
 	Port string `env:"PORT" envDefault:"8080"`
+	// LegacyListEnvelope keeps paginated lists in their old per-endpoint
+	// shape ("dumpsters", "reviews", ... plus top-level paging fields)
+	// instead of {items, meta}. Clients can override it per request with
+	// the X-List-Envelope header. Turn it off once clients have migrated.
+	LegacyListEnvelope bool `env:"LEGACY_LIST_ENVELOPE" envDefault:"true"`
+	// MaxPageOffset is the deepest row offset dumpster, review and usage
+	// lists will page to before answering 400. Set it to 0 to disable.
+	MaxPageOffset int `env:"PAGINATION_MAX_OFFSET" envDefault:"10000"`
+	// TrustedProxies lists proxy IPs/CIDRs whose forwarding headers are
+	// believed when resolving the client IP. Defaults to loopback only.
+	TrustedProxies []string `env:"TRUSTED_PROXIES" envDefault:"127.0.0.1,::1" envSeparator:","`
+	// RequestTimeout bounds every request's context. Keep it below the
+	// server's 10s write timeout so the 504 can still be written.
+	RequestTimeout time.Duration `env:"REQUEST_TIMEOUT" envDefault:"8s"`
+	// RequestTimeoutOverrides maps route patterns to their own timeout, e.g.
+	// "/api/v1/dumpsters/:id/timeline=30s".
+	RequestTimeoutOverrides map[string]time.Duration `env:"REQUEST_TIMEOUT_OVERRIDES" envKeyValSeparator:"="`
 }
 
 type DatabaseConfig struct {
@@ -23,6 +59,9 @@ type DatabaseConfig struct {
 	Password string `env:"DB_PASSWORD" envDefault:"postgres"`
 	DBName   string `env:"DB_NAME" envDefault:"waste_space"`
 	SSLMode  string `env:"DB_SSLMODE" envDefault:"disable"`
+	// ReplicaDSN points list, search, nearby and stats reads at a read
+	// replica. Empty sends every query to the primary.
+	ReplicaDSN string `env:"DB_REPLICA_DSN"`
 }
 
 type RedisConfig struct {
@@ -34,6 +73,167 @@ type RedisConfig struct {
 
 type JWTConfig struct {
 	Secret string `env:"JWT_SECRET" envDefault:"change-me-in-production"`
+	// ServiceToken lets internal services call token introspection without
+	// a user session. Leave empty to require a bearer token.
+	ServiceToken string `env:"JWT_SERVICE_TOKEN"`
+	// RememberMeRefreshTTL is the refresh token lifetime for "remember me"
+	// logins. Regular logins keep the default 7 days.
+	RememberMeRefreshTTL time.Duration `env:"JWT_REMEMBER_ME_REFRESH_TTL" envDefault:"720h"`
+}
+
+type DumpsterConfig struct {
+	AvailabilityTracksUsage bool   `env:"DUMPSTER_AVAILABILITY_TRACKS_USAGE" envDefault:"true"`
+	DefaultSort             string `env:"DUMPSTER_DEFAULT_SORT" envDefault:"newest"`
+	RecentlyViewedLimit     int    `env:"DUMPSTER_RECENTLY_VIEWED_LIMIT" envDefault:"20"`
+	// FlagThreshold is the number of open flags from distinct users that
+	// unpublishes a listing until an admin resolves them.
+	FlagThreshold int `env:"DUMPSTER_FLAG_THRESHOLD" envDefault:"3"`
+	// FeaturedLimit caps how many listings the featured endpoint returns.
+	FeaturedLimit int `env:"DUMPSTER_FEATURED_LIMIT" envDefault:"12"`
+	// Attention thresholds drive the owner's needs-attention list. A zero
+	// value disables that check.
+	AttentionMinRating       float64       `env:"DUMPSTER_ATTENTION_MIN_RATING" envDefault:"3"`
+	AttentionNegativeReviews int           `env:"DUMPSTER_ATTENTION_NEGATIVE_REVIEWS" envDefault:"2"`
+	AttentionNegativeWindow  time.Duration `env:"DUMPSTER_ATTENTION_NEGATIVE_WINDOW" envDefault:"720h"`
+	AttentionOpenFlags       int           `env:"DUMPSTER_ATTENTION_OPEN_FLAGS" envDefault:"1"`
+	AttentionInactivity      time.Duration `env:"DUMPSTER_ATTENTION_INACTIVITY" envDefault:"1440h"`
+	// CacheTTL keeps dumpster-by-ID responses in Redis; 0 disables the
+	// cache. Lookups that find nothing are cached for NegativeCacheTTL.
+	CacheTTL         time.Duration `env:"DUMPSTER_CACHE_TTL" envDefault:"5m"`
+	NegativeCacheTTL time.Duration `env:"DUMPSTER_CACHE_NEGATIVE_TTL" envDefault:"30s"`
+	// SizeGuide adds project:size pairs to the size guide, or changes the
+	// size recommended for a built-in project.
+	SizeGuide map[string]string `env:"DUMPSTER_SIZE_GUIDE" envKeyValSeparator:":"`
+}
+
+// RateLimitConfig sets per-window request allowances. Browsing limits apply
+// to every API request; the auth limit additionally applies per IP to
+// register, login and refresh. A zero limit disables that check.
+type RateLimitConfig struct {
+	Window        time.Duration `env:"RATE_LIMIT_WINDOW" envDefault:"1m"`
+	Anonymous     int           `env:"RATE_LIMIT_ANONYMOUS" envDefault:"60"`
+	Authenticated int           `env:"RATE_LIMIT_AUTHENTICATED" envDefault:"300"`
+	Auth          int           `env:"RATE_LIMIT_AUTH" envDefault:"10"`
+	Geocode       int           `env:"RATE_LIMIT_GEOCODE" envDefault:"20"`
+	Resend        int           `env:"RATE_LIMIT_RESEND" envDefault:"5"`
+}
+
+// AccountConfig.UniqueVerifiedPhones rejects registration and phone changes
+// to a number already verified on another account. Unverified duplicates are
+// always allowed, and a number can only ever be verified on one live account
+// regardless of this setting.
+type AccountConfig struct {
+	UniqueVerifiedPhones bool `env:"UNIQUE_VERIFIED_PHONES" envDefault:"true"`
+}
+
+// LoginLockoutConfig locks an email address out of login for Cooldown once
+// Threshold failed attempts land within Window. A zero threshold disables
+// lockout.
+type LoginLockoutConfig struct {
+	Threshold int           `env:"LOGIN_LOCKOUT_THRESHOLD" envDefault:"5"`
+	Window    time.Duration `env:"LOGIN_LOCKOUT_WINDOW" envDefault:"15m"`
+	Cooldown  time.Duration `env:"LOGIN_LOCKOUT_COOLDOWN" envDefault:"15m"`
+}
+
+// ObjectStorageConfig points image uploads at an S3-compatible bucket. Leave
+// Endpoint empty to use the stub store, which presigns nothing and serves
+// URLs under PublicURL.
+type ObjectStorageConfig struct {
+	Endpoint  string        `env:"OBJECT_STORAGE_ENDPOINT"`
+	Region    string        `env:"OBJECT_STORAGE_REGION" envDefault:"us-east-1"`
+	Bucket    string        `env:"OBJECT_STORAGE_BUCKET" envDefault:"waste-space"`
+	AccessKey string        `env:"OBJECT_STORAGE_ACCESS_KEY"`
+	SecretKey string        `env:"OBJECT_STORAGE_SECRET_KEY"`
+	PublicURL string        `env:"OBJECT_STORAGE_PUBLIC_URL"`
+	UploadTTL time.Duration `env:"OBJECT_STORAGE_UPLOAD_TTL" envDefault:"15m"`
+	Timeout   time.Duration `env:"OBJECT_STORAGE_TIMEOUT" envDefault:"5s"`
+}
+
+// DeletionConfig switches entity types from soft to hard delete, for
+// operators whose compliance rules require data to be erased. Hard deletes
+// cannot be undone and cascade to dependent rows.
+type DeletionConfig struct {
+	HardDeleteUsers     bool `env:"HARD_DELETE_USERS" envDefault:"false"`
+	HardDeleteDumpsters bool `env:"HARD_DELETE_DUMPSTERS" envDefault:"false"`
+	HardDeleteReviews   bool `env:"HARD_DELETE_REVIEWS" envDefault:"false"`
+	HardDeleteUsages    bool `env:"HARD_DELETE_USAGES" envDefault:"false"`
+}
+
+type MaintenanceConfig struct {
+	RefreshInterval time.Duration `env:"MAINTENANCE_REFRESH_INTERVAL" envDefault:"5s"`
+}
+
+type TaxConfig struct {
+	Rates       map[string]float64 `env:"TAX_RATES" envKeyValSeparator:":"`
+	DefaultRate float64            `env:"TAX_DEFAULT_RATE" envDefault:"0"`
+}
+
+// ImpactConfig holds the parameters of impact.NewTableEstimator.
+type ImpactConfig struct {
+	DiversionRates       map[string]float64 `env:"IMPACT_DIVERSION_RATES" envKeyValSeparator:":" envDefault:"small:0.3,medium:0.35,large:0.4,extraLarge:0.45"`
+	DefaultDiversionRate float64            `env:"IMPACT_DEFAULT_DIVERSION_RATE" envDefault:"0.3"`
+	CO2ePerKg            float64            `env:"IMPACT_CO2E_PER_KG" envDefault:"0.5"`
+}
+
+// StatementConfig.PlatformFeeRate is the share of an owner's monthly revenue
+// shown as platform fees on their statement, from 0 to 1.
+type StatementConfig struct {
+	PlatformFeeRate float64 `env:"STATEMENT_PLATFORM_FEE_RATE" envDefault:"0"`
+}
+
+type MoneyConfig struct {
+	// Currency is the ISO 4217 code every stored amount is denominated in.
+	Currency string `env:"CURRENCY" envDefault:"USD"`
+	// Locale controls how the *Formatted response fields render amounts.
+	Locale string `env:"MONEY_LOCALE" envDefault:"en-US"`
+}
+
+type BookingConfig struct {
+	PendingTTL     time.Duration `env:"BOOKING_PENDING_TTL" envDefault:"24h"`
+	ExpiryInterval time.Duration `env:"BOOKING_EXPIRY_INTERVAL" envDefault:"5m"`
+	// HoldTTL is how long a hold keeps a dumpster's dates from being booked
+	// by anyone else.
+	HoldTTL time.Duration `env:"BOOKING_HOLD_TTL" envDefault:"10m"`
+}
+
+type ReviewConfig struct {
+	// EditWindow is how long after posting an author may still edit their
+	// review, so it can't be rewritten after the owner has replied. Zero
+	// allows edits indefinitely; admins are never limited.
+	EditWindow time.Duration `env:"REVIEW_EDIT_WINDOW" envDefault:"24h"`
+	// Ratings at or below LowRatingThreshold must come with a comment of at
+	// least LowRatingMinComment characters, or any comment when that is
+	// zero. A zero threshold disables the rule.
+	LowRatingThreshold  int `env:"REVIEW_LOW_RATING_THRESHOLD" envDefault:"2"`
+	LowRatingMinComment int `env:"REVIEW_LOW_RATING_MIN_COMMENT" envDefault:"20"`
+}
+
+// GeocoderConfig selects the address geocoder. Leave URL empty to disable
+// geocoding; users are then simply excluded from proximity counts.
+type GeocoderConfig struct {
+	URL       string        `env:"GEOCODER_URL"`
+	UserAgent string        `env:"GEOCODER_USER_AGENT" envDefault:"waste-space"`
+	Timeout   time.Duration `env:"GEOCODER_TIMEOUT" envDefault:"5s"`
+}
+
+// AuthzConfig.OwnershipPolicy is "forbidden" (403 to non-owners) or
+// "not_found" (404, hides whether the resource exists).
+type AuthzConfig struct {
+	OwnershipPolicy string `env:"OWNERSHIP_POLICY" envDefault:"forbidden"`
+}
+
+// SeedConfig.Enabled allows `api -seed` to load demo data. Leave it off in
+// production; the flag is refused unless this is set.
+type SeedConfig struct {
+	Enabled bool `env:"SEED_ENABLED" envDefault:"false"`
+}
+
+// LoggingConfig turns on request and response body logging for debugging.
+// Passwords, tokens and the Authorization header are always redacted;
+// RedactFields names further JSON keys to hide.
+type LoggingConfig struct {
+	LogBodies    bool     `env:"LOG_REQUEST_BODIES" envDefault:"false"`
+	RedactFields []string `env:"LOG_REDACT_FIELDS" envSeparator:","`
 }
 
 func Load() (*Config, error) {
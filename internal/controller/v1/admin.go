@@ -0,0 +1,337 @@
+package v1
+
+import (
+	"net/http"
+	"waste-space/internal/dto"
+	"waste-space/internal/middleware"
+	"waste-space/internal/service"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AdminController struct {
+	maintenanceService service.MaintenanceService
+	reviewService      service.ReviewService
+	dumpsterService    service.DumpsterService
+	usageService       service.UsageService
+}
+
+func NewAdminController(
+	maintenanceService service.MaintenanceService,
+	reviewService service.ReviewService,
+	dumpsterService service.DumpsterService,
+	usageService service.UsageService) *AdminController {
+	return &AdminController{
+		maintenanceService: maintenanceService,
+		reviewService:      reviewService,
+		dumpsterService:    dumpsterService,
+		usageService:       usageService,
+	}
+}
+
+func (c *AdminController) initAdminRoutes(rg *gin.RouterGroup, authMiddleware, adminMiddleware gin.HandlerFunc) {
+	admin := rg.Group("/admin")
+	admin.Use(authMiddleware, adminMiddleware)
+	{
+		admin.GET("/maintenance", c.getMaintenance)
+		admin.POST("/maintenance", c.setMaintenance)
+		admin.GET("/reviews", c.listReviews)
+		admin.DELETE("/reviews/:id", c.removeReview)
+		admin.GET("/flags", c.listFlags)
+		admin.GET("/dumpsters/unreviewed", c.listUnreviewedDumpsters)
+		admin.GET("/dumpsters/by-region", c.dumpstersByRegion)
+		admin.POST("/dumpsters/:id/flags/resolve", c.resolveFlags)
+		admin.PUT("/dumpsters/:id/featured", c.setFeatured)
+		admin.POST("/usages/:id/paid", c.markUsagePaid)
+	}
+}
+
+// @Summary Get maintenance mode
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.MaintenanceResponse
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Router /api/v1/admin/maintenance [get]
+func (c *AdminController) getMaintenance(ctx *gin.Context) {
+	response, err := c.maintenanceService.GetState(ctx.Request.Context())
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Set maintenance mode
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.MaintenanceRequest true "Maintenance mode"
+// @Success 200 {object} dto.MaintenanceResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Router /api/v1/admin/maintenance [post]
+func (c *AdminController) setMaintenance(ctx *gin.Context) {
+	var req dto.MaintenanceRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.maintenanceService.SetState(ctx.Request.Context(), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary List reviews for moderation
+// @Description Pass includeDeleted=true to also return soft-deleted reviews; deletedReason tells author deletions from moderator removals.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number"
+// @Param limit query int false "Items per page"
+// @Param dumpsterId query string false "Filter by dumpster ID"
+// @Param userId query string false "Filter by author ID"
+// @Param includeDeleted query bool false "Include soft-deleted reviews"
+// @Param X-List-Envelope header string false "Response shape: items|legacy (defaults to the server setting)"
+// @Success 200 {object} dto.PaginatedResponse[dto.ReviewResponse]
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Router /api/v1/admin/reviews [get]
+func (c *AdminController) listReviews(ctx *gin.Context) {
+	var req dto.AdminReviewListRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.reviewService.ListForModeration(ctx.Request.Context(), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	respondPage(ctx, response, "reviews")
+}
+
+// @Summary Remove review as moderator
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Review ID"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/admin/reviews/{id} [delete]
+func (c *AdminController) removeReview(ctx *gin.Context) {
+	if err := c.reviewService.Remove(ctx.Request.Context(), ctx.Param("id")); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
+
+// @Summary List dumpster flags
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number"
+// @Param limit query int false "Items per page"
+// @Param status query string false "Filter by status" Enums(open, dismissed, upheld)
+// @Param dumpsterId query string false "Filter by dumpster ID"
+// @Param X-List-Envelope header string false "Response shape: items|legacy (defaults to the server setting)"
+// @Success 200 {object} dto.PaginatedResponse[dto.DumpsterFlagResponse]
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Router /api/v1/admin/flags [get]
+func (c *AdminController) listFlags(ctx *gin.Context) {
+	var req dto.DumpsterFlagListRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.dumpsterService.ListFlags(ctx.Request.Context(), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	respondPage(ctx, response, "flags")
+}
+
+// @Summary List dumpsters without reviews
+// @Description Oldest listings first, including unpublished ones.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number"
+// @Param limit query int false "Items per page"
+// @Param ownerId query string false "Filter by owner ID"
+// @Param createdFrom query string false "Only listings created at or after this time (RFC3339)"
+// @Param createdTo query string false "Only listings created before this time (RFC3339)"
+// @Param X-List-Envelope header string false "Response shape: items|legacy (defaults to the server setting)"
+// @Success 200 {object} dto.PaginatedResponse[dto.DumpsterResponse]
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Router /api/v1/admin/dumpsters/unreviewed [get]
+func (c *AdminController) listUnreviewedDumpsters(ctx *gin.Context) {
+	var req dto.UnreviewedDumpsterListRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.dumpsterService.ListUnreviewed(ctx.Request.Context(), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	respondPage(ctx, response, "dumpsters")
+}
+
+// @Summary Count dumpsters by region
+// @Description Listing counts per state and per city, most listings first. Every state is returned; cities are capped by limit.
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param publishedOnly query bool false "Count only published listings"
+// @Param availableOnly query bool false "Count only listings bookable right now"
+// @Param limit query int false "Maximum cities returned" default(50)
+// @Success 200 {object} dto.DumpsterRegionCountsResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Router /api/v1/admin/dumpsters/by-region [get]
+func (c *AdminController) dumpstersByRegion(ctx *gin.Context) {
+	var req dto.DumpsterRegionCountsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.dumpsterService.GetRegionCounts(ctx.Request.Context(), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Resolve dumpster flags
+// @Description Closes every open flag on the listing. dismiss republishes it; uphold keeps it unpublished.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dumpster ID"
+// @Param request body dto.ResolveDumpsterFlagsRequest true "Resolution"
+// @Success 200 {object} dto.DumpsterResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/admin/dumpsters/{id}/flags/resolve [post]
+func (c *AdminController) resolveFlags(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	var req dto.ResolveDumpsterFlagsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.dumpsterService.ResolveFlags(ctx.Request.Context(), userID, ctx.Param("id"), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Set dumpster featured flag
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dumpster ID"
+// @Param request body dto.SetFeaturedRequest true "Featured flag"
+// @Success 200 {object} dto.DumpsterResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/admin/dumpsters/{id}/featured [put]
+func (c *AdminController) setFeatured(ctx *gin.Context) {
+	var req dto.SetFeaturedRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.dumpsterService.SetFeatured(ctx.Request.Context(), ctx.Param("id"), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Mark usage session paid
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Usage ID"
+// @Success 200 {object} dto.UsageResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Router /api/v1/admin/usages/{id}/paid [post]
+func (c *AdminController) markUsagePaid(ctx *gin.Context) {
+	response, err := c.usageService.MarkPaid(ctx.Request.Context(), ctx.Param("id"))
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *AdminController) getUserIDFromContext(ctx *gin.Context) (string, bool) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		handleError(ctx, apperrors.Unauthorized("unauthorized"))
+		return "", false
+	}
+	return userID.String(), true
+}
@@ -1,8 +1,11 @@
 package v1
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"waste-space/internal/dto"
+	"waste-space/internal/middleware"
 	"waste-space/internal/service"
 	apperrors "waste-space/pkg/errors"
 
@@ -19,12 +22,19 @@ func NewAuthController(userService service.UserService) *AuthController {
 	}
 }
 
-func (c *AuthController) initAuthRoutes(rg *gin.RouterGroup) {
+func (c *AuthController) initAuthRoutes(
+	rg *gin.RouterGroup,
+	authMiddleware gin.HandlerFunc,
+	introspectMiddleware gin.HandlerFunc,
+	rateLimitMiddleware gin.HandlerFunc) {
 	auth := rg.Group("/auth")
 	{
-		auth.POST("/register", c.register)
-		auth.POST("/login", c.login)
-		auth.POST("/refresh", c.refreshToken)
+		auth.POST("/register", rateLimitMiddleware, c.register)
+		auth.POST("/login", rateLimitMiddleware, c.login)
+		auth.POST("/refresh", rateLimitMiddleware, c.refreshToken)
+		auth.POST("/logout", authMiddleware, c.logout)
+		auth.POST("/introspect", introspectMiddleware, c.introspect)
+		auth.GET("/me/permissions", authMiddleware, c.permissions)
 	}
 }
 
@@ -36,6 +46,7 @@ func (c *AuthController) initAuthRoutes(rg *gin.RouterGroup) {
 // @Success 201 {object} dto.UserResponse
 // @Failure 400 {object} map[string]string
 // @Failure 409 {object} map[string]string
+// @Failure 429 {object} map[string]string
 // @Router /api/v1/auth/register [post]
 func (c *AuthController) register(ctx *gin.Context) {
 	var req dto.CreateUserRequest
@@ -61,6 +72,8 @@ func (c *AuthController) register(ctx *gin.Context) {
 // @Success 200 {object} dto.LoginResponse
 // @Failure 400 {object} map[string]string
 // @Failure 401 {object} map[string]string
+// @Failure 423 {object} map[string]string
+// @Failure 429 {object} map[string]string
 // @Router /api/v1/auth/login [post]
 func (c *AuthController) login(ctx *gin.Context) {
 	var req dto.LoginRequest
@@ -69,7 +82,12 @@ func (c *AuthController) login(ctx *gin.Context) {
 		return
 	}
 
-	response, err := c.userService.Login(ctx.Request.Context(), req)
+	client := dto.ClientInfo{
+		UserAgent: ctx.Request.UserAgent(),
+		IPAddress: middleware.ClientIP(ctx),
+	}
+
+	response, err := c.userService.Login(ctx.Request.Context(), req, client)
 	if err != nil {
 		handleError(ctx, err)
 		return
@@ -86,6 +104,7 @@ func (c *AuthController) login(ctx *gin.Context) {
 // @Success 200 {object} dto.RefreshTokenResponse
 // @Failure 400 {object} map[string]string
 // @Failure 401 {object} map[string]string
+// @Failure 429 {object} map[string]string
 // @Router /api/v1/auth/refresh [post]
 func (c *AuthController) refreshToken(ctx *gin.Context) {
 	var req dto.RefreshTokenRequest
@@ -103,7 +122,95 @@ func (c *AuthController) refreshToken(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, response)
 }
 
+// @Summary Logout current session
+// @Description Revokes only the session the access token belongs to; other devices stay signed in.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 204
+// @Failure 401 {object} map[string]string
+// @Router /api/v1/auth/logout [post]
+func (c *AuthController) logout(ctx *gin.Context) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		handleError(ctx, apperrors.Unauthorized("unauthorized"))
+		return
+	}
+
+	if err := c.userService.Logout(ctx.Request.Context(), userID.String(), middleware.GetAccessToken(ctx)); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
+
+// @Summary Get permissions for the current token
+// @Description Role from the token plus capabilities derived from it and the account state, for toggling UI. Inactive accounts have no capabilities; API key callers can't manage API keys.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.PermissionsResponse
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/auth/me/permissions [get]
+func (c *AuthController) permissions(ctx *gin.Context) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		handleError(ctx, apperrors.Unauthorized("unauthorized"))
+		return
+	}
+
+	response, err := c.userService.GetPermissions(
+		ctx.Request.Context(), userID.String(), middleware.GetRole(ctx), middleware.IsAPIKeyAuth(ctx))
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Introspect access token
+// @Description Reports whether a token is active. Invalid, expired or revoked tokens return active=false with 200. Callers authenticate with a bearer token or the X-Service-Token header.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.IntrospectRequest true "Token to introspect"
+// @Success 200 {object} dto.IntrospectResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /api/v1/auth/introspect [post]
+func (c *AuthController) introspect(ctx *gin.Context) {
+	var req dto.IntrospectRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if req.Token == "" {
+		handleError(ctx, apperrors.BadRequest("token is required"))
+		return
+	}
+
+	response, err := c.userService.IntrospectToken(ctx.Request.Context(), req.Token)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
 func handleError(ctx *gin.Context, err error) {
+	if errors.Is(err, context.DeadlineExceeded) {
+		ctx.JSON(http.StatusGatewayTimeout, gin.H{"error": "request timed out"})
+		return
+	}
+
 	status := apperrors.GetHTTPStatus(err)
 	ctx.JSON(status, gin.H{"error": err.Error()})
 }
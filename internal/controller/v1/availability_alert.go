@@ -0,0 +1,89 @@
+package v1
+
+import (
+	"net/http"
+	"waste-space/internal/middleware"
+	"waste-space/internal/service"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AvailabilityAlertController struct {
+	alertService service.AvailabilityAlertService
+}
+
+func NewAvailabilityAlertController(alertService service.AvailabilityAlertService) *AvailabilityAlertController {
+	return &AvailabilityAlertController{
+		alertService: alertService,
+	}
+}
+
+func (c *AvailabilityAlertController) initAvailabilityAlertRoutes(rg *gin.RouterGroup, authMiddleware gin.HandlerFunc) {
+	dumpsters := rg.Group("/dumpsters/:id")
+	dumpsters.Use(authMiddleware)
+	{
+		dumpsters.POST("/alert", c.subscribe)
+		dumpsters.DELETE("/alert", c.unsubscribe)
+	}
+}
+
+// @Summary Subscribe to an availability alert
+// @Description Notifies the caller once when the dumpster becomes available. Subscribing again is a no-op.
+// @Tags dumpsters
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dumpster ID"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/alert [post]
+func (c *AvailabilityAlertController) subscribe(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	if err := c.alertService.Subscribe(ctx.Request.Context(), userID, ctx.Param("id")); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
+
+// @Summary Unsubscribe from an availability alert
+// @Tags dumpsters
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dumpster ID"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/alert [delete]
+func (c *AvailabilityAlertController) unsubscribe(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	if err := c.alertService.Unsubscribe(ctx.Request.Context(), userID, ctx.Param("id")); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
+
+func (c *AvailabilityAlertController) getUserIDFromContext(ctx *gin.Context) (string, bool) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		handleError(ctx, apperrors.Unauthorized("unauthorized"))
+		return "", false
+	}
+	return userID.String(), true
+}
@@ -0,0 +1,255 @@
+package v1
+
+import (
+	"net/http"
+	"waste-space/internal/dto"
+	"waste-space/internal/middleware"
+	"waste-space/internal/service"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BookingController struct {
+	bookingService service.BookingService
+	paymentService service.PaymentService
+}
+
+func NewBookingController(
+	bookingService service.BookingService,
+	paymentService service.PaymentService) *BookingController {
+	return &BookingController{
+		bookingService: bookingService,
+		paymentService: paymentService,
+	}
+}
+
+func (c *BookingController) initBookingRoutes(
+	rg *gin.RouterGroup,
+	authMiddleware gin.HandlerFunc,
+	resendRateLimit gin.HandlerFunc) {
+	bookings := rg.Group("/bookings")
+	bookings.Use(authMiddleware)
+	{
+		bookings.GET("/upcoming", c.getUpcoming)
+		bookings.GET("/:id", c.getByID)
+		bookings.POST("/:id/confirm", c.confirm)
+		bookings.POST("/:id/pay", c.pay)
+		bookings.POST("/:id/end-early", c.endEarly)
+		bookings.POST("/:id/resend-notification", resendRateLimit, c.resendNotification)
+	}
+
+	rg.GET("/dumpsters/:id/lead-time", authMiddleware, c.leadTime)
+}
+
+// @Summary Get upcoming bookings
+// @Description Confirmed bookings of the caller that start now or later, soonest first.
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} dto.BookingResponse
+// @Failure 401 {object} map[string]string
+// @Router /api/v1/bookings/upcoming [get]
+func (c *BookingController) getUpcoming(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := c.bookingService.GetUpcoming(ctx.Request.Context(), userID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Get booking by ID
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Booking ID"
+// @Success 200 {object} dto.BookingResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/bookings/{id} [get]
+func (c *BookingController) getByID(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	id := ctx.Param("id")
+
+	response, err := c.bookingService.GetByID(ctx.Request.Context(), userID, id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Confirm booking
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Booking ID"
+// @Success 200 {object} dto.BookingResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/bookings/{id}/confirm [post]
+func (c *BookingController) confirm(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	id := ctx.Param("id")
+
+	response, err := c.bookingService.Confirm(ctx.Request.Context(), userID, id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Pay for booking
+// @Description Charges the booking total and confirms the booking. Send an Idempotency-Key header to retry safely: replaying a key returns the original attempt instead of charging again.
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Booking ID"
+// @Param Idempotency-Key header string false "Client-generated key identifying this payment attempt"
+// @Success 200 {object} dto.PaymentResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Router /api/v1/bookings/{id}/pay [post]
+func (c *BookingController) pay(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := c.paymentService.PayBooking(ctx.Request.Context(), userID, ctx.Param("id"), ctx.GetHeader("Idempotency-Key"))
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary End booking early
+// @Description Shortens a confirmed booking to endDate, reprices it for the days used and refunds the difference if it was paid. The dumpster becomes bookable again from endDate.
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Booking ID"
+// @Param request body dto.EndBookingEarlyRequest true "New end date"
+// @Success 200 {object} dto.EndBookingEarlyResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/bookings/{id}/end-early [post]
+func (c *BookingController) endEarly(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	var req dto.EndBookingEarlyRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.bookingService.EndEarly(ctx.Request.Context(), userID, ctx.Param("id"), req.EndDate)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Resend booking notification
+// @Description Sends the booker the notification for the booking's current state (confirmed or expired) again. Available to the booker and the dumpster's owner.
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Booking ID"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 429 {object} map[string]string
+// @Router /api/v1/bookings/{id}/resend-notification [post]
+func (c *BookingController) resendNotification(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	if err := c.bookingService.ResendNotification(ctx.Request.Context(), userID, ctx.Param("id")); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
+
+// @Summary Get a dumpster's booking lead time
+// @Description Average and median days between a confirmed booking being made and its start date. Owner or admin only.
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dumpster ID"
+// @Success 200 {object} dto.BookingLeadTimeResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/lead-time [get]
+func (c *BookingController) leadTime(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := c.bookingService.GetLeadTimeStats(ctx.Request.Context(), userID, ctx.Param("id"), middleware.IsAdmin(ctx))
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *BookingController) getUserIDFromContext(ctx *gin.Context) (string, bool) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		handleError(ctx, apperrors.Unauthorized("unauthorized"))
+		return "", false
+	}
+	return userID.String(), true
+}
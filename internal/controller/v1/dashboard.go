@@ -0,0 +1,101 @@
+package v1
+
+import (
+	"net/http"
+	"strconv"
+	"waste-space/internal/middleware"
+	"waste-space/internal/service"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DashboardController struct {
+	dashboardService service.DashboardService
+}
+
+func NewDashboardController(dashboardService service.DashboardService) *DashboardController {
+	return &DashboardController{
+		dashboardService: dashboardService,
+	}
+}
+
+func (c *DashboardController) initDashboardRoutes(rg *gin.RouterGroup, authMiddleware gin.HandlerFunc) {
+	users := rg.Group("/users/me")
+	users.Use(authMiddleware)
+	{
+		users.GET("/dashboard", c.getOwnerDashboard)
+		users.GET("/statements/:year/:month", c.getMonthlyStatement)
+	}
+}
+
+// @Summary Get owner dashboard summary
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.OwnerDashboardResponse
+// @Failure 401 {object} map[string]string
+// @Router /api/v1/users/me/dashboard [get]
+func (c *DashboardController) getOwnerDashboard(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := c.dashboardService.GetOwnerDashboard(ctx.Request.Context(), userID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Get owner monthly statement
+// @Description Statement for one calendar month (UTC): per-dumpster revenue from completed usages and ended confirmed bookings, usage and booking counts, platform fees and payout. The current month is returned with final=false.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param year path int true "Year"
+// @Param month path int true "Month (1-12)"
+// @Success 200 {object} dto.MonthlyStatementResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /api/v1/users/me/statements/{year}/{month} [get]
+func (c *DashboardController) getMonthlyStatement(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	year, err := strconv.Atoi(ctx.Param("year"))
+	if err != nil {
+		handleError(ctx, apperrors.BadRequest("invalid year"))
+		return
+	}
+
+	month, err := strconv.Atoi(ctx.Param("month"))
+	if err != nil {
+		handleError(ctx, apperrors.BadRequest("invalid month"))
+		return
+	}
+
+	response, err := c.dashboardService.GetMonthlyStatement(ctx.Request.Context(), userID, year, month)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *DashboardController) getUserIDFromContext(ctx *gin.Context) (string, bool) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		handleError(ctx, apperrors.Unauthorized("unauthorized"))
+		return "", false
+	}
+	return userID.String(), true
+}
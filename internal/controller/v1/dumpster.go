@@ -2,6 +2,9 @@ package v1
 
 import (
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 	"waste-space/internal/dto"
 	"waste-space/internal/middleware"
 	"waste-space/internal/service"
@@ -20,23 +23,58 @@ func NewDumpsterController(dumpsterService service.DumpsterService) *DumpsterCon
 	}
 }
 
-func (c *DumpsterController) initDumpsterRoutes(rg *gin.RouterGroup, authMiddleware gin.HandlerFunc) {
+func (c *DumpsterController) initDumpsterRoutes(
+	rg *gin.RouterGroup,
+	authMiddleware gin.HandlerFunc,
+	optionalAuthMiddleware gin.HandlerFunc,
+	geocodeRateLimit gin.HandlerFunc) {
 	dumpsters := rg.Group("/dumpsters")
 	{
 		dumpsters.GET("", c.list)
 		dumpsters.GET("/search", c.search)
 		dumpsters.GET("/nearby", c.nearby)
-		dumpsters.GET("/:id", c.getByID)
+		dumpsters.GET("/density", c.density)
+		dumpsters.GET("/price-stats", c.priceStats)
+		dumpsters.GET("/featured", c.featured)
+		dumpsters.GET("/price-suggestion", c.priceSuggestion)
+		dumpsters.GET("/size-guide", c.sizeGuide)
+		dumpsters.GET("/shared/:token", c.getShared)
+		dumpsters.GET("/slug/:slug", optionalAuthMiddleware, c.getBySlug)
+		dumpsters.GET("/:id", optionalAuthMiddleware, c.getByID)
+		dumpsters.GET("/availability", c.checkAvailabilityBatch)
 		dumpsters.GET("/:id/availability", c.checkAvailability)
+		dumpsters.GET("/:id/quote", c.quote)
+		dumpsters.GET("/:id/distance", c.distance)
+		dumpsters.GET("/:id/paired", c.paired)
 
 		dumpsters.Use(authMiddleware)
 		{
 			dumpsters.POST("", c.create)
-			dumpsters.PUT("/:id", c.update)
+			dumpsters.POST("/validate-address", geocodeRateLimit, c.validateAddress)
+			dumpsters.PUT("/:id", c.replace)
+			dumpsters.PATCH("/:id", c.patch)
+			dumpsters.DELETE("/mine", c.deleteMine)
+			dumpsters.GET("/mine/duplicates", c.duplicates)
+			dumpsters.GET("/mine/needs-attention", c.needsAttention)
+			dumpsters.POST("/mine/duplicates/merge", c.mergeDuplicates)
 			dumpsters.DELETE("/:id", c.delete)
 			dumpsters.POST("/:id/book", c.book)
+			dumpsters.POST("/:id/hold", c.hold)
+			dumpsters.POST("/:id/clone", c.clone)
+			dumpsters.POST("/:id/publish", c.publish)
+			dumpsters.POST("/:id/snooze", c.snooze)
+			dumpsters.POST("/:id/flag", c.flag)
+			dumpsters.POST("/:id/share-links", c.createShareLink)
+			dumpsters.DELETE("/:id/share-links", c.revokeShareLinks)
+			dumpsters.DELETE("/:id/snooze", c.unsnooze)
+			dumpsters.GET("/:id/timeline", c.timeline)
+			dumpsters.GET("/:id/revisions", c.revisions)
+			dumpsters.GET("/:id/revisions/:rev", c.revision)
+			dumpsters.POST("/:id/revert/:revisionId", c.revert)
 		}
 	}
+
+	rg.GET("/users/me/recently-viewed", authMiddleware, c.recentlyViewed)
 }
 
 // @Summary List dumpsters
@@ -45,13 +83,18 @@ func (c *DumpsterController) initDumpsterRoutes(rg *gin.RouterGroup, authMiddlew
 // @Produce json
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(20)
-// @Param sortBy query string false "Sort by: price|distance|rating|availability"
+// @Param tags query string false "Comma-separated tags; results carry all of them"
+// @Param sortBy query string false "Sort by: newest|price|distance|rating|availability (defaults to the configured sort)"
 // @Param location query string false "Coordinates lat,lng"
 // @Param maxPrice query number false "Maximum price per day"
 // @Param size query string false "Size: small|medium|large|extraLarge"
 // @Param availableNow query boolean false "Available now"
 // @Param maxDistance query number false "Maximum distance in km"
-// @Success 200 {object} dto.DumpsterListResponse
+// @Param createdFrom query string false "Only listings created at or after this time (RFC3339)"
+// @Param createdTo query string false "Only listings created before this time (RFC3339)"
+// @Param X-List-Envelope header string false "Response shape: items|legacy (defaults to the server setting)"
+// @Param X-Read-Consistency header string false "Set to primary to skip the read replica, e.g. right after a write"
+// @Success 200 {object} dto.PaginatedResponse[dto.DumpsterResponse]
 // @Failure 400 {object} map[string]string
 // @Router /api/v1/dumpsters [get]
 func (c *DumpsterController) list(ctx *gin.Context) {
@@ -67,10 +110,11 @@ func (c *DumpsterController) list(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, response)
+	respondPage(ctx, response, "dumpsters")
 }
 
 // @Summary Get dumpster by ID
+// @Description Signed-in viewers other than the owner get the dumpster added to their recently viewed list. Unpublished listings are 404 for everyone but the owner and admins.
 // @Tags dumpsters
 // @Accept json
 // @Produce json
@@ -81,7 +125,61 @@ func (c *DumpsterController) list(ctx *gin.Context) {
 func (c *DumpsterController) getByID(ctx *gin.Context) {
 	id := ctx.Param("id")
 
-	response, err := c.dumpsterService.GetByID(ctx.Request.Context(), id)
+	var viewerID string
+	if userID, ok := middleware.GetUserID(ctx); ok {
+		viewerID = userID.String()
+	}
+
+	response, err := c.dumpsterService.GetByID(ctx.Request.Context(), viewerID, middleware.IsAdmin(ctx), id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Get dumpster by slug
+// @Description Same as getting a dumpster by ID, looked up by its human-readable slug.
+// @Tags dumpsters
+// @Accept json
+// @Produce json
+// @Param slug path string true "Dumpster slug"
+// @Success 200 {object} dto.DumpsterResponse
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/dumpsters/slug/{slug} [get]
+func (c *DumpsterController) getBySlug(ctx *gin.Context) {
+	slug := ctx.Param("slug")
+
+	var viewerID string
+	if userID, ok := middleware.GetUserID(ctx); ok {
+		viewerID = userID.String()
+	}
+
+	response, err := c.dumpsterService.GetBySlug(ctx.Request.Context(), viewerID, middleware.IsAdmin(ctx), slug)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary List recently viewed dumpsters
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} dto.DumpsterResponse
+// @Failure 401 {object} map[string]string
+// @Router /api/v1/users/me/recently-viewed [get]
+func (c *DumpsterController) recentlyViewed(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := c.dumpsterService.GetRecentlyViewed(ctx.Request.Context(), userID)
 	if err != nil {
 		handleError(ctx, err)
 		return
@@ -121,20 +219,58 @@ func (c *DumpsterController) create(ctx *gin.Context) {
 	ctx.JSON(http.StatusCreated, response)
 }
 
-// @Summary Update dumpster
+// @Summary Replace dumpster
+// @Description Full replace: the body is the complete dumpster. Omitted fields take their creation defaults, so optional text and tags are cleared and isAvailable becomes true. Use PATCH to change only some fields.
 // @Tags dumpsters
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Dumpster ID"
-// @Param request body dto.UpdateDumpsterRequest true "Dumpster update data"
+// @Param request body dto.ReplaceDumpsterRequest true "Complete dumpster data"
 // @Success 200 {object} dto.DumpsterResponse
 // @Failure 400 {object} map[string]string
 // @Failure 401 {object} map[string]string
 // @Failure 403 {object} map[string]string
 // @Failure 404 {object} map[string]string
 // @Router /api/v1/dumpsters/{id} [put]
-func (c *DumpsterController) update(ctx *gin.Context) {
+func (c *DumpsterController) replace(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	id := ctx.Param("id")
+
+	var req dto.ReplaceDumpsterRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.dumpsterService.Replace(ctx.Request.Context(), userID, id, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Patch dumpster
+// @Description Merge patch: only the fields present in the body change. Set description, capacity, weight or tags to null to clear them. null on any other field is rejected.
+// @Tags dumpsters
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dumpster ID"
+// @Param request body dto.PatchDumpsterRequest true "Fields to change"
+// @Success 200 {object} dto.DumpsterResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/dumpsters/{id} [patch]
+func (c *DumpsterController) patch(ctx *gin.Context) {
 	userID, ok := c.getUserIDFromContext(ctx)
 	if !ok {
 		return
@@ -142,13 +278,13 @@ func (c *DumpsterController) update(ctx *gin.Context) {
 
 	id := ctx.Param("id")
 
-	var req dto.UpdateDumpsterRequest
+	var req dto.PatchDumpsterRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
 		handleError(ctx, apperrors.BadRequest(err.Error()))
 		return
 	}
 
-	response, err := c.dumpsterService.Update(ctx.Request.Context(), userID, id, req)
+	response, err := c.dumpsterService.Patch(ctx.Request.Context(), userID, id, req)
 	if err != nil {
 		handleError(ctx, err)
 		return
@@ -158,6 +294,7 @@ func (c *DumpsterController) update(ctx *gin.Context) {
 }
 
 // @Summary Delete dumpster
+// @Description Refused with 409 while the dumpster has an active usage or a pending or confirmed booking that hasn't ended.
 // @Tags dumpsters
 // @Accept json
 // @Produce json
@@ -167,6 +304,7 @@ func (c *DumpsterController) update(ctx *gin.Context) {
 // @Failure 401 {object} map[string]string
 // @Failure 403 {object} map[string]string
 // @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
 // @Router /api/v1/dumpsters/{id} [delete]
 func (c *DumpsterController) delete(ctx *gin.Context) {
 	userID, ok := c.getUserIDFromContext(ctx)
@@ -184,21 +322,143 @@ func (c *DumpsterController) delete(ctx *gin.Context) {
 	ctx.JSON(http.StatusNoContent, nil)
 }
 
+// @Summary Delete all my dumpsters
+// @Description Soft-deletes every dumpster owned by the caller. Requires confirm=true. Dumpsters with an active usage are skipped unless force=true, which cancels those usages first. Dumpsters with a pending or confirmed booking that hasn't ended are always skipped.
+// @Tags dumpsters
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param confirm query bool true "Must be true to proceed"
+// @Param force query bool false "Cancel active usages instead of skipping their dumpsters"
+// @Success 200 {object} dto.DeleteOwnerDumpstersResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /api/v1/dumpsters/mine [delete]
+func (c *DumpsterController) deleteMine(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	var req dto.DeleteOwnerDumpstersRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.dumpsterService.DeleteAllByOwner(ctx.Request.Context(), userID, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary List my dumpsters needing attention
+// @Description Listings with open flags, recent negative reviews, a low rating or long inactivity, most urgent first, each with the reasons it was picked.
+// @Tags dumpsters
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.DumpsterNeedsAttentionResponse
+// @Failure 401 {object} map[string]string
+// @Router /api/v1/dumpsters/mine/needs-attention [get]
+func (c *DumpsterController) needsAttention(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := c.dumpsterService.GetNeedsAttention(ctx.Request.Context(), userID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Find my duplicate dumpsters
+// @Description Lists pairs of the caller's dumpsters at the same address or within 100m of each other with similar titles.
+// @Tags dumpsters
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.DumpsterDuplicatesResponse
+// @Failure 401 {object} map[string]string
+// @Router /api/v1/dumpsters/mine/duplicates [get]
+func (c *DumpsterController) duplicates(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := c.dumpsterService.FindPotentialDuplicates(ctx.Request.Context(), userID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Merge duplicate dumpsters
+// @Description Moves the duplicate's reviews, usages and bookings onto the kept dumpster and deletes the duplicate. Refused with 409 if the duplicate's bookings or active usages would clash with the kept dumpster's.
+// @Tags dumpsters
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.MergeDumpstersRequest true "Dumpsters to merge"
+// @Success 200 {object} dto.DumpsterMergeResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Router /api/v1/dumpsters/mine/duplicates/merge [post]
+func (c *DumpsterController) mergeDuplicates(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	var req dto.MergeDumpstersRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.dumpsterService.MergeDuplicates(ctx.Request.Context(), userID, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
 // @Summary Search dumpsters
 // @Tags dumpsters
 // @Accept json
 // @Produce json
 // @Param q query string false "Search query"
-// @Param city query string false "City"
-// @Param state query string false "State"
-// @Param zipCode query string false "Zip code"
+// @Param city query string false "City (case-insensitive, partial match)"
+// @Param state query string false "State code (case-insensitive)"
+// @Param zipCode query string false "Zip code; fewer than 5 characters match as a prefix"
 // @Param minPrice query number false "Minimum price"
 // @Param maxPrice query number false "Maximum price"
 // @Param size query string false "Size: small|medium|large|extraLarge"
 // @Param isAvailable query boolean false "Available"
+// @Param tags query string false "Comma-separated tags; results carry all of them"
+// @Param sortBy query string false "Sort by: newest|price|rating|availability (defaults to the configured sort)"
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(20)
-// @Success 200 {object} dto.DumpsterListResponse
+// @Param createdFrom query string false "Only listings created at or after this time (RFC3339)"
+// @Param createdTo query string false "Only listings created before this time (RFC3339)"
+// @Param X-List-Envelope header string false "Response shape: items|legacy (defaults to the server setting)"
+// @Param X-Read-Consistency header string false "Set to primary to skip the read replica, e.g. right after a write"
+// @Success 200 {object} dto.PaginatedResponse[dto.DumpsterResponse]
 // @Failure 400 {object} map[string]string
 // @Router /api/v1/dumpsters/search [get]
 func (c *DumpsterController) search(ctx *gin.Context) {
@@ -214,7 +474,7 @@ func (c *DumpsterController) search(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, response)
+	respondPage(ctx, response, "dumpsters")
 }
 
 // @Summary Find nearby dumpsters
@@ -225,6 +485,10 @@ func (c *DumpsterController) search(ctx *gin.Context) {
 // @Param lng query number true "Longitude"
 // @Param maxDistance query number false "Maximum distance in km" default(25)
 // @Param limit query int false "Maximum results" default(20)
+// @Param maxPrice query number false "Maximum price per day"
+// @Param size query string false "Size: small|medium|large|extraLarge"
+// @Param availableNow query boolean false "Available now"
+// @Param X-Read-Consistency header string false "Set to primary to skip the read replica, e.g. right after a write"
 // @Success 200 {array} dto.DumpsterResponse
 // @Failure 400 {object} map[string]string
 // @Router /api/v1/dumpsters/nearby [get]
@@ -244,52 +508,730 @@ func (c *DumpsterController) nearby(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, response)
 }
 
-// @Summary Book dumpster
+// @Summary List featured dumpsters
+// @Description Curated listings for the homepage: featured, available, published and with an active owner, best rated first.
 // @Tags dumpsters
 // @Accept json
 // @Produce json
-// @Security BearerAuth
-// @Param id path string true "Dumpster ID"
-// @Param request body dto.BookDumpsterRequest true "Booking data"
-// @Success 201 {object} dto.BookingResponse
+// @Success 200 {array} dto.DumpsterResponse
+// @Router /api/v1/dumpsters/featured [get]
+func (c *DumpsterController) featured(ctx *gin.Context) {
+	response, err := c.dumpsterService.ListFeatured(ctx.Request.Context())
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Suggest a price for a new listing
+// @Description Suggests a daily price range from the 25th-75th percentile of same-size listings within 25km. sufficientData is false, and no prices are returned, when fewer than 5 comparables exist.
+// @Tags dumpsters
+// @Accept json
+// @Produce json
+// @Param lat query number true "Latitude"
+// @Param lng query number true "Longitude"
+// @Param size query string true "Dumpster size (small, medium, large, extraLarge)"
+// @Param X-Read-Consistency header string false "Set to primary to skip the read replica, e.g. right after a write"
+// @Success 200 {object} dto.PriceSuggestionResponse
 // @Failure 400 {object} map[string]string
-// @Failure 401 {object} map[string]string
-// @Router /api/v1/dumpsters/{id}/book [post]
-func (c *DumpsterController) book(ctx *gin.Context) {
-	userID, ok := c.getUserIDFromContext(ctx)
-	if !ok {
+// @Router /api/v1/dumpsters/price-suggestion [get]
+func (c *DumpsterController) priceSuggestion(ctx *gin.Context) {
+	var req dto.PriceSuggestionRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
 		return
 	}
 
-	id := ctx.Param("id")
+	response, err := c.dumpsterService.SuggestPrice(ctx.Request.Context(), req.Latitude, req.Longitude, req.Size)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
 
-	var req dto.BookDumpsterRequest
-	if err := ctx.ShouldBindJSON(&req); err != nil {
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Get dumpster size guidance
+// @Description Recommends a dumpster size for a project type such as kitchen-remodel or roof-tear-off, with a short rationale and a link to a search for that size. Without project, the whole guide is returned.
+// @Tags dumpsters
+// @Accept json
+// @Produce json
+// @Param project query string false "Project type; spaces and hyphens are interchangeable"
+// @Success 200 {object} dto.SizeGuideResponse
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/dumpsters/size-guide [get]
+func (c *DumpsterController) sizeGuide(ctx *gin.Context) {
+	var req dto.SizeGuideRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
 		handleError(ctx, apperrors.BadRequest(err.Error()))
 		return
 	}
 
-	response, err := c.dumpsterService.BookDumpster(ctx.Request.Context(), userID, id, req)
+	response, err := c.dumpsterService.GetSizeGuide(ctx.Request.Context(), req.Project)
 	if err != nil {
 		handleError(ctx, err)
 		return
 	}
 
-	ctx.JSON(http.StatusCreated, response)
+	ctx.JSON(http.StatusOK, response)
 }
 
-// @Summary Check dumpster availability
+// @Summary Get distance to a dumpster
+// @Description Straight-line distance is always returned; driving distance and ETA only when a routing provider is configured.
 // @Tags dumpsters
 // @Accept json
 // @Produce json
 // @Param id path string true "Dumpster ID"
-// @Success 200 {object} dto.AvailabilityResponse
+// @Param lat query number true "Latitude"
+// @Param lng query number true "Longitude"
+// @Success 200 {object} dto.DumpsterDistanceResponse
+// @Failure 400 {object} map[string]string
 // @Failure 404 {object} map[string]string
-// @Router /api/v1/dumpsters/{id}/availability [get]
-func (c *DumpsterController) checkAvailability(ctx *gin.Context) {
-	id := ctx.Param("id")
+// @Router /api/v1/dumpsters/{id}/distance [get]
+func (c *DumpsterController) distance(ctx *gin.Context) {
+	var req dto.DumpsterDistanceRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
 
-	response, err := c.dumpsterService.CheckAvailability(ctx.Request.Context(), id)
+	response, err := c.dumpsterService.GetDistance(ctx.Request.Context(), ctx.Param("id"), req.Latitude, req.Longitude)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary List dumpsters frequently used together with this one
+// @Description Other published listings used or booked by the same people, ordered by how many users they share. Only the listing's 500 most recent users are considered.
+// @Tags dumpsters
+// @Accept json
+// @Produce json
+// @Param id path string true "Dumpster ID"
+// @Param limit query int false "Maximum results (1-50)" default(10)
+// @Success 200 {array} dto.PairedDumpsterResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/paired [get]
+func (c *DumpsterController) paired(ctx *gin.Context) {
+	var req dto.PairedDumpstersRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.dumpsterService.GetFrequentlyPairedWith(ctx.Request.Context(), ctx.Param("id"), req.Limit)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Validate a listing address
+// @Description Looks the address up with the geocoder before a listing is created. found is false, with no coordinates, when the address isn't recognized.
+// @Tags dumpsters
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.ValidateAddressRequest true "Address"
+// @Success 200 {object} dto.AddressValidationResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 429 {object} map[string]string
+// @Router /api/v1/dumpsters/validate-address [post]
+func (c *DumpsterController) validateAddress(ctx *gin.Context) {
+	var req dto.ValidateAddressRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.dumpsterService.ValidateAddress(ctx.Request.Context(), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Get available dumpster density grid
+// @Tags dumpsters
+// @Accept json
+// @Produce json
+// @Param minLat query number true "Southern latitude bound"
+// @Param minLng query number true "Western longitude bound"
+// @Param maxLat query number true "Northern latitude bound"
+// @Param maxLng query number true "Eastern longitude bound"
+// @Param gridSize query int false "Cells per axis" default(10)
+// @Param X-Read-Consistency header string false "Set to primary to skip the read replica, e.g. right after a write"
+// @Success 200 {object} dto.DumpsterDensityResponse
+// @Failure 400 {object} map[string]string
+// @Router /api/v1/dumpsters/density [get]
+func (c *DumpsterController) density(ctx *gin.Context) {
+	var req dto.DumpsterDensityRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.dumpsterService.GetDensity(ctx.Request.Context(), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Get price statistics for an area
+// @Tags dumpsters
+// @Accept json
+// @Produce json
+// @Param city query string false "City"
+// @Param state query string false "State code (case-insensitive)"
+// @Param zipCode query string false "Zip code; fewer than 5 characters match as a prefix"
+// @Param X-Read-Consistency header string false "Set to primary to skip the read replica, e.g. right after a write"
+// @Success 200 {object} dto.DumpsterPriceStatsResponse
+// @Failure 400 {object} map[string]string
+// @Router /api/v1/dumpsters/price-stats [get]
+func (c *DumpsterController) priceStats(ctx *gin.Context) {
+	var req dto.DumpsterPriceStatsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.dumpsterService.GetPriceStats(ctx.Request.Context(), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Book dumpster
+// @Tags dumpsters
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dumpster ID"
+// @Param request body dto.BookDumpsterRequest true "Booking data"
+// @Success 201 {object} dto.BookingResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/book [post]
+func (c *DumpsterController) book(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	id := ctx.Param("id")
+
+	var req dto.BookDumpsterRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.dumpsterService.BookDumpster(ctx.Request.Context(), userID, id, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response)
+}
+
+// @Summary Hold dumpster dates
+// @Description Holds the dates for the caller for a few minutes so no one else can book them. Pass the returned token as holdToken when booking; unused holds expire on their own.
+// @Tags dumpsters
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dumpster ID"
+// @Param request body dto.HoldDumpsterRequest true "Dates to hold"
+// @Success 201 {object} dto.DumpsterHoldResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/hold [post]
+func (c *DumpsterController) hold(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	var req dto.HoldDumpsterRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.dumpsterService.Hold(ctx.Request.Context(), userID, ctx.Param("id"), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response)
+}
+
+// @Summary Get a rental quote
+// @Description Prices renting the dumpster for the given dates, including tax. No sign-in is required and the response contains no owner details.
+// @Tags dumpsters
+// @Accept json
+// @Produce json
+// @Param id path string true "Dumpster ID"
+// @Param startDate query string true "Rental start (RFC3339)"
+// @Param endDate query string true "Rental end (RFC3339)"
+// @Success 200 {object} dto.DumpsterQuoteResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/quote [get]
+func (c *DumpsterController) quote(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	var req dto.DumpsterQuoteRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.dumpsterService.Quote(ctx.Request.Context(), id, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Check dumpster availability
+// @Description Public; no sign-in is required. When the dumpster can't be rented right now, reason says why: unpublished, owner_disabled, snoozed, in_use or booked.
+// @Tags dumpsters
+// @Accept json
+// @Produce json
+// @Param id path string true "Dumpster ID"
+// @Success 200 {object} dto.AvailabilityResponse
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/availability [get]
+func (c *DumpsterController) checkAvailability(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	response, err := c.dumpsterService.CheckAvailability(ctx.Request.Context(), id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Check availability of several dumpsters
+// @Description Public; no sign-in is required. Returns availability keyed by dumpster ID for up to 50 IDs; IDs that match no dumpster are left out.
+// @Tags dumpsters
+// @Accept json
+// @Produce json
+// @Param ids query string true "Comma-separated dumpster IDs"
+// @Success 200 {object} map[string]dto.AvailabilityResponse
+// @Failure 400 {object} map[string]string
+// @Router /api/v1/dumpsters/availability [get]
+func (c *DumpsterController) checkAvailabilityBatch(ctx *gin.Context) {
+	ids := strings.Split(ctx.Query("ids"), ",")
+
+	response, err := c.dumpsterService.CheckAvailabilityBatch(ctx.Request.Context(), ids)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Create dumpster share link
+// @Description Signs an expiring link that shows the listing even while it is unpublished. expiresInHours defaults to 168 and may be at most 720.
+// @Tags dumpsters
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dumpster ID"
+// @Param request body dto.CreateShareLinkRequest false "Link lifetime"
+// @Success 201 {object} dto.ShareLinkResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/share-links [post]
+func (c *DumpsterController) createShareLink(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateShareLinkRequest
+	if ctx.Request.ContentLength != 0 {
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			handleError(ctx, apperrors.BadRequest(err.Error()))
+			return
+		}
+	}
+
+	ttl := service.DefaultShareLinkTTL
+	if req.ExpiresInHours != 0 {
+		ttl = time.Duration(req.ExpiresInHours) * time.Hour
+	}
+
+	response, err := c.dumpsterService.CreateShareLink(ctx.Request.Context(), userID, ctx.Param("id"), ttl)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response)
+}
+
+// @Summary Revoke dumpster share links
+// @Description Invalidates every share link issued for the dumpster.
+// @Tags dumpsters
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dumpster ID"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/share-links [delete]
+func (c *DumpsterController) revokeShareLinks(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	if err := c.dumpsterService.RevokeShareLinks(ctx.Request.Context(), userID, ctx.Param("id")); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
+
+// @Summary Get shared dumpster
+// @Description Resolves a share link token to its listing, whether or not the listing is published.
+// @Tags dumpsters
+// @Accept json
+// @Produce json
+// @Param token path string true "Share token"
+// @Success 200 {object} dto.DumpsterResponse
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/dumpsters/shared/{token} [get]
+func (c *DumpsterController) getShared(ctx *gin.Context) {
+	response, err := c.dumpsterService.GetShared(ctx.Request.Context(), ctx.Param("token"))
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Snooze dumpster availability
+// @Tags dumpsters
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dumpster ID"
+// @Param request body dto.SnoozeDumpsterRequest true "Snooze data"
+// @Success 200 {object} dto.DumpsterResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/snooze [post]
+func (c *DumpsterController) snooze(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	id := ctx.Param("id")
+
+	var req dto.SnoozeDumpsterRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.dumpsterService.Snooze(ctx.Request.Context(), userID, id, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Flag dumpster for moderation
+// @Description Reports a fraudulent or inappropriate listing. A listing collecting enough open flags is unpublished until an admin reviews it.
+// @Tags dumpsters
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dumpster ID"
+// @Param request body dto.FlagDumpsterRequest true "Flag reason"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/flag [post]
+func (c *DumpsterController) flag(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	var req dto.FlagDumpsterRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	if err := c.dumpsterService.Flag(ctx.Request.Context(), userID, ctx.Param("id"), req); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
+
+// @Summary Clone dumpster
+// @Description Copies one of the caller's listings into a new unpublished draft titled "... (copy)". Reviews, ratings, usages and images are not copied. Publish the draft once edited.
+// @Tags dumpsters
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dumpster ID"
+// @Success 201 {object} dto.DumpsterResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/clone [post]
+func (c *DumpsterController) clone(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := c.dumpsterService.Clone(ctx.Request.Context(), userID, ctx.Param("id"))
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response)
+}
+
+// @Summary Publish dumpster
+// @Description Makes an unpublished listing, such as a clone, visible. Listings hidden by moderation can't be published by their owner.
+// @Tags dumpsters
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dumpster ID"
+// @Success 200 {object} dto.DumpsterResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/publish [post]
+func (c *DumpsterController) publish(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := c.dumpsterService.Publish(ctx.Request.Context(), userID, ctx.Param("id"))
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Cancel dumpster snooze
+// @Tags dumpsters
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dumpster ID"
+// @Success 200 {object} dto.DumpsterResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/snooze [delete]
+func (c *DumpsterController) unsnooze(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	id := ctx.Param("id")
+
+	response, err := c.dumpsterService.Unsnooze(ctx.Request.Context(), userID, id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Get dumpster activity timeline
+// @Description Creation, price changes, bookings, usages and reviews, newest first. Pass nextBefore and nextBeforeId from the previous page as before and beforeId to continue.
+// @Tags dumpsters
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dumpster ID"
+// @Param before query string false "Only events before this RFC3339 timestamp"
+// @Param beforeId query string false "Event ID paired with before, from nextBeforeId"
+// @Param limit query int false "Maximum events" default(20)
+// @Success 200 {object} dto.DumpsterTimelineResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/timeline [get]
+func (c *DumpsterController) timeline(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	id := ctx.Param("id")
+
+	var req dto.DumpsterTimelineRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.dumpsterService.GetTimeline(ctx.Request.Context(), userID, id, middleware.IsAdmin(ctx), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary List dumpster revisions
+// @Description Every owner edit of the listing, newest first, with the fields each edit changed. Owner or admin only.
+// @Tags dumpsters
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dumpster ID"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} dto.DumpsterRevisionListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/revisions [get]
+func (c *DumpsterController) revisions(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	var req dto.DumpsterRevisionListRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.dumpsterService.ListRevisions(ctx.Request.Context(), userID, ctx.Param("id"), middleware.IsAdmin(ctx), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	respondPage(ctx, response, "revisions")
+}
+
+// @Summary Get dumpster revision
+// @Description The full snapshot of the listing as saved by one edit. Owner or admin only.
+// @Tags dumpsters
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dumpster ID"
+// @Param rev path int true "Revision number"
+// @Success 200 {object} dto.DumpsterRevisionResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/revisions/{rev} [get]
+func (c *DumpsterController) revision(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	rev, err := strconv.Atoi(ctx.Param("rev"))
+	if err != nil {
+		handleError(ctx, apperrors.BadRequest("invalid revision"))
+		return
+	}
+
+	response, err := c.dumpsterService.GetRevision(ctx.Request.Context(), userID, ctx.Param("id"), middleware.IsAdmin(ctx), rev)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Revert dumpster to a revision
+// @Description Restores the listing's details and tags from an earlier revision and records the revert as a new revision. Fails with 409 if the listing is edited at the same time.
+// @Tags dumpsters
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dumpster ID"
+// @Param revisionId path int true "Revision number to restore"
+// @Success 200 {object} dto.DumpsterResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/revert/{revisionId} [post]
+func (c *DumpsterController) revert(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := c.dumpsterService.RevertToRevision(ctx.Request.Context(), userID, ctx.Param("id"), ctx.Param("revisionId"))
 	if err != nil {
 		handleError(ctx, err)
 		return
@@ -0,0 +1,161 @@
+package v1
+
+import (
+	"net/http"
+	"waste-space/internal/dto"
+	"waste-space/internal/middleware"
+	"waste-space/internal/service"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DumpsterImageController struct {
+	imageService service.DumpsterImageService
+}
+
+func NewDumpsterImageController(imageService service.DumpsterImageService) *DumpsterImageController {
+	return &DumpsterImageController{
+		imageService: imageService,
+	}
+}
+
+func (c *DumpsterImageController) initDumpsterImageRoutes(rg *gin.RouterGroup, authMiddleware gin.HandlerFunc) {
+	images := rg.Group("/dumpsters/:id/images")
+	{
+		images.GET("", c.list)
+
+		images.Use(authMiddleware)
+		{
+			images.POST("/uploads", c.createUploads)
+			images.POST("/confirm", c.confirm)
+			images.DELETE("/:imageId", c.delete)
+		}
+	}
+}
+
+// @Summary List dumpster images
+// @Tags images
+// @Accept json
+// @Produce json
+// @Param id path string true "Dumpster ID"
+// @Success 200 {array} dto.DumpsterImageResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/images [get]
+func (c *DumpsterImageController) list(ctx *gin.Context) {
+	response, err := c.imageService.List(ctx.Request.Context(), ctx.Param("id"))
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Request image upload URLs
+// @Description Returns one presigned upload per requested image (image/jpeg, image/png or image/webp). Upload the bytes with the given method and headers, then confirm the keys. A dumpster holds at most 10 images.
+// @Tags images
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dumpster ID"
+// @Param request body dto.CreateImageUploadsRequest true "Images to upload"
+// @Success 200 {object} dto.ImageUploadsResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/images/uploads [post]
+func (c *DumpsterImageController) createUploads(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateImageUploadsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.imageService.CreateUploads(ctx.Request.Context(), userID, ctx.Param("id"), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Confirm uploaded images
+// @Description Records uploaded images on the dumpster. Each key must have been issued for this dumpster and already uploaded.
+// @Tags images
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dumpster ID"
+// @Param request body dto.ConfirmImageUploadsRequest true "Uploaded keys"
+// @Success 201 {array} dto.DumpsterImageResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/images/confirm [post]
+func (c *DumpsterImageController) confirm(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	var req dto.ConfirmImageUploadsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.imageService.Confirm(ctx.Request.Context(), userID, ctx.Param("id"), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response)
+}
+
+// @Summary Delete dumpster image
+// @Tags images
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dumpster ID"
+// @Param imageId path string true "Image ID"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/images/{imageId} [delete]
+func (c *DumpsterImageController) delete(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	if err := c.imageService.Delete(ctx.Request.Context(), userID, ctx.Param("id"), ctx.Param("imageId")); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
+
+func (c *DumpsterImageController) getUserIDFromContext(ctx *gin.Context) (string, bool) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		handleError(ctx, apperrors.Unauthorized("unauthorized"))
+		return "", false
+	}
+	return userID.String(), true
+}
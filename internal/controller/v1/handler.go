@@ -1,6 +1,8 @@
 package v1
 
 import (
+	"net/http"
+	"strings"
 	"waste-space/internal/middleware"
 	"waste-space/internal/service"
 	"waste-space/pkg/auth"
@@ -13,12 +15,29 @@ import (
 )
 
 type Handler struct {
-	authController     *AuthController
-	userController     *UserController
-	dumpsterController *DumpsterController
-	reviewController   *ReviewController
-	usageController    *UsageController
-	tokenService       auth.TokenService
+	authController         *AuthController
+	userController         *UserController
+	dumpsterController     *DumpsterController
+	reviewController       *ReviewController
+	usageController        *UsageController
+	bookingController      *BookingController
+	notificationController *NotificationController
+	dashboardController    *DashboardController
+	alertController        *AvailabilityAlertController
+	pricingRuleController  *PricingRuleController
+	imageController        *DumpsterImageController
+	blockController        *OwnerBlockController
+	searchController       *SearchController
+	adminController        *AdminController
+	maintenanceService     service.MaintenanceService
+	tokenService           auth.TokenService
+	apiKeys                middleware.APIKeyAuthenticator
+	serviceToken           string
+	browseRateLimit        gin.HandlerFunc
+	authRateLimit          gin.HandlerFunc
+	geocodeRateLimit       gin.HandlerFunc
+	resendRateLimit        gin.HandlerFunc
+	legacyListEnvelope     bool
 }
 
 func NewHandler(
@@ -26,28 +45,106 @@ func NewHandler(
 	dumpsterService service.DumpsterService,
 	reviewService service.ReviewService,
 	usageService service.UsageService,
-	tokenService auth.TokenService) *Handler {
+	bookingService service.BookingService,
+	paymentService service.PaymentService,
+	notificationService service.NotificationService,
+	dashboardService service.DashboardService,
+	alertService service.AvailabilityAlertService,
+	pricingRuleService service.PricingRuleService,
+	imageService service.DumpsterImageService,
+	ownerBlockService service.OwnerBlockService,
+	searchService service.SearchService,
+	maintenanceService service.MaintenanceService,
+	tokenService auth.TokenService,
+	serviceToken string,
+	browseRateLimit gin.HandlerFunc,
+	authRateLimit gin.HandlerFunc,
+	geocodeRateLimit gin.HandlerFunc,
+	resendRateLimit gin.HandlerFunc,
+	legacyListEnvelope bool) *Handler {
 	return &Handler{
-		authController:     NewAuthController(userService),
-		userController:     NewUserController(userService),
-		dumpsterController: NewDumpsterController(dumpsterService),
-		reviewController:   NewReviewController(reviewService),
-		usageController:    NewUsageController(usageService),
-		tokenService:       tokenService,
+		authController:         NewAuthController(userService),
+		userController:         NewUserController(userService),
+		dumpsterController:     NewDumpsterController(dumpsterService),
+		reviewController:       NewReviewController(reviewService),
+		usageController:        NewUsageController(usageService),
+		bookingController:      NewBookingController(bookingService, paymentService),
+		notificationController: NewNotificationController(notificationService),
+		dashboardController:    NewDashboardController(dashboardService),
+		alertController:        NewAvailabilityAlertController(alertService),
+		pricingRuleController:  NewPricingRuleController(pricingRuleService),
+		imageController:        NewDumpsterImageController(imageService),
+		blockController:        NewOwnerBlockController(ownerBlockService),
+		searchController:       NewSearchController(searchService),
+		adminController:        NewAdminController(maintenanceService, reviewService, dumpsterService, usageService),
+		maintenanceService:     maintenanceService,
+		tokenService:           tokenService,
+		apiKeys:                userService,
+		serviceToken:           serviceToken,
+		browseRateLimit:        browseRateLimit,
+		authRateLimit:          authRateLimit,
+		geocodeRateLimit:       geocodeRateLimit,
+		resendRateLimit:        resendRateLimit,
+		legacyListEnvelope:     legacyListEnvelope,
 	}
 }
 
 func (h *Handler) InitRoutes(router *gin.Engine) {
+	router.Use(middleware.Maintenance(h.maintenanceService,
+		"/health",
+		"/api/v1/admin/maintenance",
+		"/api/v1/auth/login",
+		"/api/v1/auth/refresh",
+	))
+
+	router.GET("/health", h.health)
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
-	authMW := middleware.Auth(h.tokenService)
+	authMW := middleware.Auth(h.tokenService, h.apiKeys)
+	optionalAuthMW := middleware.OptionalAuth(h.tokenService, h.apiKeys)
+	adminMW := middleware.RequireAdmin()
+	introspectMW := middleware.AuthOrServiceToken(h.tokenService, h.apiKeys, h.serviceToken)
 
-	v1 := router.Group("/api/v1")
+	// optional auth runs first so the browse limit can key signed-in users
+	// by user ID instead of IP.
+	v1 := router.Group("/api/v1", optionalAuthMW, h.browseRateLimit, middleware.ListEnvelope(h.legacyListEnvelope))
 	{
-		h.authController.initAuthRoutes(v1)
+		h.authController.initAuthRoutes(v1, authMW, introspectMW, h.authRateLimit)
 		h.userController.initUserRoutes(v1, authMW)
-		h.dumpsterController.initDumpsterRoutes(v1, authMW)
+		h.dumpsterController.initDumpsterRoutes(v1, authMW, optionalAuthMW, h.geocodeRateLimit)
 		h.reviewController.initReviewRoutes(v1, authMW)
 		h.usageController.initUsageRoutes(v1, authMW)
+		h.bookingController.initBookingRoutes(v1, authMW, h.resendRateLimit)
+		h.notificationController.initNotificationRoutes(v1, authMW)
+		h.dashboardController.initDashboardRoutes(v1, authMW)
+		h.alertController.initAvailabilityAlertRoutes(v1, authMW)
+		h.pricingRuleController.initPricingRuleRoutes(v1, authMW)
+		h.imageController.initDumpsterImageRoutes(v1, authMW)
+		h.blockController.initOwnerBlockRoutes(v1, authMW)
+		h.searchController.initSearchRoutes(v1)
+		h.adminController.initAdminRoutes(v1, authMW, adminMW)
+	}
+}
+
+// @Summary Health check
+// @Tags health
+// @Produce json
+// @Success 200 {object} map[string]string
+// @Router /health [get]
+func (h *Handler) health(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+func splitIDs(raw string) []string {
+	if raw == "" {
+		return nil
+	}
+
+	var ids []string
+	for _, id := range strings.Split(raw, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
 	}
+	return ids
 }
@@ -0,0 +1,219 @@
+package v1
+
+import (
+	"net/http"
+	"waste-space/internal/dto"
+	"waste-space/internal/middleware"
+	"waste-space/internal/service"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type NotificationController struct {
+	notificationService service.NotificationService
+}
+
+func NewNotificationController(notificationService service.NotificationService) *NotificationController {
+	return &NotificationController{
+		notificationService: notificationService,
+	}
+}
+
+func (c *NotificationController) initNotificationRoutes(rg *gin.RouterGroup, authMiddleware gin.HandlerFunc) {
+	notifications := rg.Group("/notifications")
+	notifications.Use(authMiddleware)
+	{
+		notifications.GET("", c.list)
+		notifications.POST("/read", c.markRead)
+		notifications.POST("/read-all", c.markAllRead)
+		notifications.POST("/:id/read", c.markAsRead)
+	}
+
+	preferences := rg.Group("/users/me/notification-preferences")
+	preferences.Use(authMiddleware)
+	{
+		preferences.GET("", c.getPreferences)
+		preferences.PUT("", c.updatePreferences)
+	}
+}
+
+// @Summary List notifications
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number"
+// @Param limit query int false "Items per page"
+// @Param unreadOnly query bool false "Only unread notifications"
+// @Param X-List-Envelope header string false "Response shape: items|legacy (defaults to the server setting)"
+// @Success 200 {object} dto.PaginatedResponse[dto.NotificationResponse]
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /api/v1/notifications [get]
+func (c *NotificationController) list(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	var req dto.NotificationListRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.notificationService.List(ctx.Request.Context(), userID, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	respondPage(ctx, response, "notifications")
+}
+
+// @Summary Mark notification as read
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Notification ID"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/notifications/{id}/read [post]
+func (c *NotificationController) markAsRead(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	id := ctx.Param("id")
+
+	if err := c.notificationService.MarkAsRead(ctx.Request.Context(), userID, id); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// @Summary Mark notifications as read
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.MarkNotificationsReadRequest true "Notification IDs"
+// @Success 200 {object} dto.MarkNotificationsReadResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /api/v1/notifications/read [post]
+func (c *NotificationController) markRead(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	var req dto.MarkNotificationsReadRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.notificationService.MarkRead(ctx.Request.Context(), userID, req.IDs)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Mark all notifications as read
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.MarkNotificationsReadResponse
+// @Failure 401 {object} map[string]string
+// @Router /api/v1/notifications/read-all [post]
+func (c *NotificationController) markAllRead(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := c.notificationService.MarkAllRead(ctx.Request.Context(), userID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Get notification preferences
+// @Description Returns the channel settings for every notification type, with defaults filled in for types the user has not changed
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.NotificationPreferencesResponse
+// @Failure 401 {object} map[string]string
+// @Router /api/v1/users/me/notification-preferences [get]
+func (c *NotificationController) getPreferences(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := c.notificationService.GetPreferences(ctx.Request.Context(), userID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Update notification preferences
+// @Description Sets the channels for the listed notification types; types not listed keep their current settings
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.UpdateNotificationPreferencesRequest true "Preferences to change"
+// @Success 200 {object} dto.NotificationPreferencesResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /api/v1/users/me/notification-preferences [put]
+func (c *NotificationController) updatePreferences(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateNotificationPreferencesRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.notificationService.UpdatePreferences(ctx.Request.Context(), userID, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *NotificationController) getUserIDFromContext(ctx *gin.Context) (string, bool) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		handleError(ctx, apperrors.Unauthorized("unauthorized"))
+		return "", false
+	}
+	return userID.String(), true
+}
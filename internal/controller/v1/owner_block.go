@@ -0,0 +1,120 @@
+package v1
+
+import (
+	"net/http"
+	"waste-space/internal/dto"
+	"waste-space/internal/middleware"
+	"waste-space/internal/service"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type OwnerBlockController struct {
+	blockService service.OwnerBlockService
+}
+
+func NewOwnerBlockController(blockService service.OwnerBlockService) *OwnerBlockController {
+	return &OwnerBlockController{
+		blockService: blockService,
+	}
+}
+
+func (c *OwnerBlockController) initOwnerBlockRoutes(rg *gin.RouterGroup, authMiddleware gin.HandlerFunc) {
+	blocks := rg.Group("/users/me/blocks")
+	blocks.Use(authMiddleware)
+	{
+		blocks.GET("", c.list)
+		blocks.POST("", c.block)
+		blocks.DELETE("/:userId", c.unblock)
+	}
+}
+
+// @Summary List blocked users
+// @Description Users the caller has blocked from booking or using their dumpsters, most recent first.
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} dto.OwnerBlockResponse
+// @Failure 401 {object} map[string]string
+// @Router /api/v1/users/me/blocks [get]
+func (c *OwnerBlockController) list(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := c.blockService.List(ctx.Request.Context(), userID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Block a user
+// @Description Stops the user from booking or starting a usage on any of the caller's dumpsters. The blocked user only sees that the dumpster is unavailable. Blocking someone already blocked is a no-op.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateOwnerBlockRequest true "User to block"
+// @Success 201 {object} dto.OwnerBlockResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/users/me/blocks [post]
+func (c *OwnerBlockController) block(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateOwnerBlockRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.blockService.Block(ctx.Request.Context(), userID, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response)
+}
+
+// @Summary Unblock a user
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Param userId path string true "Blocked user ID"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/users/me/blocks/{userId} [delete]
+func (c *OwnerBlockController) unblock(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	if err := c.blockService.Unblock(ctx.Request.Context(), userID, ctx.Param("userId")); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
+
+func (c *OwnerBlockController) getUserIDFromContext(ctx *gin.Context) (string, bool) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		handleError(ctx, apperrors.Unauthorized("unauthorized"))
+		return "", false
+	}
+	return userID.String(), true
+}
@@ -0,0 +1,27 @@
+package v1
+
+import (
+	"net/http"
+	"waste-space/internal/dto"
+	"waste-space/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// respondPage writes a paginated list. In the legacy envelope the items go
+// under legacyKey (e.g. "dumpsters") next to top-level paging fields, which
+// is how these endpoints responded before PaginatedResponse.
+func respondPage[T any](ctx *gin.Context, page *dto.PaginatedResponse[T], legacyKey string) {
+	if !middleware.UsesLegacyListEnvelope(ctx) {
+		ctx.JSON(http.StatusOK, page)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		legacyKey:    page.Items,
+		"total":      page.Meta.Total,
+		"page":       page.Meta.Page,
+		"limit":      page.Meta.Limit,
+		"totalPages": page.Meta.TotalPages,
+	})
+}
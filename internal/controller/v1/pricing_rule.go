@@ -0,0 +1,160 @@
+package v1
+
+import (
+	"net/http"
+	"waste-space/internal/dto"
+	"waste-space/internal/middleware"
+	"waste-space/internal/service"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PricingRuleController struct {
+	pricingRuleService service.PricingRuleService
+}
+
+func NewPricingRuleController(pricingRuleService service.PricingRuleService) *PricingRuleController {
+	return &PricingRuleController{
+		pricingRuleService: pricingRuleService,
+	}
+}
+
+func (c *PricingRuleController) initPricingRuleRoutes(rg *gin.RouterGroup, authMiddleware gin.HandlerFunc) {
+	rules := rg.Group("/dumpsters/:id/pricing-rules")
+	{
+		rules.GET("", c.list)
+
+		rules.Use(authMiddleware)
+		{
+			rules.POST("", c.create)
+			rules.PUT("/:ruleId", c.update)
+			rules.DELETE("/:ruleId", c.delete)
+		}
+	}
+}
+
+// @Summary List dumpster pricing rules
+// @Tags pricing
+// @Accept json
+// @Produce json
+// @Param id path string true "Dumpster ID"
+// @Success 200 {array} dto.PricingRuleResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/pricing-rules [get]
+func (c *PricingRuleController) list(ctx *gin.Context) {
+	response, err := c.pricingRuleService.List(ctx.Request.Context(), ctx.Param("id"))
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Create dumpster pricing rule
+// @Description Adjusts the daily price on a weekday (0 = Sunday) or an inclusive date range, by multiplier or fixed override. Date-range rules beat weekday rules; the newest rule wins among equals.
+// @Tags pricing
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dumpster ID"
+// @Param request body dto.PricingRuleRequest true "Pricing rule"
+// @Success 201 {object} dto.PricingRuleResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/pricing-rules [post]
+func (c *PricingRuleController) create(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	var req dto.PricingRuleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.pricingRuleService.Create(ctx.Request.Context(), userID, ctx.Param("id"), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response)
+}
+
+// @Summary Replace dumpster pricing rule
+// @Tags pricing
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dumpster ID"
+// @Param ruleId path string true "Pricing rule ID"
+// @Param request body dto.PricingRuleRequest true "Pricing rule"
+// @Success 200 {object} dto.PricingRuleResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/pricing-rules/{ruleId} [put]
+func (c *PricingRuleController) update(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	var req dto.PricingRuleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.pricingRuleService.Update(ctx.Request.Context(), userID, ctx.Param("id"), ctx.Param("ruleId"), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Delete dumpster pricing rule
+// @Tags pricing
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dumpster ID"
+// @Param ruleId path string true "Pricing rule ID"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/pricing-rules/{ruleId} [delete]
+func (c *PricingRuleController) delete(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	if err := c.pricingRuleService.Delete(ctx.Request.Context(), userID, ctx.Param("id"), ctx.Param("ruleId")); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
+
+func (c *PricingRuleController) getUserIDFromContext(ctx *gin.Context) (string, bool) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		handleError(ctx, apperrors.Unauthorized("unauthorized"))
+		return "", false
+	}
+	return userID.String(), true
+}
@@ -2,6 +2,7 @@ package v1
 
 import (
 	"net/http"
+	"strings"
 	"waste-space/internal/dto"
 	"waste-space/internal/middleware"
 	"waste-space/internal/service"
@@ -24,24 +25,66 @@ func (c *ReviewController) initReviewRoutes(rg *gin.RouterGroup, authMiddleware
 	reviews := rg.Group("/reviews")
 	{
 		reviews.GET("/:id", c.getByID)
+		reviews.GET("/:id/thread", c.getThread)
 
 		reviews.Use(authMiddleware)
 		{
 			reviews.GET("/user/:userId", c.getUserReviews)
+			reviews.POST("/:id/vote", c.vote)
+			reviews.DELETE("/:id/vote", c.removeVote)
 		}
 	}
 
+	rg.GET("/dumpsters/review-summaries", c.getSummaries)
+
 	dumpsters := rg.Group("/dumpsters/:id")
 	{
 		dumpsters.GET("/reviews", c.getDumpsterReviews)
+		dumpsters.GET("/reviews/sentiment", c.getSentiment)
 
 		dumpsters.Use(authMiddleware)
 		{
+			dumpsters.GET("/reviews/mine", c.getMine)
 			dumpsters.POST("/reviews", c.create)
 			dumpsters.PUT("/reviews/:reviewId", c.update)
 			dumpsters.DELETE("/reviews/:reviewId", c.delete)
 		}
 	}
+
+	rg.GET("/users/me/received-reviews/export", authMiddleware, c.exportReceived)
+	rg.GET("/users/me/reviews/unanswered", authMiddleware, c.getUnanswered)
+}
+
+// @Summary Export received reviews as CSV
+// @Description Streams every review on the caller's dumpsters, newest first. Columns: dumpster, rating, comment, reviewer, date.
+// @Tags reviews
+// @Produce text/csv
+// @Security BearerAuth
+// @Success 200 {file} file
+// @Failure 401 {object} map[string]string
+// @Router /api/v1/users/me/received-reviews/export [get]
+func (c *ReviewController) exportReceived(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	ctx.Header("Content-Type", "text/csv; charset=utf-8")
+	ctx.Header("Content-Disposition", `attachment; filename="received-reviews.csv"`)
+	ctx.Status(http.StatusOK)
+
+	if err := c.reviewService.ExportReceived(ctx.Request.Context(), userID, ctx.Writer); err != nil {
+		if !ctx.Writer.Written() {
+			ctx.Writer.Header().Del("Content-Type")
+			ctx.Writer.Header().Del("Content-Disposition")
+			handleError(ctx, err)
+			return
+		}
+		// Part of the CSV is already on the wire, so the error can only end
+		// the stream early.
+		_ = ctx.Error(err)
+		ctx.Abort()
+	}
 }
 
 // @Summary Get review by ID
@@ -64,6 +107,52 @@ func (c *ReviewController) getByID(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, response)
 }
 
+// @Summary Get review thread
+// @Description Returns the review together with the owner's reply, null until there is one, and its helpfulness vote totals in one nested object.
+// @Tags reviews
+// @Produce json
+// @Param id path string true "Review ID"
+// @Success 200 {object} dto.ReviewThreadResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/reviews/{id}/thread [get]
+func (c *ReviewController) getThread(ctx *gin.Context) {
+	response, err := c.reviewService.GetThread(ctx.Request.Context(), ctx.Param("id"))
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Get my review for dumpster
+// @Description Returns the caller's own review of the dumpster, so it can be edited without knowing its ID.
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dumpster ID"
+// @Success 200 {object} dto.ReviewResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/reviews/mine [get]
+func (c *ReviewController) getMine(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := c.reviewService.GetMine(ctx.Request.Context(), userID, ctx.Param("id"))
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
 // @Summary Create review for dumpster
 // @Tags reviews
 // @Accept json
@@ -99,6 +188,7 @@ func (c *ReviewController) create(ctx *gin.Context) {
 }
 
 // @Summary Update review
+// @Description Authors can edit a review only within the configured edit window after posting it. Admins are exempt. The low-rating comment rule applies to the edited review.
 // @Tags reviews
 // @Accept json
 // @Produce json
@@ -126,7 +216,7 @@ func (c *ReviewController) update(ctx *gin.Context) {
 		return
 	}
 
-	response, err := c.reviewService.Update(ctx.Request.Context(), userID, id, req)
+	response, err := c.reviewService.Update(ctx.Request.Context(), userID, id, middleware.IsAdmin(ctx), req)
 	if err != nil {
 		handleError(ctx, err)
 		return
@@ -163,6 +253,47 @@ func (c *ReviewController) delete(ctx *gin.Context) {
 	ctx.JSON(http.StatusNoContent, nil)
 }
 
+// @Summary Get review sentiment for dumpster
+// @Description Counts the dumpster's reviews as positive (4-5 stars), neutral (3) or negative (1-2), with percentages.
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Param id path string true "Dumpster ID"
+// @Success 200 {object} dto.ReviewSentimentResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/reviews/sentiment [get]
+func (c *ReviewController) getSentiment(ctx *gin.Context) {
+	response, err := c.reviewService.GetSentimentSummary(ctx.Request.Context(), ctx.Param("id"))
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Get review summaries for several dumpsters
+// @Description Public; no sign-in is required. Returns the average rating and review count keyed by dumpster ID for up to 50 IDs; IDs that match no dumpster are left out.
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Param ids query string true "Comma-separated dumpster IDs"
+// @Success 200 {object} map[string]dto.ReviewSummary
+// @Failure 400 {object} map[string]string
+// @Router /api/v1/dumpsters/review-summaries [get]
+func (c *ReviewController) getSummaries(ctx *gin.Context) {
+	ids := strings.Split(ctx.Query("ids"), ",")
+
+	response, err := c.reviewService.GetSummariesForDumpsters(ctx.Request.Context(), ids)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
 // @Summary Get reviews for dumpster
 // @Tags reviews
 // @Accept json
@@ -170,7 +301,9 @@ func (c *ReviewController) delete(ctx *gin.Context) {
 // @Param id path string true "Dumpster ID"
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(20)
-// @Success 200 {object} dto.ReviewListResponse
+// @Param sortBy query string false "Sort by: recent|helpful"
+// @Param X-List-Envelope header string false "Response shape: items|legacy (defaults to the server setting)"
+// @Success 200 {object} dto.PaginatedResponse[dto.ReviewResponse]
 // @Failure 400 {object} map[string]string
 // @Router /api/v1/dumpsters/{id}/reviews [get]
 func (c *ReviewController) getDumpsterReviews(ctx *gin.Context) {
@@ -188,7 +321,7 @@ func (c *ReviewController) getDumpsterReviews(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, response)
+	respondPage(ctx, response, "reviews")
 }
 
 // @Summary Get reviews by user
@@ -199,7 +332,8 @@ func (c *ReviewController) getDumpsterReviews(ctx *gin.Context) {
 // @Param userId path string true "User ID"
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(20)
-// @Success 200 {object} dto.ReviewListResponse
+// @Param X-List-Envelope header string false "Response shape: items|legacy (defaults to the server setting)"
+// @Success 200 {object} dto.PaginatedResponse[dto.ReviewResponse]
 // @Failure 400 {object} map[string]string
 // @Failure 401 {object} map[string]string
 // @Router /api/v1/reviews/user/{userId} [get]
@@ -218,6 +352,103 @@ func (c *ReviewController) getUserReviews(ctx *gin.Context) {
 		return
 	}
 
+	respondPage(ctx, response, "reviews")
+}
+
+// @Summary Get reviews awaiting an owner reply
+// @Description Lists reviews on the caller's dumpsters that have no owner reply yet, oldest first, with each dumpster's title.
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Param X-List-Envelope header string false "Response shape: items|legacy (defaults to the server setting)"
+// @Success 200 {object} dto.PaginatedResponse[dto.ReviewResponse]
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /api/v1/users/me/reviews/unanswered [get]
+func (c *ReviewController) getUnanswered(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	var req dto.ReviewListRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.reviewService.GetUnanswered(ctx.Request.Context(), userID, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	respondPage(ctx, response, "reviews")
+}
+
+// @Summary Vote on review helpfulness
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Review ID"
+// @Param request body dto.ReviewVoteRequest true "Vote data"
+// @Success 200 {object} dto.ReviewResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/reviews/{id}/vote [post]
+func (c *ReviewController) vote(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	id := ctx.Param("id")
+
+	var req dto.ReviewVoteRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.reviewService.Vote(ctx.Request.Context(), userID, id, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Remove review helpfulness vote
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Review ID"
+// @Success 200 {object} dto.ReviewResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/reviews/{id}/vote [delete]
+func (c *ReviewController) removeVote(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	id := ctx.Param("id")
+
+	response, err := c.reviewService.RemoveVote(ctx.Request.Context(), userID, id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
 	ctx.JSON(http.StatusOK, response)
 }
 
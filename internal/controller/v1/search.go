@@ -0,0 +1,51 @@
+package v1
+
+import (
+	"net/http"
+	"waste-space/internal/dto"
+	"waste-space/internal/service"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SearchController struct {
+	searchService service.SearchService
+}
+
+func NewSearchController(searchService service.SearchService) *SearchController {
+	return &SearchController{
+		searchService: searchService,
+	}
+}
+
+func (c *SearchController) initSearchRoutes(rg *gin.RouterGroup) {
+	rg.GET("/search", c.global)
+}
+
+// @Summary Search listings and reviews
+// @Description One search over listing titles, descriptions and locations and over review text. Results carry a type discriminator; listing hits come first, then review hits with the reviewed listing attached. Each kind is capped at limit.
+// @Tags search
+// @Accept json
+// @Produce json
+// @Param q query string true "Search text (at least 2 characters)"
+// @Param limit query int false "Maximum hits per type (1-20)" default(5)
+// @Param X-Read-Consistency header string false "Set to primary to skip the read replica, e.g. right after a write"
+// @Success 200 {object} dto.GlobalSearchResponse
+// @Failure 400 {object} map[string]string
+// @Router /api/v1/search [get]
+func (c *SearchController) global(ctx *gin.Context) {
+	var req dto.GlobalSearchRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.searchService.Global(ctx.Request.Context(), req.Query, req.Limit)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
@@ -25,9 +25,14 @@ func (c *UsageController) initUsageRoutes(rg *gin.RouterGroup, authMiddleware gi
 	usages.Use(authMiddleware)
 	{
 		usages.GET("/:id", c.getByID)
+		usages.GET("/:id/receipt", c.getReceipt)
+		usages.GET("/:id/running-cost", c.getRunningCost)
 		usages.GET("", c.list)
 		usages.GET("/stats", c.getStats)
+		usages.GET("/trends", c.getTrends)
 		usages.GET("/user/:userId", c.getUserUsages)
+		usages.PATCH("/:id", c.updateDraft)
+		usages.POST("/:id/activate", c.activate)
 		usages.DELETE("/:id", c.delete)
 	}
 
@@ -35,9 +40,16 @@ func (c *UsageController) initUsageRoutes(rg *gin.RouterGroup, authMiddleware gi
 	dumpsters.Use(authMiddleware)
 	{
 		dumpsters.POST("/usages/start", c.startUsage)
+		dumpsters.POST("/usages/drafts", c.createDraft)
 		dumpsters.PUT("/usages/:usageId/end", c.endUsage)
 		dumpsters.GET("/usages", c.getDumpsterUsages)
+		dumpsters.GET("/usages/mine", c.getMyDumpsterUsages)
+		dumpsters.GET("/occupancy", c.getOccupancy)
 	}
+
+	rg.GET("/users/me/balance", authMiddleware, c.getBalance)
+	rg.GET("/users/me/spending-report", authMiddleware, c.getSpendingReport)
+	rg.GET("/users/me/impact", authMiddleware, c.getImpact)
 }
 
 // @Summary Start dumpster usage
@@ -50,6 +62,7 @@ func (c *UsageController) initUsageRoutes(rg *gin.RouterGroup, authMiddleware gi
 // @Success 201 {object} dto.UsageResponse
 // @Failure 400 {object} map[string]string
 // @Failure 401 {object} map[string]string
+// @Failure 409 {object} map[string]string
 // @Router /api/v1/dumpsters/{id}/usages/start [post]
 func (c *UsageController) startUsage(ctx *gin.Context) {
 	userID, ok := c.getUserIDFromContext(ctx)
@@ -74,6 +87,115 @@ func (c *UsageController) startUsage(ctx *gin.Context) {
 	ctx.JSON(http.StatusCreated, response)
 }
 
+// @Summary Create draft usage
+// @Description Prepares a usage session that can be edited before it starts. Availability is only checked on activation.
+// @Tags usages
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dumpster ID"
+// @Param request body dto.CreateUsageDraftRequest true "Draft usage data"
+// @Success 201 {object} dto.UsageResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/usages/drafts [post]
+func (c *UsageController) createDraft(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	dumpsterID := ctx.Param("id")
+
+	var req dto.CreateUsageDraftRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.usageService.CreateDraft(ctx.Request.Context(), userID, dumpsterID, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response)
+}
+
+// @Summary Update draft usage
+// @Tags usages
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Usage ID"
+// @Param request body dto.UpdateUsageDraftRequest true "Draft changes"
+// @Success 200 {object} dto.UsageResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/usages/{id} [patch]
+func (c *UsageController) updateDraft(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateUsageDraftRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.usageService.UpdateDraft(ctx.Request.Context(), userID, ctx.Param("id"), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Activate draft usage
+// @Description Starts a draft usage session, checking availability as starting a usage does.
+// @Tags usages
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Usage ID"
+// @Param request body dto.ActivateUsageRequest false "Activation data"
+// @Success 200 {object} dto.UsageResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Router /api/v1/usages/{id}/activate [post]
+func (c *UsageController) activate(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	var req dto.ActivateUsageRequest
+	if ctx.Request.ContentLength != 0 {
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			handleError(ctx, apperrors.BadRequest(err.Error()))
+			return
+		}
+	}
+
+	response, err := c.usageService.Activate(ctx.Request.Context(), userID, ctx.Param("id"), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
 // @Summary End dumpster usage
 // @Tags usages
 // @Accept json
@@ -87,6 +209,7 @@ func (c *UsageController) startUsage(ctx *gin.Context) {
 // @Failure 401 {object} map[string]string
 // @Failure 403 {object} map[string]string
 // @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
 // @Router /api/v1/dumpsters/{id}/usages/{usageId}/end [put]
 func (c *UsageController) endUsage(ctx *gin.Context) {
 	userID, ok := c.getUserIDFromContext(ctx)
@@ -142,7 +265,8 @@ func (c *UsageController) getByID(ctx *gin.Context) {
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(20)
 // @Param status query string false "Filter by status (active, completed, cancelled)"
-// @Success 200 {object} dto.UsageListResponse
+// @Param X-List-Envelope header string false "Response shape: items|legacy (defaults to the server setting)"
+// @Success 200 {object} dto.PaginatedResponse[dto.UsageResponse]
 // @Failure 400 {object} map[string]string
 // @Failure 401 {object} map[string]string
 // @Router /api/v1/dumpsters/{id}/usages [get]
@@ -161,7 +285,43 @@ func (c *UsageController) getDumpsterUsages(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, response)
+	respondPage(ctx, response, "usages")
+}
+
+// @Summary Get my usages for dumpster
+// @Description Lists only the caller's own usage sessions on the dumpster.
+// @Tags usages
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dumpster ID"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Param status query string false "Filter by status (active, completed, cancelled)"
+// @Param X-List-Envelope header string false "Response shape: items|legacy (defaults to the server setting)"
+// @Success 200 {object} dto.PaginatedResponse[dto.UsageResponse]
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/usages/mine [get]
+func (c *UsageController) getMyDumpsterUsages(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	var req dto.UsageListRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.usageService.GetMyUsagesForDumpster(ctx.Request.Context(), userID, ctx.Param("id"), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	respondPage(ctx, response, "usages")
 }
 
 // @Summary Get usages by user
@@ -173,7 +333,8 @@ func (c *UsageController) getDumpsterUsages(ctx *gin.Context) {
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(20)
 // @Param status query string false "Filter by status (active, completed, cancelled)"
-// @Success 200 {object} dto.UsageListResponse
+// @Param X-List-Envelope header string false "Response shape: items|legacy (defaults to the server setting)"
+// @Success 200 {object} dto.PaginatedResponse[dto.UsageResponse]
 // @Failure 400 {object} map[string]string
 // @Failure 401 {object} map[string]string
 // @Router /api/v1/usages/user/{userId} [get]
@@ -192,7 +353,7 @@ func (c *UsageController) getUserUsages(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, response)
+	respondPage(ctx, response, "usages")
 }
 
 // @Summary List all usages with filters
@@ -205,7 +366,8 @@ func (c *UsageController) getUserUsages(ctx *gin.Context) {
 // @Param status query string false "Filter by status (active, completed, cancelled)"
 // @Param dumpsterId query string false "Filter by dumpster ID"
 // @Param userId query string false "Filter by user ID"
-// @Success 200 {object} dto.UsageListResponse
+// @Param X-List-Envelope header string false "Response shape: items|legacy (defaults to the server setting)"
+// @Success 200 {object} dto.PaginatedResponse[dto.UsageResponse]
 // @Failure 400 {object} map[string]string
 // @Failure 401 {object} map[string]string
 // @Router /api/v1/usages [get]
@@ -222,7 +384,7 @@ func (c *UsageController) list(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, response)
+	respondPage(ctx, response, "usages")
 }
 
 // @Summary Get usage statistics
@@ -236,6 +398,43 @@ func (c *UsageController) list(ctx *gin.Context) {
 // @Failure 400 {object} map[string]string
 // @Failure 401 {object} map[string]string
 // @Router /api/v1/usages/stats [get]
+// @Summary Get usage trends
+// @Description Usage counts and completed-usage revenue per bucket, gap-filled with zeros. Defaults to the caller's dumpsters over the last 30 days.
+// @Tags usages
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param dumpsterId query string false "Dumpster ID"
+// @Param ownerId query string false "Owner ID"
+// @Param granularity query string false "Bucket size: day|week|month" default(day)
+// @Param from query string false "Range start (RFC3339)"
+// @Param to query string false "Range end (RFC3339), defaults to now"
+// @Success 200 {object} dto.UsageTrendsResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Router /api/v1/usages/trends [get]
+func (c *UsageController) getTrends(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	var req dto.UsageTrendsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.usageService.GetTrends(ctx.Request.Context(), userID, middleware.IsAdmin(ctx), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
 func (c *UsageController) getStats(ctx *gin.Context) {
 	dumpsterID := ctx.Query("dumpsterId")
 	userID := ctx.Query("userId")
@@ -268,12 +467,18 @@ func (c *UsageController) getStats(ctx *gin.Context) {
 // @Param id path string true "Usage ID"
 // @Success 204
 // @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
 // @Failure 404 {object} map[string]string
 // @Router /api/v1/usages/{id} [delete]
 func (c *UsageController) delete(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
 	id := ctx.Param("id")
 
-	if err := c.usageService.Delete(ctx.Request.Context(), id); err != nil {
+	if err := c.usageService.Delete(ctx.Request.Context(), userID, id); err != nil {
 		handleError(ctx, err)
 		return
 	}
@@ -281,6 +486,182 @@ func (c *UsageController) delete(ctx *gin.Context) {
 	ctx.JSON(http.StatusNoContent, nil)
 }
 
+// @Summary Get usage receipt
+// @Tags usages
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Usage ID"
+// @Success 200 {object} dto.UsageReceiptResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/usages/{id}/receipt [get]
+func (c *UsageController) getReceipt(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	id := ctx.Param("id")
+
+	response, err := c.usageService.GetReceipt(ctx.Request.Context(), userID, id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Get usage running cost
+// @Description Cost of an active usage so far, prorated to the current minute. Completed usages return their final cost. Visible to the renter and the dumpster owner.
+// @Tags usages
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Usage ID"
+// @Success 200 {object} dto.UsageRunningCostResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/usages/{id}/running-cost [get]
+func (c *UsageController) getRunningCost(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	id := ctx.Param("id")
+
+	response, err := c.usageService.GetRunningCost(ctx.Request.Context(), userID, id)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Get dumpster occupancy
+// @Description Share of the period the dumpster spent in completed usages, with usages clipped to the period. Defaults to the last 30 days. Owner or admin only.
+// @Tags usages
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dumpster ID"
+// @Param from query string false "Period start (RFC3339)"
+// @Param to query string false "Period end (RFC3339), defaults to now"
+// @Success 200 {object} dto.OccupancyResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/dumpsters/{id}/occupancy [get]
+func (c *UsageController) getOccupancy(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	var req dto.OccupancyRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.usageService.GetOccupancy(ctx.Request.Context(), userID, ctx.Param("id"), middleware.IsAdmin(ctx), req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Get outstanding balance
+// @Description Sums the cost of the caller's completed usage sessions that have not been paid. Returns zero when nothing is owed.
+// @Tags usages
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.BalanceResponse
+// @Failure 401 {object} map[string]string
+// @Router /api/v1/users/me/balance [get]
+func (c *UsageController) getBalance(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := c.usageService.GetOutstandingBalance(ctx.Request.Context(), userID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Get environmental impact
+// @Description Totals the estimated waste diverted from landfill and CO2e avoided by the caller's completed usage sessions. Usages of dumpsters without a listed weight count as zero.
+// @Tags usages
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.ImpactResponse
+// @Failure 401 {object} map[string]string
+// @Router /api/v1/users/me/impact [get]
+func (c *UsageController) getImpact(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := c.usageService.GetImpact(ctx.Request.Context(), userID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Get spending report
+// @Description Totals the caller's completed usage sessions by the month they ended in and by dumpster size, with zeros for empty months and sizes. Defaults to the last 12 months; from is widened to the start of its month.
+// @Tags usages
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param from query string false "Range start (RFC3339)"
+// @Param to query string false "Range end, exclusive (RFC3339); defaults to now"
+// @Success 200 {object} dto.SpendingReportResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /api/v1/users/me/spending-report [get]
+func (c *UsageController) getSpendingReport(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	var req dto.SpendingReportRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.usageService.GetSpendingReport(ctx.Request.Context(), userID, req.From, req.To)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
 func (c *UsageController) getUserIDFromContext(ctx *gin.Context) (string, bool) {
 	userID, ok := middleware.GetUserID(ctx)
 	if !ok {
@@ -30,6 +30,15 @@ func (c *UserController) initUserRoutes(rg *gin.RouterGroup, authMiddleware gin.
 		users.PATCH("/me/phone", c.updatePhone)
 		users.PATCH("/me/password", c.updatePassword)
 		users.DELETE("/me", c.deleteMe)
+		users.GET("/me/sessions", c.listSessions)
+		users.GET("/me/engagement", c.getEngagement)
+		users.GET("/me/overview", c.getOverview)
+		users.DELETE("/me/sessions/:sessionId", c.revokeSession)
+		users.GET("/me/api-keys", c.listAPIKeys)
+		users.POST("/me/api-keys", c.createAPIKey)
+		users.DELETE("/me/api-keys/:id", c.revokeAPIKey)
+		users.GET("/public", c.getPublicProfiles)
+		users.GET("/proximity", c.countNearby)
 		users.GET("/:id", c.getByID)
 	}
 }
@@ -58,6 +67,53 @@ func (c *UserController) getMe(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, response)
 }
 
+// @Summary Get current user engagement
+// @Description The caller's active usages together with lifetime totals: usage counts, minutes and spend.
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.UserEngagementResponse
+// @Failure 401 {object} map[string]string
+// @Router /api/v1/users/me/engagement [get]
+func (c *UserController) getEngagement(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := c.userService.GetEngagement(ctx.Request.Context(), userID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Get current user overview
+// @Description Everything for the account tab in one call: profile, active usages, upcoming bookings, unread notification count, outstanding balance and, for owners, listing stats. Sections other than the profile are null when they fail to load; ownerStats is also null for users without listings.
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.UserOverviewResponse
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/users/me/overview [get]
+func (c *UserController) getOverview(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := c.userService.GetOverview(ctx.Request.Context(), userID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
 // @Summary Update current user profile
 // @Tags users
 // @Accept json
@@ -67,6 +123,7 @@ func (c *UserController) getMe(ctx *gin.Context) {
 // @Success 200 {object} dto.UserResponse
 // @Failure 400 {object} map[string]string
 // @Failure 401 {object} map[string]string
+// @Failure 409 {object} map[string]string
 // @Router /api/v1/users/me [put]
 func (c *UserController) updateMe(ctx *gin.Context) {
 	userID, ok := c.getUserIDFromContext(ctx)
@@ -98,10 +155,11 @@ func (c *UserController) updateMe(ctx *gin.Context) {
 // @Success 200 {object} dto.UserResponse
 // @Failure 400 {object} map[string]string
 // @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
 // @Failure 409 {object} map[string]string
 // @Router /api/v1/users/me/email [patch]
 func (c *UserController) updateEmail(ctx *gin.Context) {
-	userID, ok := c.getUserIDFromContext(ctx)
+	userID, ok := c.getSessionUserID(ctx)
 	if !ok {
 		return
 	}
@@ -130,9 +188,11 @@ func (c *UserController) updateEmail(ctx *gin.Context) {
 // @Success 200 {object} dto.UserResponse
 // @Failure 400 {object} map[string]string
 // @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 409 {object} map[string]string
 // @Router /api/v1/users/me/phone [patch]
 func (c *UserController) updatePhone(ctx *gin.Context) {
-	userID, ok := c.getUserIDFromContext(ctx)
+	userID, ok := c.getSessionUserID(ctx)
 	if !ok {
 		return
 	}
@@ -161,9 +221,10 @@ func (c *UserController) updatePhone(ctx *gin.Context) {
 // @Success 204
 // @Failure 400 {object} map[string]string
 // @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
 // @Router /api/v1/users/me/password [patch]
 func (c *UserController) updatePassword(ctx *gin.Context) {
-	userID, ok := c.getUserIDFromContext(ctx)
+	userID, ok := c.getSessionUserID(ctx)
 	if !ok {
 		return
 	}
@@ -189,15 +250,16 @@ func (c *UserController) updatePassword(ctx *gin.Context) {
 // @Security BearerAuth
 // @Success 204
 // @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
 // @Failure 404 {object} map[string]string
 // @Router /api/v1/users/me [delete]
 func (c *UserController) deleteMe(ctx *gin.Context) {
-	userID, ok := c.getUserIDFromContext(ctx)
+	userID, ok := c.getSessionUserID(ctx)
 	if !ok {
 		return
 	}
 
-	if err := c.userService.DeleteMe(ctx.Request.Context(), userID); err != nil {
+	if err := c.userService.DeleteMe(ctx.Request.Context(), userID, middleware.GetAccessToken(ctx)); err != nil {
 		handleError(ctx, err)
 		return
 	}
@@ -227,6 +289,210 @@ func (c *UserController) getByID(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, response)
 }
 
+// @Summary Get public profiles of multiple users
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param ids query string true "Comma-separated user IDs"
+// @Success 200 {object} map[string]dto.PublicUserResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /api/v1/users/public [get]
+func (c *UserController) getPublicProfiles(ctx *gin.Context) {
+	ids := splitIDs(ctx.Query("ids"))
+
+	response, err := c.userService.GetPublicProfiles(ctx.Request.Context(), ids)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Count users near a point
+// @Description Returns only an aggregate count of active users whose geocoded address lies within the radius. Individual user locations are never exposed. Available to admins and dumpster owners.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param lat query number true "Latitude"
+// @Param lng query number true "Longitude"
+// @Param radius query number true "Radius in kilometers (max 100)"
+// @Success 200 {object} dto.UserProximityResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Router /api/v1/users/proximity [get]
+func (c *UserController) countNearby(ctx *gin.Context) {
+	userID, ok := c.getUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	var req dto.UserProximityRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.userService.CountNearby(ctx.Request.Context(), userID, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary List active sessions
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} dto.SessionResponse
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Router /api/v1/users/me/sessions [get]
+func (c *UserController) listSessions(ctx *gin.Context) {
+	userID, ok := c.getSessionUserID(ctx)
+	if !ok {
+		return
+	}
+
+	sessionID, _ := middleware.GetSessionID(ctx)
+
+	response, err := c.userService.ListSessions(ctx.Request.Context(), userID, sessionID.String())
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Revoke a session
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param sessionId path string true "Session ID"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/users/me/sessions/{sessionId} [delete]
+func (c *UserController) revokeSession(ctx *gin.Context) {
+	userID, ok := c.getSessionUserID(ctx)
+	if !ok {
+		return
+	}
+
+	sessionID := ctx.Param("sessionId")
+
+	if err := c.userService.RevokeSession(ctx.Request.Context(), userID, sessionID); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
+
+// @Summary List API keys
+// @Description The caller's API keys, revoked ones included. Raw keys are never shown again after creation.
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} dto.APIKeyResponse
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Router /api/v1/users/me/api-keys [get]
+func (c *UserController) listAPIKeys(ctx *gin.Context) {
+	userID, ok := c.getSessionUserID(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := c.userService.ListAPIKeys(ctx.Request.Context(), userID)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Create an API key
+// @Description Issues a long-lived key to send in the X-API-Key header instead of a bearer token. The raw key is only returned here.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateAPIKeyRequest true "Key label"
+// @Success 201 {object} dto.CreatedAPIKeyResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Router /api/v1/users/me/api-keys [post]
+func (c *UserController) createAPIKey(ctx *gin.Context) {
+	userID, ok := c.getSessionUserID(ctx)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateAPIKeyRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		handleError(ctx, apperrors.BadRequest(err.Error()))
+		return
+	}
+
+	response, err := c.userService.CreateAPIKey(ctx.Request.Context(), userID, req)
+	if err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response)
+}
+
+// @Summary Revoke an API key
+// @Tags users
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "API key ID"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/users/me/api-keys/{id} [delete]
+func (c *UserController) revokeAPIKey(ctx *gin.Context) {
+	userID, ok := c.getSessionUserID(ctx)
+	if !ok {
+		return
+	}
+
+	if err := c.userService.RevokeAPIKey(ctx.Request.Context(), userID, ctx.Param("id")); err != nil {
+		handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
+
+// getSessionUserID is getUserIDFromContext for routes that manage
+// credentials or the account itself: they need a signed-in session, so a
+// leaked API key can't be turned into an account takeover.
+func (c *UserController) getSessionUserID(ctx *gin.Context) (string, bool) {
+	if middleware.IsAPIKeyAuth(ctx) {
+		handleError(ctx, apperrors.Forbidden("this action requires a signed-in session, not an API key"))
+		return "", false
+	}
+	return c.getUserIDFromContext(ctx)
+}
+
 func (c *UserController) getUserIDFromContext(ctx *gin.Context) (string, bool) {
 	userID, ok := middleware.GetUserID(ctx)
 	if !ok {
@@ -0,0 +1,13 @@
+package dto
+
+type MaintenanceRequest struct {
+	Mode              string `json:"mode" validate:"required,oneof=off readOnly full"`
+	RetryAfterSeconds int    `json:"retryAfterSeconds" validate:"omitempty,min=1"`
+	Message           string `json:"message" validate:"omitempty,max=255"`
+}
+
+type MaintenanceResponse struct {
+	Mode              string `json:"mode"`
+	RetryAfterSeconds int    `json:"retryAfterSeconds"`
+	Message           string `json:"message,omitempty"`
+}
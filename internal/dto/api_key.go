@@ -0,0 +1,23 @@
+package dto
+
+import "time"
+
+type CreateAPIKeyRequest struct {
+	Label string `json:"label" validate:"required,max=100"`
+}
+
+type APIKeyResponse struct {
+	ID         string     `json:"id"`
+	Prefix     string     `json:"prefix"`
+	Label      string     `json:"label"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+// CreatedAPIKeyResponse carries the raw key. It is only ever returned from
+// creation; store it then, since it cannot be shown again.
+type CreatedAPIKeyResponse struct {
+	APIKeyResponse
+	Key string `json:"key"`
+}
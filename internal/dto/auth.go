@@ -1,8 +1,11 @@
 package dto
 
+import "time"
+
 type LoginRequest struct {
-	Email    string `json:"email" validate:"required,email"`
-	Password string `json:"password" validate:"required"`
+	Email      string `json:"email" validate:"required,email"`
+	Password   string `json:"password" validate:"required"`
+	RememberMe bool   `json:"rememberMe"`
 }
 
 type LoginResponse struct {
@@ -18,3 +21,28 @@ type RefreshTokenRequest struct {
 type RefreshTokenResponse struct {
 	AccessToken string `json:"accessToken"`
 }
+
+type IntrospectRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+// PermissionsResponse is what the caller may do. Role comes from the token;
+// the account flags from the user record.
+type PermissionsResponse struct {
+	UserID          string   `json:"userId"`
+	Role            string   `json:"role"`
+	IsAdmin         bool     `json:"isAdmin"`
+	IsActive        bool     `json:"isActive"`
+	IsEmailVerified bool     `json:"isEmailVerified"`
+	IsPhoneVerified bool     `json:"isPhoneVerified"`
+	APIKeyAuth      bool     `json:"apiKeyAuth"`
+	Capabilities    []string `json:"capabilities"`
+}
+
+// IntrospectResponse only carries token details when Active is true.
+type IntrospectResponse struct {
+	Active    bool       `json:"active"`
+	UserID    string     `json:"userId,omitempty"`
+	Email     string     `json:"email,omitempty"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
@@ -0,0 +1,74 @@
+package dto
+
+import (
+	"time"
+	"waste-space/pkg/money"
+)
+
+type BookDumpsterRequest struct {
+	StartDate time.Time `json:"startDate" validate:"required"`
+	EndDate   time.Time `json:"endDate" validate:"required,gtfield=StartDate"`
+	// HoldToken, when set, consumes the caller's hold on these dates once
+	// the booking is created.
+	HoldToken string `json:"holdToken,omitempty"`
+}
+
+type HoldDumpsterRequest struct {
+	StartDate time.Time `json:"startDate" validate:"required"`
+	EndDate   time.Time `json:"endDate" validate:"required,gtfield=StartDate"`
+}
+
+// DumpsterHoldResponse is a short-lived hold on a dumpster's dates. Pass
+// Token as holdToken when booking before ExpiresAt to keep the dates.
+type DumpsterHoldResponse struct {
+	Token      string    `json:"token"`
+	DumpsterID string    `json:"dumpsterId"`
+	StartDate  time.Time `json:"startDate"`
+	EndDate    time.Time `json:"endDate"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+}
+
+type BookingResponse struct {
+	ID                  string            `json:"id"`
+	DumpsterID          string            `json:"dumpsterId"`
+	Dumpster            *DumpsterResponse `json:"dumpster,omitempty"`
+	UserID              string            `json:"userId"`
+	StartDate           time.Time         `json:"startDate"`
+	EndDate             time.Time         `json:"endDate"`
+	Subtotal            money.Amount      `json:"subtotal"`
+	TaxRate             float64           `json:"taxRate"`
+	Tax                 money.Amount      `json:"tax"`
+	TotalPrice          money.Amount      `json:"totalPrice"`
+	TotalPriceFormatted string            `json:"totalPriceFormatted"`
+	Currency            string            `json:"currency"`
+	Status              string            `json:"status"`
+	ConfirmedAt         *time.Time        `json:"confirmedAt,omitempty"`
+	ExpiredAt           *time.Time        `json:"expiredAt,omitempty"`
+	PaidAt              *time.Time        `json:"paidAt,omitempty"`
+	EndedEarlyAt        *time.Time        `json:"endedEarlyAt,omitempty"`
+	CreatedAt           time.Time         `json:"createdAt"`
+	UpdatedAt           time.Time         `json:"updatedAt"`
+}
+
+// BookingLeadTimeResponse is how many days ahead of the start date a
+// dumpster's confirmed bookings were made.
+type BookingLeadTimeResponse struct {
+	DumpsterID  string  `json:"dumpsterId" gorm:"-"`
+	Bookings    int64   `json:"bookings"`
+	AverageDays float64 `json:"averageDays"`
+	MedianDays  float64 `json:"medianDays"`
+}
+
+type EndBookingEarlyRequest struct {
+	EndDate time.Time `json:"endDate" validate:"required"`
+}
+
+// EndBookingEarlyResponse is the shortened booking, repriced for the days
+// actually used, and the amount refunded against its payment.
+type EndBookingEarlyResponse struct {
+	Booking         BookingResponse `json:"booking"`
+	Refund          money.Amount    `json:"refund"`
+	RefundFormatted string          `json:"refundFormatted"`
+	Currency        string          `json:"currency"`
+	RefundReference string          `json:"refundReference,omitempty"`
+}
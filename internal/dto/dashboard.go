@@ -0,0 +1,68 @@
+package dto
+
+import (
+	"time"
+	"waste-space/pkg/money"
+)
+
+type OwnerDashboardResponse struct {
+	ListingCount     int64        `json:"listingCount"`
+	ActiveUsages     int64        `json:"activeUsages"`
+	PendingBookings  int64        `json:"pendingBookings"`
+	RevenueThisMonth money.Amount `json:"revenueThisMonth"`
+	Currency         string       `json:"currency"`
+	AverageRating    float64      `json:"averageRating"`
+	PeriodStart      time.Time    `json:"periodStart"`
+}
+
+// MonthlyStatementResponse is an owner's statement for one calendar month
+// (UTC), laid out for a PDF renderer. It covers usages completed and
+// confirmed bookings ended in [PeriodStart, PeriodEnd). Revenue is pre-tax;
+// Fees are the platform's share and Payout what the owner keeps. Final is
+// false while the month is still running.
+type MonthlyStatementResponse struct {
+	StatementNumber string                  `json:"statementNumber"`
+	OwnerID         string                  `json:"ownerId"`
+	Year            int                     `json:"year"`
+	Month           int                     `json:"month"`
+	PeriodStart     time.Time               `json:"periodStart"`
+	PeriodEnd       time.Time               `json:"periodEnd"`
+	GeneratedAt     time.Time               `json:"generatedAt"`
+	Final           bool                    `json:"final"`
+	Currency        string                  `json:"currency"`
+	FeeRate         float64                 `json:"feeRate"`
+	Dumpsters       []StatementDumpsterLine `json:"dumpsters"`
+	Totals          StatementTotals         `json:"totals"`
+}
+
+// StatementDumpsterLine is one listing's activity on a statement.
+type StatementDumpsterLine struct {
+	DumpsterID     string       `json:"dumpsterId"`
+	Title          string       `json:"title"`
+	Usages         int64        `json:"usages"`
+	UsageRevenue   money.Amount `json:"usageRevenue"`
+	Bookings       int64        `json:"bookings"`
+	BookingRevenue money.Amount `json:"bookingRevenue"`
+	Revenue        money.Amount `json:"revenue"`
+	Fees           money.Amount `json:"fees"`
+	Payout         money.Amount `json:"payout"`
+}
+
+type StatementTotals struct {
+	Usages         int64        `json:"usages"`
+	UsageRevenue   money.Amount `json:"usageRevenue"`
+	Bookings       int64        `json:"bookings"`
+	BookingRevenue money.Amount `json:"bookingRevenue"`
+	Revenue        money.Amount `json:"revenue"`
+	Fees           money.Amount `json:"fees"`
+	Payout         money.Amount `json:"payout"`
+}
+
+// StatementRevenueRow is the count and revenue of one listing's usages or
+// bookings within a statement period.
+type StatementRevenueRow struct {
+	DumpsterID string
+	Title      string
+	Count      int64
+	Revenue    money.Amount
+}
@@ -2,122 +2,441 @@ package dto
 
 import (
 	"time"
+	"waste-space/pkg/money"
 )
 
 type CreateDumpsterRequest struct {
-	Title       string  `json:"title" validate:"required,min=5,max=255"`
-	Description string  `json:"description"`
-	Location    string  `json:"location" validate:"required"`
-	Latitude    float64 `json:"latitude" validate:"required,latitude"`
-	Longitude   float64 `json:"longitude" validate:"required,longitude"`
-	Address     string  `json:"address" validate:"required"`
-	City        string  `json:"city" validate:"required"`
-	State       string  `json:"state" validate:"required"`
-	ZipCode     string  `json:"zipCode" validate:"required"`
-	PricePerDay float64 `json:"pricePerDay" validate:"required,gt=0"`
-	Size        string  `json:"size" validate:"required,oneof=small medium large extraLarge"`
-	Capacity    string  `json:"capacity"`
-	Weight      string  `json:"weight"`
-}
-
-type UpdateDumpsterRequest struct {
-	Title       *string  `json:"title,omitempty" validate:"omitempty,min=5,max=255"`
-	Description *string  `json:"description,omitempty"`
-	Location    *string  `json:"location,omitempty"`
-	Latitude    *float64 `json:"latitude,omitempty" validate:"omitempty,latitude"`
-	Longitude   *float64 `json:"longitude,omitempty" validate:"omitempty,longitude"`
-	Address     *string  `json:"address,omitempty"`
-	City        *string  `json:"city,omitempty"`
-	State       *string  `json:"state,omitempty"`
-	ZipCode     *string  `json:"zipCode,omitempty"`
-	PricePerDay *float64 `json:"pricePerDay,omitempty" validate:"omitempty,gt=0"`
-	Size        *string  `json:"size,omitempty" validate:"omitempty,oneof=small medium large extraLarge"`
-	IsAvailable *bool    `json:"isAvailable,omitempty"`
-	Capacity    *string  `json:"capacity,omitempty"`
-	Weight      *string  `json:"weight,omitempty"`
+	Title       string       `json:"title" validate:"required,min=5,max=255"`
+	Description string       `json:"description"`
+	Location    string       `json:"location" validate:"required"`
+	Latitude    float64      `json:"latitude" validate:"required,latitude"`
+	Longitude   float64      `json:"longitude" validate:"required,longitude"`
+	Address     string       `json:"address" validate:"required"`
+	City        string       `json:"city" validate:"required"`
+	State       string       `json:"state" validate:"required"`
+	ZipCode     string       `json:"zipCode" validate:"required"`
+	PricePerDay money.Amount `json:"pricePerDay" validate:"required,gt=0"`
+	Size        string       `json:"size" validate:"required,oneof=small medium large extraLarge"`
+	Capacity    string       `json:"capacity"`
+	Weight      string       `json:"weight"`
+	AutoRelease bool         `json:"autoRelease"`
+	// ExclusiveUse allows at most one active usage at a time across all users.
+	ExclusiveUse bool     `json:"exclusiveUse"`
+	Tags         []string `json:"tags,omitempty"`
+}
+
+// ReplaceDumpsterRequest is the full representation written by PUT. Fields
+// left out take the value a newly created dumpster would have.
+type ReplaceDumpsterRequest struct {
+	CreateDumpsterRequest
+	IsAvailable *bool `json:"isAvailable,omitempty"`
+}
+
+// PatchDumpsterRequest carries a JSON merge patch. Omitted fields are left
+// unchanged. null clears description, capacity, weight and tags, and is
+// rejected for every other field.
+type PatchDumpsterRequest struct {
+	Title        Optional[string]       `json:"title" swaggertype:"string"`
+	Description  Optional[string]       `json:"description" swaggertype:"string"`
+	Location     Optional[string]       `json:"location" swaggertype:"string"`
+	Latitude     Optional[float64]      `json:"latitude" swaggertype:"number"`
+	Longitude    Optional[float64]      `json:"longitude" swaggertype:"number"`
+	Address      Optional[string]       `json:"address" swaggertype:"string"`
+	City         Optional[string]       `json:"city" swaggertype:"string"`
+	State        Optional[string]       `json:"state" swaggertype:"string"`
+	ZipCode      Optional[string]       `json:"zipCode" swaggertype:"string"`
+	PricePerDay  Optional[money.Amount] `json:"pricePerDay" swaggertype:"number"`
+	Size         Optional[string]       `json:"size" swaggertype:"string" enums:"small,medium,large,extraLarge"`
+	IsAvailable  Optional[bool]         `json:"isAvailable" swaggertype:"boolean"`
+	Capacity     Optional[string]       `json:"capacity" swaggertype:"string"`
+	Weight       Optional[string]       `json:"weight" swaggertype:"string"`
+	AutoRelease  Optional[bool]         `json:"autoRelease" swaggertype:"boolean"`
+	ExclusiveUse Optional[bool]         `json:"exclusiveUse" swaggertype:"boolean"`
+	Tags         Optional[[]string]     `json:"tags" swaggertype:"array,string"`
 }
 
 type DumpsterResponse struct {
-	ID          string        `json:"id"`
-	OwnerID     string        `json:"ownerId"`
-	Owner       *UserResponse `json:"owner,omitempty"`
-	Title       string        `json:"title"`
-	Description string        `json:"description"`
-	Location    string        `json:"location"`
-	Latitude    float64       `json:"latitude"`
-	Longitude   float64       `json:"longitude"`
-	Address     string        `json:"address"`
-	City        string        `json:"city"`
-	State       string        `json:"state"`
-	ZipCode     string        `json:"zipCode"`
-	PricePerDay float64       `json:"pricePerDay"`
-	Size        string        `json:"size"`
-	IsAvailable bool          `json:"isAvailable"`
-	Rating      float64       `json:"rating"`
-	ReviewCount int           `json:"reviewCount"`
-	Capacity    string        `json:"capacity"`
-	Weight      string        `json:"weight"`
-	CreatedAt   time.Time     `json:"createdAt"`
-	UpdatedAt   time.Time     `json:"updatedAt"`
+	ID                   string        `json:"id"`
+	OwnerID              string        `json:"ownerId"`
+	Owner                *UserResponse `json:"owner,omitempty"`
+	Title                string        `json:"title"`
+	Slug                 string        `json:"slug"`
+	Description          string        `json:"description"`
+	Location             string        `json:"location"`
+	Latitude             float64       `json:"latitude"`
+	Longitude            float64       `json:"longitude"`
+	Address              string        `json:"address"`
+	City                 string        `json:"city"`
+	State                string        `json:"state"`
+	ZipCode              string        `json:"zipCode"`
+	PricePerDay          money.Amount  `json:"pricePerDay"`
+	PricePerDayFormatted string        `json:"pricePerDayFormatted"`
+	Currency             string        `json:"currency"`
+	Size                 string        `json:"size"`
+	IsAvailable          bool          `json:"isAvailable"`
+	UnavailableUntil     *time.Time    `json:"unavailableUntil,omitempty"`
+	Rating               float64       `json:"rating"`
+	ReviewCount          int           `json:"reviewCount"`
+	Capacity             string        `json:"capacity"`
+	Weight               string        `json:"weight"`
+	AutoRelease          bool          `json:"autoRelease"`
+	ExclusiveUse         bool          `json:"exclusiveUse"`
+	IsFeatured           bool          `json:"isFeatured"`
+	Unpublished          bool          `json:"unpublished"`
+	Tags                 []string      `json:"tags"`
+	Images               []string      `json:"images"`
+	CreatedAt            time.Time     `json:"createdAt"`
+	UpdatedAt            time.Time     `json:"updatedAt"`
 }
 
 type DumpsterListRequest struct {
-	Page          int      `form:"page" validate:"omitempty,min=1"`
-	Limit         int      `form:"limit" validate:"omitempty,min=1,max=100"`
-	SortBy        string   `form:"sortBy" validate:"omitempty,oneof=price distance rating availability"`
-	Location      string   `form:"location"`
-	MaxPrice      *float64 `form:"maxPrice" validate:"omitempty,gt=0"`
-	Size          string   `form:"size" validate:"omitempty,oneof=small medium large extraLarge"`
-	AvailableNow  *bool    `form:"availableNow"`
-	MaxDistance   *float64 `form:"maxDistance" validate:"omitempty,gt=0"`
+	Page         int        `form:"page" validate:"omitempty,min=1"`
+	Limit        int        `form:"limit" validate:"omitempty,min=1,max=100"`
+	SortBy       string     `form:"sortBy" validate:"omitempty,oneof=newest price distance rating availability"`
+	Location     string     `form:"location"`
+	MaxPrice     *float64   `form:"maxPrice" validate:"omitempty,gt=0"`
+	Size         string     `form:"size" validate:"omitempty,oneof=small medium large extraLarge"`
+	AvailableNow *bool      `form:"availableNow"`
+	Tags         string     `form:"tags"`
+	MaxDistance  *float64   `form:"maxDistance" validate:"omitempty,gt=0"`
+	CreatedFrom  *time.Time `form:"createdFrom" time_format:"2006-01-02T15:04:05Z07:00"`
+	CreatedTo    *time.Time `form:"createdTo" time_format:"2006-01-02T15:04:05Z07:00"`
 }
 
 type DumpsterSearchRequest struct {
-	Query       string   `form:"q"`
-	City        string   `form:"city"`
-	State       string   `form:"state"`
-	ZipCode     string   `form:"zipCode"`
-	MinPrice    *float64 `form:"minPrice" validate:"omitempty,gte=0"`
-	MaxPrice    *float64 `form:"maxPrice" validate:"omitempty,gte=0"`
-	Size        string   `form:"size" validate:"omitempty,oneof=small medium large extraLarge"`
-	IsAvailable *bool    `form:"isAvailable"`
-	Page        int      `form:"page" validate:"omitempty,min=1"`
-	Limit       int      `form:"limit" validate:"omitempty,min=1,max=100"`
+	Query       string     `form:"q"`
+	City        string     `form:"city"`
+	State       string     `form:"state"`
+	ZipCode     string     `form:"zipCode"`
+	MinPrice    *float64   `form:"minPrice" validate:"omitempty,gte=0"`
+	MaxPrice    *float64   `form:"maxPrice" validate:"omitempty,gte=0"`
+	Size        string     `form:"size" validate:"omitempty,oneof=small medium large extraLarge"`
+	IsAvailable *bool      `form:"isAvailable"`
+	Tags        string     `form:"tags"`
+	SortBy      string     `form:"sortBy" validate:"omitempty,oneof=newest price rating availability"`
+	Page        int        `form:"page" validate:"omitempty,min=1"`
+	Limit       int        `form:"limit" validate:"omitempty,min=1,max=100"`
+	CreatedFrom *time.Time `form:"createdFrom" time_format:"2006-01-02T15:04:05Z07:00"`
+	CreatedTo   *time.Time `form:"createdTo" time_format:"2006-01-02T15:04:05Z07:00"`
+}
+
+// UnreviewedDumpsterListRequest filters the admin list of listings that
+// have not received a review yet.
+type UnreviewedDumpsterListRequest struct {
+	Page        int        `form:"page" validate:"omitempty,min=1"`
+	Limit       int        `form:"limit" validate:"omitempty,min=1,max=100"`
+	OwnerID     string     `form:"ownerId"`
+	CreatedFrom *time.Time `form:"createdFrom" time_format:"2006-01-02T15:04:05Z07:00"`
+	CreatedTo   *time.Time `form:"createdTo" time_format:"2006-01-02T15:04:05Z07:00"`
 }
 
 type NearbyDumpstersRequest struct {
-	Latitude    float64  `form:"lat" validate:"required,latitude"`
-	Longitude   float64  `form:"lng" validate:"required,longitude"`
-	MaxDistance *float64 `form:"maxDistance" validate:"omitempty,gt=0"`
-	Limit       int      `form:"limit" validate:"omitempty,min=1,max=100"`
+	Latitude     float64  `form:"lat" validate:"required,latitude"`
+	Longitude    float64  `form:"lng" validate:"required,longitude"`
+	MaxDistance  *float64 `form:"maxDistance" validate:"omitempty,gt=0"`
+	Limit        int      `form:"limit" validate:"omitempty,min=1,max=100"`
+	MaxPrice     *float64 `form:"maxPrice" validate:"omitempty,gt=0"`
+	Size         string   `form:"size" validate:"omitempty,oneof=small medium large extraLarge"`
+	AvailableNow *bool    `form:"availableNow"`
+}
+
+type PairedDumpstersRequest struct {
+	Limit int `form:"limit" validate:"omitempty,min=1,max=50"`
+}
+
+// PairedDumpsterCount is how many users a listing shares with another one.
+type PairedDumpsterCount struct {
+	DumpsterID  string
+	SharedUsers int64
+}
+
+// PairedDumpsterResponse is a listing often used by the same people as the
+// requested one; SharedUsers is how many of them it has in common.
+type PairedDumpsterResponse struct {
+	Dumpster    DumpsterResponse `json:"dumpster"`
+	SharedUsers int64            `json:"sharedUsers"`
+}
+
+type ValidateAddressRequest struct {
+	Address string `json:"address" validate:"required"`
+	City    string `json:"city" validate:"required"`
+	State   string `json:"state" validate:"required"`
+	ZipCode string `json:"zipCode" validate:"required"`
+}
+
+type NormalizedAddress struct {
+	Address string `json:"address"`
+	City    string `json:"city"`
+	State   string `json:"state"`
+	ZipCode string `json:"zipCode"`
+}
+
+// AddressValidationResponse echoes the address as it was looked up. Found
+// is false, and no coordinates are set, when the geocoder didn't recognize it.
+type AddressValidationResponse struct {
+	Found     bool              `json:"found"`
+	Address   NormalizedAddress `json:"address"`
+	Latitude  *float64          `json:"latitude,omitempty"`
+	Longitude *float64          `json:"longitude,omitempty"`
+}
+
+type DumpsterDistanceRequest struct {
+	Latitude  float64 `form:"lat" validate:"required,latitude"`
+	Longitude float64 `form:"lng" validate:"required,longitude"`
 }
 
-type BookDumpsterRequest struct {
-	StartDate time.Time `json:"startDate" validate:"required"`
-	EndDate   time.Time `json:"endDate" validate:"required,gtfield=StartDate"`
+type PriceSuggestionRequest struct {
+	Latitude  float64 `form:"lat" validate:"required,latitude"`
+	Longitude float64 `form:"lng" validate:"required,longitude"`
+	Size      string  `form:"size" validate:"required,oneof=small medium large extraLarge"`
 }
 
-type BookingResponse struct {
-	ID          string    `json:"id"`
-	DumpsterID  string    `json:"dumpsterId"`
-	UserID      string    `json:"userId"`
-	StartDate   time.Time `json:"startDate"`
-	EndDate     time.Time `json:"endDate"`
-	TotalPrice  float64   `json:"totalPrice"`
-	Status      string    `json:"status"`
-	CreatedAt   time.Time `json:"createdAt"`
+type SizeGuideRequest struct {
+	Project string `form:"project"`
+}
+
+// SizeGuideEntry recommends a dumpster size for one kind of project.
+// SearchURL is a search for available listings of that size.
+type SizeGuideEntry struct {
+	Project   string `json:"project"`
+	Size      string `json:"size"`
+	Rationale string `json:"rationale"`
+	SearchURL string `json:"searchUrl"`
+}
+
+type SizeGuideResponse struct {
+	Entries []SizeGuideEntry `json:"entries"`
+}
+
+// PriceSuggestionStats are the price percentiles of comparable listings.
+type PriceSuggestionStats struct {
+	Count  int64        `json:"count"`
+	Low    money.Amount `json:"low"`
+	Median money.Amount `json:"median"`
+	High   money.Amount `json:"high"`
+}
+
+// PriceSuggestionResponse suggests a price range from comparable listings.
+// When SufficientData is false there were too few comparables and the price
+// fields are omitted rather than guessed.
+type PriceSuggestionResponse struct {
+	Size           string        `json:"size"`
+	RadiusKm       float64       `json:"radiusKm"`
+	Comparables    int64         `json:"comparables"`
+	SufficientData bool          `json:"sufficientData"`
+	Low            *money.Amount `json:"low,omitempty"`
+	Suggested      *money.Amount `json:"suggested,omitempty"`
+	High           *money.Amount `json:"high,omitempty"`
+	Currency       string        `json:"currency"`
+}
+
+type SetFeaturedRequest struct {
+	Featured bool `json:"featured"`
+}
+
+type CreateShareLinkRequest struct {
+	// ExpiresInHours defaults to 168 (one week) and is capped at 720.
+	ExpiresInHours int `json:"expiresInHours" validate:"omitempty,min=1,max=720"`
+}
+
+type ShareLinkResponse struct {
+	Token     string    `json:"token"`
+	Path      string    `json:"path"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// DumpsterDistanceResponse always carries the straight-line distance; the
+// driving fields are set only when a routing provider returned a route.
+type DumpsterDistanceResponse struct {
+	DumpsterID        string   `json:"dumpsterId"`
+	DistanceKm        float64  `json:"distanceKm"`
+	DrivingDistanceKm *float64 `json:"drivingDistanceKm,omitempty"`
+	EtaSeconds        *int64   `json:"etaSeconds,omitempty"`
 }
 
 type AvailabilityResponse struct {
-	DumpsterID  string `json:"dumpsterId"`
-	IsAvailable bool   `json:"isAvailable"`
-	Message     string `json:"message,omitempty"`
+	DumpsterID       string     `json:"dumpsterId"`
+	IsAvailable      bool       `json:"isAvailable"`
+	InUse            bool       `json:"inUse"`
+	UnavailableUntil *time.Time `json:"unavailableUntil,omitempty"`
+	// Reason says why IsAvailable is false; Message is the same for display.
+	Reason  string `json:"reason,omitempty" enums:"unpublished,owner_disabled,snoozed,in_use,booked"`
+	Message string `json:"message,omitempty"`
+}
+
+type DumpsterQuoteRequest struct {
+	StartDate time.Time `form:"startDate" time_format:"2006-01-02T15:04:05Z07:00" validate:"required"`
+	EndDate   time.Time `form:"endDate" time_format:"2006-01-02T15:04:05Z07:00" validate:"required,gtfield=StartDate"`
+}
+
+// DumpsterQuoteResponse is a price estimate for renting a dumpster over a
+// date range. It is served to anonymous callers, so it carries only listing
+// facts and never anything about the owner.
+type DumpsterQuoteResponse struct {
+	DumpsterID     string       `json:"dumpsterId"`
+	Title          string       `json:"title"`
+	Size           string       `json:"size"`
+	City           string       `json:"city"`
+	State          string       `json:"state"`
+	StartDate      time.Time    `json:"startDate"`
+	EndDate        time.Time    `json:"endDate"`
+	Days           float64      `json:"days"`
+	Subtotal       money.Amount `json:"subtotal"`
+	TaxRate        float64      `json:"taxRate"`
+	Tax            money.Amount `json:"tax"`
+	Total          money.Amount `json:"total"`
+	TotalFormatted string       `json:"totalFormatted"`
+	Currency       string       `json:"currency"`
+	// Available reports whether booking these dates would currently succeed.
+	Available bool   `json:"available"`
+	Message   string `json:"message,omitempty"`
+}
+
+type SnoozeDumpsterRequest struct {
+	Until time.Time `json:"until" validate:"required"`
+}
+
+type DumpsterListResponse = PaginatedResponse[DumpsterResponse]
+
+type DumpsterDensityRequest struct {
+	MinLat   float64 `form:"minLat" validate:"required,latitude"`
+	MinLng   float64 `form:"minLng" validate:"required,longitude"`
+	MaxLat   float64 `form:"maxLat" validate:"required,latitude"`
+	MaxLng   float64 `form:"maxLng" validate:"required,longitude"`
+	GridSize int     `form:"gridSize" validate:"omitempty,min=1,max=50"`
+}
+
+type DumpsterPriceStatsRequest struct {
+	City    string `form:"city"`
+	State   string `form:"state"`
+	ZipCode string `form:"zipCode"`
+}
+
+// DumpsterRegionCountsRequest filters the admin region breakdown. Limit caps
+// the number of cities returned; states are never capped.
+type DumpsterRegionCountsRequest struct {
+	PublishedOnly bool `form:"publishedOnly"`
+	AvailableOnly bool `form:"availableOnly"`
+	Limit         int  `form:"limit" validate:"omitempty,min=1,max=500"`
+}
+
+// DeleteOwnerDumpstersRequest guards the bulk delete: nothing happens unless
+// Confirm is set, and listings with an active usage are kept unless Force is.
+// Listings with upcoming bookings are always kept.
+type DeleteOwnerDumpstersRequest struct {
+	Confirm bool `form:"confirm"`
+	Force   bool `form:"force"`
+}
+
+type DeleteOwnerDumpstersResponse struct {
+	Deleted         int64 `json:"deleted"`
+	Skipped         int64 `json:"skipped"`
+	CancelledUsages int64 `json:"cancelledUsages"`
+}
+
+// DumpsterDuplicatePair is two of the owner's listings that look like the
+// same dumpster. Keep is the older listing and the suggested merge target.
+type DumpsterDuplicatePair struct {
+	Keep            DumpsterResponse `json:"keep"`
+	Duplicate       DumpsterResponse `json:"duplicate"`
+	DistanceKm      float64          `json:"distanceKm"`
+	SameAddress     bool             `json:"sameAddress"`
+	TitleSimilarity float64          `json:"titleSimilarity"`
+}
+
+type DumpsterDuplicatesResponse struct {
+	Pairs []DumpsterDuplicatePair `json:"pairs"`
+}
+
+// DumpsterAttentionReason explains why a listing is on the owner's
+// needs-attention list.
+type DumpsterAttentionReason struct {
+	Code    string `json:"code" enums:"open_flags,negative_reviews,low_rating,inactive"`
+	Message string `json:"message"`
+}
+
+// DumpsterAttentionItem is a listing that tripped at least one threshold.
+// Higher scores are more urgent.
+type DumpsterAttentionItem struct {
+	Dumpster DumpsterResponse          `json:"dumpster"`
+	Reasons  []DumpsterAttentionReason `json:"reasons"`
+	Score    int                       `json:"score"`
+}
+
+type DumpsterNeedsAttentionResponse struct {
+	Items []DumpsterAttentionItem `json:"items"`
+}
+
+type MergeDumpstersRequest struct {
+	KeepID      string `json:"keepId" validate:"required,uuid"`
+	DuplicateID string `json:"duplicateId" validate:"required,uuid"`
+}
+
+// DumpsterMergeResult counts what was moved from the duplicate onto the kept
+// listing. Reviews by users who had already reviewed the kept listing stay
+// behind on the deleted duplicate and are counted as skipped.
+type DumpsterMergeResult struct {
+	ReviewsMoved   int64 `json:"reviewsMoved"`
+	ReviewsSkipped int64 `json:"reviewsSkipped"`
+	UsagesMoved    int64 `json:"usagesMoved"`
+	BookingsMoved  int64 `json:"bookingsMoved"`
+}
+
+type DumpsterMergeResponse struct {
+	Dumpster DumpsterResponse `json:"dumpster"`
+	MergedID string           `json:"mergedId"`
+	DumpsterMergeResult
+}
+
+type DumpsterPriceStatsResponse struct {
+	City     string       `json:"city,omitempty"`
+	State    string       `json:"state,omitempty"`
+	ZipCode  string       `json:"zipCode,omitempty"`
+	Count    int64        `json:"count"`
+	MinPrice money.Amount `json:"minPrice"`
+	MaxPrice money.Amount `json:"maxPrice"`
+	AvgPrice money.Amount `json:"avgPrice"`
+	Median   money.Amount `json:"median"`
+	Currency string       `json:"currency" gorm:"-"`
+}
+
+type StateCount struct {
+	State string `json:"state"`
+	Count int64  `json:"count"`
+}
+
+type CityCount struct {
+	City  string `json:"city"`
+	State string `json:"state"`
+	Count int64  `json:"count"`
+}
+
+// DumpsterRegionCountsResponse counts listings per state and per city, most
+// listings first. CitiesTruncated is set when more cities matched than the
+// limit allowed.
+type DumpsterRegionCountsResponse struct {
+	Total           int64        `json:"total"`
+	States          []StateCount `json:"states"`
+	Cities          []CityCount  `json:"cities"`
+	CitiesTruncated bool         `json:"citiesTruncated"`
+}
+
+type DensityCell struct {
+	Row       int     `json:"row"`
+	Col       int     `json:"col"`
+	CenterLat float64 `json:"centerLat"`
+	CenterLng float64 `json:"centerLng"`
+	Count     int64   `json:"count"`
 }
 
-type DumpsterListResponse struct {
-	Dumpsters  []DumpsterResponse `json:"dumpsters"`
-	Total      int64              `json:"total"`
-	Page       int                `json:"page"`
-	Limit      int                `json:"limit"`
-	TotalPages int                `json:"totalPages"`
+type DumpsterDensityResponse struct {
+	MinLat     float64       `json:"minLat"`
+	MinLng     float64       `json:"minLng"`
+	MaxLat     float64       `json:"maxLat"`
+	MaxLng     float64       `json:"maxLng"`
+	GridSize   int           `json:"gridSize"`
+	CellHeight float64       `json:"cellHeight"`
+	CellWidth  float64       `json:"cellWidth"`
+	Total      int64         `json:"total"`
+	Cells      []DensityCell `json:"cells"`
 }
@@ -0,0 +1,33 @@
+package dto
+
+import "time"
+
+type FlagDumpsterRequest struct {
+	Reason string `json:"reason" validate:"required,max=500"`
+}
+
+type DumpsterFlagResponse struct {
+	ID         string     `json:"id"`
+	DumpsterID string     `json:"dumpsterId"`
+	ReporterID string     `json:"reporterId"`
+	Reason     string     `json:"reason"`
+	Status     string     `json:"status" enums:"open,dismissed,upheld"`
+	ResolvedBy string     `json:"resolvedBy,omitempty"`
+	ResolvedAt *time.Time `json:"resolvedAt,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+type DumpsterFlagListRequest struct {
+	Page       int    `form:"page" validate:"omitempty,min=1"`
+	Limit      int    `form:"limit" validate:"omitempty,min=1,max=100"`
+	Status     string `form:"status" validate:"omitempty,oneof=open dismissed upheld"`
+	DumpsterID string `form:"dumpsterId"`
+}
+
+type DumpsterFlagListResponse = PaginatedResponse[DumpsterFlagResponse]
+
+// ResolveDumpsterFlagsRequest closes every open flag on a listing. Dismiss
+// republishes the listing; uphold keeps it unpublished.
+type ResolveDumpsterFlagsRequest struct {
+	Action string `json:"action" validate:"required,oneof=dismiss uphold" enums:"dismiss,uphold"`
+}
@@ -0,0 +1,37 @@
+package dto
+
+import "time"
+
+type ImageUploadRequest struct {
+	ContentType string `json:"contentType" validate:"required,oneof=image/jpeg image/png image/webp"`
+}
+
+type CreateImageUploadsRequest struct {
+	Images []ImageUploadRequest `json:"images" validate:"required,min=1,dive"`
+}
+
+// ImageUploadResponse is a presigned request the client sends the image
+// bytes with, including Headers verbatim, before confirming Key.
+type ImageUploadResponse struct {
+	Key       string            `json:"key"`
+	UploadURL string            `json:"uploadUrl"`
+	Method    string            `json:"method"`
+	Headers   map[string]string `json:"headers"`
+	ExpiresAt time.Time         `json:"expiresAt"`
+}
+
+type ImageUploadsResponse struct {
+	Uploads []ImageUploadResponse `json:"uploads"`
+}
+
+type ConfirmImageUploadsRequest struct {
+	Keys []string `json:"keys" validate:"required,min=1"`
+}
+
+type DumpsterImageResponse struct {
+	ID         string    `json:"id"`
+	DumpsterID string    `json:"dumpsterId"`
+	URL        string    `json:"url"`
+	Position   int       `json:"position"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
@@ -0,0 +1,47 @@
+package dto
+
+import (
+	"time"
+	"waste-space/pkg/money"
+)
+
+// DumpsterSnapshot is the owner-editable state of a listing at one revision.
+type DumpsterSnapshot struct {
+	Title        string       `json:"title"`
+	Description  string       `json:"description"`
+	Location     string       `json:"location"`
+	Latitude     float64      `json:"latitude"`
+	Longitude    float64      `json:"longitude"`
+	Address      string       `json:"address"`
+	City         string       `json:"city"`
+	State        string       `json:"state"`
+	ZipCode      string       `json:"zipCode"`
+	PricePerDay  money.Amount `json:"pricePerDay"`
+	Size         string       `json:"size"`
+	Capacity     string       `json:"capacity"`
+	Weight       string       `json:"weight"`
+	IsAvailable  bool         `json:"isAvailable"`
+	AutoRelease  bool         `json:"autoRelease"`
+	ExclusiveUse bool         `json:"exclusiveUse"`
+	Tags         []string     `json:"tags"`
+}
+
+// DumpsterRevisionResponse describes one edit. ChangedFields names the
+// snapshot fields that differ from the state before the edit, and
+// RevertedFrom is the revision a revert restored. Snapshot is only included
+// when fetching a single revision.
+type DumpsterRevisionResponse struct {
+	Revision      int               `json:"revision"`
+	DumpsterID    string            `json:"dumpsterId"`
+	ChangedFields []string          `json:"changedFields"`
+	RevertedFrom  *int              `json:"revertedFrom,omitempty"`
+	Snapshot      *DumpsterSnapshot `json:"snapshot,omitempty"`
+	CreatedAt     time.Time         `json:"createdAt"`
+}
+
+type DumpsterRevisionListRequest struct {
+	Page  int `form:"page" validate:"omitempty,min=1"`
+	Limit int `form:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+type DumpsterRevisionListResponse = PaginatedResponse[DumpsterRevisionResponse]
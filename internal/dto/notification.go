@@ -0,0 +1,50 @@
+package dto
+
+import "time"
+
+type NotificationResponse struct {
+	ID          string     `json:"id"`
+	Type        string     `json:"type"`
+	Title       string     `json:"title"`
+	Message     string     `json:"message"`
+	ReferenceID string     `json:"referenceId,omitempty"`
+	IsRead      bool       `json:"isRead"`
+	ReadAt      *time.Time `json:"readAt,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
+
+type NotificationListRequest struct {
+	Page       int  `form:"page" validate:"omitempty,min=1"`
+	Limit      int  `form:"limit" validate:"omitempty,min=1,max=100"`
+	UnreadOnly bool `form:"unreadOnly"`
+}
+
+type NotificationListResponse = PaginatedResponse[NotificationResponse]
+
+type MarkNotificationsReadRequest struct {
+	IDs []string `json:"ids" validate:"required,min=1,max=100"`
+}
+
+type MarkNotificationsReadResponse struct {
+	Marked int64 `json:"marked"`
+}
+
+type NotificationPreferenceResponse struct {
+	Type  string `json:"type"`
+	InApp bool   `json:"inApp"`
+	Email bool   `json:"email"`
+}
+
+type NotificationPreferencesResponse struct {
+	Preferences []NotificationPreferenceResponse `json:"preferences"`
+}
+
+type NotificationPreferenceRequest struct {
+	Type  string `json:"type" validate:"required"`
+	InApp bool   `json:"inApp"`
+	Email bool   `json:"email"`
+}
+
+type UpdateNotificationPreferencesRequest struct {
+	Preferences []NotificationPreferenceRequest `json:"preferences" validate:"required,min=1,dive"`
+}
@@ -0,0 +1,21 @@
+package dto
+
+import "encoding/json"
+
+// Optional tells a JSON field that was left out apart from one explicitly set
+// to null, which a plain pointer cannot. Set reports that the key was present
+// and Null that its value was the literal null.
+type Optional[T any] struct {
+	Value T
+	Set   bool
+	Null  bool
+}
+
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	o.Set = true
+	if string(data) == "null" {
+		o.Null = true
+		return nil
+	}
+	return json.Unmarshal(data, &o.Value)
+}
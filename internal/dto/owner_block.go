@@ -0,0 +1,12 @@
+package dto
+
+import "time"
+
+type CreateOwnerBlockRequest struct {
+	UserID string `json:"userId" validate:"required,uuid"`
+}
+
+type OwnerBlockResponse struct {
+	BlockedUserID string    `json:"blockedUserId"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
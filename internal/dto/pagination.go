@@ -0,0 +1,39 @@
+package dto
+
+import "math"
+
+// PaginationMeta describes where a page sits in the full result set.
+type PaginationMeta struct {
+	Total      int64 `json:"total"`
+	Page       int   `json:"page"`
+	Limit      int   `json:"limit"`
+	TotalPages int   `json:"totalPages"`
+}
+
+// PaginatedResponse is the envelope every paginated list endpoint returns:
+// the page's items under "items" and the paging details under "meta".
+type PaginatedResponse[T any] struct {
+	Items []T            `json:"items"`
+	Meta  PaginationMeta `json:"meta"`
+}
+
+// NewPaginatedResponse wraps one page of items. Page and limit are clamped to
+// at least 1, and a nil items slice is reported as empty rather than null.
+func NewPaginatedResponse[T any](items []T, total int64, page, limit int) *PaginatedResponse[T] {
+	page = max(page, 1)
+	limit = max(limit, 1)
+
+	if items == nil {
+		items = []T{}
+	}
+
+	return &PaginatedResponse[T]{
+		Items: items,
+		Meta: PaginationMeta{
+			Total:      total,
+			Page:       page,
+			Limit:      limit,
+			TotalPages: int(math.Ceil(float64(total) / float64(limit))),
+		},
+	}
+}
@@ -0,0 +1,22 @@
+package dto
+
+import (
+	"time"
+	"waste-space/pkg/money"
+)
+
+type PaymentResponse struct {
+	ID                string       `json:"id"`
+	UserID            string       `json:"userId"`
+	BookingID         string       `json:"bookingId,omitempty"`
+	UsageID           string       `json:"usageId,omitempty"`
+	Amount            money.Amount `json:"amount"`
+	Currency          string       `json:"currency"`
+	Status            string       `json:"status" enums:"pending,paid,failed"`
+	ProviderReference string       `json:"providerReference,omitempty"`
+	FailureReason     string       `json:"failureReason,omitempty"`
+	RefundedAmount    money.Amount `json:"refundedAmount"`
+	RefundedAt        *time.Time   `json:"refundedAt,omitempty"`
+	CreatedAt         time.Time    `json:"createdAt"`
+	UpdatedAt         time.Time    `json:"updatedAt"`
+}
@@ -0,0 +1,32 @@
+package dto
+
+import (
+	"time"
+	"waste-space/pkg/money"
+)
+
+// PricingRuleRequest sets either dayOfWeek (0 = Sunday) or a startDate and
+// endDate pair (YYYY-MM-DD, inclusive), and either multiplier or
+// priceOverride.
+type PricingRuleRequest struct {
+	Label         string        `json:"label" validate:"max=100"`
+	DayOfWeek     *int          `json:"dayOfWeek" validate:"omitempty,min=0,max=6"`
+	StartDate     string        `json:"startDate" example:"2025-12-24"`
+	EndDate       string        `json:"endDate" example:"2025-12-26"`
+	Multiplier    *float64      `json:"multiplier" validate:"omitempty,gt=0"`
+	PriceOverride *money.Amount `json:"priceOverride" validate:"omitempty,gt=0"`
+}
+
+type PricingRuleResponse struct {
+	ID            string        `json:"id"`
+	DumpsterID    string        `json:"dumpsterId"`
+	Label         string        `json:"label"`
+	DayOfWeek     *int          `json:"dayOfWeek,omitempty"`
+	StartDate     string        `json:"startDate,omitempty"`
+	EndDate       string        `json:"endDate,omitempty"`
+	Multiplier    *float64      `json:"multiplier,omitempty"`
+	PriceOverride *money.Amount `json:"priceOverride,omitempty"`
+	Currency      string        `json:"currency,omitempty"`
+	CreatedAt     time.Time     `json:"createdAt"`
+	UpdatedAt     time.Time     `json:"updatedAt"`
+}
@@ -13,25 +13,99 @@ type UpdateReviewRequest struct {
 }
 
 type ReviewResponse struct {
-	ID         string        `json:"id"`
-	DumpsterID string        `json:"dumpsterId"`
-	UserID     string        `json:"userId"`
-	User       *UserResponse `json:"user,omitempty"`
-	Rating     int           `json:"rating"`
-	Comment    string        `json:"comment"`
-	CreatedAt  time.Time     `json:"createdAt"`
-	UpdatedAt  time.Time     `json:"updatedAt"`
+	ID              string        `json:"id"`
+	DumpsterID      string        `json:"dumpsterId"`
+	DumpsterTitle   string        `json:"dumpsterTitle,omitempty"`
+	UserID          string        `json:"userId"`
+	User            *UserResponse `json:"user,omitempty"`
+	Rating          int           `json:"rating"`
+	Comment         string        `json:"comment"`
+	OwnerReply      *string       `json:"ownerReply,omitempty"`
+	OwnerRepliedAt  *time.Time    `json:"ownerRepliedAt,omitempty"`
+	HelpfulCount    int           `json:"helpfulCount"`
+	NotHelpfulCount int           `json:"notHelpfulCount"`
+	// Editable is false once the review's edit window has closed.
+	Editable      bool       `json:"editable"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	UpdatedAt     time.Time  `json:"updatedAt"`
+	DeletedAt     *time.Time `json:"deletedAt,omitempty"`
+	DeletedReason string     `json:"deletedReason,omitempty" enums:"author,moderator"`
+}
+
+// ReviewThreadResponse nests a review with the owner's reply and its
+// helpfulness votes so clients can render it as one unit. Reply is null
+// until the owner replies.
+type ReviewThreadResponse struct {
+	Review ReviewResponse    `json:"review"`
+	Reply  *ReviewReply      `json:"reply"`
+	Votes  ReviewVoteSummary `json:"votes"`
+}
+
+type ReviewReply struct {
+	Text      string    `json:"text"`
+	RepliedAt time.Time `json:"repliedAt"`
+}
+
+type ReviewVoteSummary struct {
+	Helpful    int `json:"helpful"`
+	NotHelpful int `json:"notHelpful"`
+	Total      int `json:"total"`
 }
 
 type ReviewListRequest struct {
-	Page  int `form:"page" validate:"omitempty,min=1"`
-	Limit int `form:"limit" validate:"omitempty,min=1,max=100"`
+	Page   int    `form:"page" validate:"omitempty,min=1"`
+	Limit  int    `form:"limit" validate:"omitempty,min=1,max=100"`
+	SortBy string `form:"sortBy" validate:"omitempty,oneof=recent helpful"`
+}
+
+type AdminReviewListRequest struct {
+	Page           int    `form:"page" validate:"omitempty,min=1"`
+	Limit          int    `form:"limit" validate:"omitempty,min=1,max=100"`
+	DumpsterID     string `form:"dumpsterId"`
+	UserID         string `form:"userId"`
+	IncludeDeleted bool   `form:"includeDeleted"`
+}
+
+type ReviewVoteRequest struct {
+	Helpful *bool `json:"helpful" validate:"required"`
+}
+
+type ReviewListResponse = PaginatedResponse[ReviewResponse]
+
+// ReviewSentimentCounts buckets a dumpster's reviews by rating: positive is
+// 4-5 stars, neutral 3, negative 1-2.
+type ReviewSentimentCounts struct {
+	Positive int64
+	Neutral  int64
+	Negative int64
+}
+
+// ReviewSummary is a dumpster's average rating and review count.
+type ReviewSummary struct {
+	DumpsterID string  `json:"dumpsterId"`
+	Average    float64 `json:"average"`
+	Count      int64   `json:"count"`
+}
+
+type SentimentBucket struct {
+	Count      int64   `json:"count"`
+	Percentage float64 `json:"percentage"`
+}
+
+type ReviewSentimentResponse struct {
+	DumpsterID string          `json:"dumpsterId"`
+	Total      int64           `json:"total"`
+	Positive   SentimentBucket `json:"positive"`
+	Neutral    SentimentBucket `json:"neutral"`
+	Negative   SentimentBucket `json:"negative"`
 }
 
-type ReviewListResponse struct {
-	Reviews    []ReviewResponse `json:"reviews"`
-	Total      int64            `json:"total"`
-	Page       int              `json:"page"`
-	Limit      int              `json:"limit"`
-	TotalPages int              `json:"totalPages"`
+// ReceivedReviewRow is one line of an owner's received-reviews export.
+type ReceivedReviewRow struct {
+	DumpsterTitle     string
+	Rating            int
+	Comment           string
+	ReviewerFirstName string
+	ReviewerLastName  string
+	CreatedAt         time.Time
 }
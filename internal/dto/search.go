@@ -0,0 +1,28 @@
+package dto
+
+const (
+	SearchHitTypeDumpster = "dumpster"
+	SearchHitTypeReview   = "review"
+)
+
+type GlobalSearchRequest struct {
+	Query string `form:"q" validate:"required,min=2"`
+	Limit int    `form:"limit" validate:"omitempty,min=1,max=20"`
+}
+
+// SearchHit is one global search result; Type says which field is set.
+// Review hits also carry the reviewed listing in Dumpster.
+type SearchHit struct {
+	Type     string            `json:"type" enums:"dumpster,review"`
+	Dumpster *DumpsterResponse `json:"dumpster,omitempty"`
+	Review   *ReviewResponse   `json:"review,omitempty"`
+}
+
+// GlobalSearchResponse lists listing hits first, then review hits, each
+// capped at the requested limit.
+type GlobalSearchResponse struct {
+	Query         string      `json:"query"`
+	Results       []SearchHit `json:"results"`
+	DumpsterCount int         `json:"dumpsterCount"`
+	ReviewCount   int         `json:"reviewCount"`
+}
@@ -0,0 +1,68 @@
+package dto
+
+import (
+	"time"
+	"waste-space/pkg/money"
+)
+
+// DumpsterTimelineRequest pages with the cursor from the previous response:
+// Before alone returns events strictly older than it, and BeforeID
+// continues among events sharing that exact timestamp.
+type DumpsterTimelineRequest struct {
+	Before   *time.Time `form:"before" time_format:"2006-01-02T15:04:05Z07:00"`
+	BeforeID string     `form:"beforeId" validate:"omitempty,uuid"`
+	Limit    int        `form:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+// TimelineEvent is a single entry in a dumpster timeline. Type is the
+// discriminator and exactly one matching payload field is set.
+type TimelineEvent struct {
+	ID          string               `json:"id"`
+	Type        string               `json:"type" enums:"created,price_changed,booking,usage,review"`
+	OccurredAt  time.Time            `json:"occurredAt"`
+	Created     *TimelineCreated     `json:"created,omitempty"`
+	PriceChange *TimelinePriceChange `json:"priceChange,omitempty"`
+	Booking     *TimelineBooking     `json:"booking,omitempty"`
+	Usage       *TimelineUsage       `json:"usage,omitempty"`
+	Review      *TimelineReview      `json:"review,omitempty"`
+}
+
+type TimelineCreated struct {
+	Title       string       `json:"title"`
+	PricePerDay money.Amount `json:"pricePerDay"`
+}
+
+type TimelinePriceChange struct {
+	OldPrice money.Amount `json:"oldPrice"`
+	NewPrice money.Amount `json:"newPrice"`
+}
+
+type TimelineBooking struct {
+	ID         string       `json:"id"`
+	UserID     string       `json:"userId"`
+	Status     string       `json:"status"`
+	StartDate  time.Time    `json:"startDate"`
+	EndDate    time.Time    `json:"endDate"`
+	TotalPrice money.Amount `json:"totalPrice"`
+}
+
+type TimelineUsage struct {
+	ID        string        `json:"id"`
+	UserID    string        `json:"userId"`
+	Status    string        `json:"status"`
+	StartTime time.Time     `json:"startTime"`
+	EndTime   *time.Time    `json:"endTime,omitempty"`
+	TotalCost *money.Amount `json:"totalCost,omitempty"`
+}
+
+type TimelineReview struct {
+	ID     string `json:"id"`
+	UserID string `json:"userId"`
+	Rating int    `json:"rating"`
+}
+
+type DumpsterTimelineResponse struct {
+	Events       []TimelineEvent `json:"events"`
+	NextBefore   *time.Time      `json:"nextBefore,omitempty"`
+	NextBeforeID string          `json:"nextBeforeId,omitempty"`
+}
@@ -2,6 +2,7 @@ package dto
 
 import (
 	"time"
+	"waste-space/pkg/money"
 )
 
 type StartUsageRequest struct {
@@ -9,47 +10,225 @@ type StartUsageRequest struct {
 	Notes     string    `json:"notes"`
 }
 
+// CreateUsageDraftRequest prepares a usage session without starting it.
+// StartTime is the planned start and defaults to now.
+type CreateUsageDraftRequest struct {
+	StartTime *time.Time `json:"startTime"`
+	Notes     string     `json:"notes"`
+}
+
+// UpdateUsageDraftRequest edits a draft; omitted fields are left unchanged.
+type UpdateUsageDraftRequest struct {
+	StartTime *time.Time `json:"startTime"`
+	Notes     *string    `json:"notes"`
+}
+
+// ActivateUsageRequest starts a draft session. StartTime defaults to now.
+type ActivateUsageRequest struct {
+	StartTime *time.Time `json:"startTime"`
+}
+
 type EndUsageRequest struct {
 	EndTime time.Time `json:"endTime" validate:"required"`
 	Notes   string    `json:"notes"`
 }
 
 type UsageResponse struct {
-	ID              string           `json:"id"`
-	DumpsterID      string           `json:"dumpsterId"`
-	Dumpster        *DumpsterResponse `json:"dumpster,omitempty"`
-	UserID          string           `json:"userId"`
-	User            *UserResponse    `json:"user,omitempty"`
-	StartTime       time.Time        `json:"startTime"`
-	EndTime         *time.Time       `json:"endTime,omitempty"`
-	DurationMinutes *int             `json:"durationMinutes,omitempty"`
-	TotalCost       *float64         `json:"totalCost,omitempty"`
-	Status          string           `json:"status"`
-	Notes           string           `json:"notes"`
-	CreatedAt       time.Time        `json:"createdAt"`
-	UpdatedAt       time.Time        `json:"updatedAt"`
-}
-
-type UsageListResponse struct {
-	Usages     []UsageResponse `json:"usages"`
-	Total      int64           `json:"total"`
-	Page       int             `json:"page"`
-	Limit      int             `json:"limit"`
-	TotalPages int             `json:"totalPages"`
+	ID                 string            `json:"id"`
+	DumpsterID         string            `json:"dumpsterId"`
+	Dumpster           *DumpsterResponse `json:"dumpster,omitempty"`
+	UserID             string            `json:"userId"`
+	User               *UserResponse     `json:"user,omitempty"`
+	StartTime          time.Time         `json:"startTime"`
+	EndTime            *time.Time        `json:"endTime,omitempty"`
+	DurationMinutes    *int              `json:"durationMinutes,omitempty"`
+	TotalCost          *money.Amount     `json:"totalCost,omitempty"`
+	TotalCostFormatted string            `json:"totalCostFormatted,omitempty"`
+	Currency           string            `json:"currency"`
+	Status             string            `json:"status"`
+	Notes              string            `json:"notes"`
+	IsPaid             bool              `json:"isPaid"`
+	PaidAt             *time.Time        `json:"paidAt,omitempty"`
+	Impact             *UsageImpact      `json:"impact,omitempty"`
+	CreatedAt          time.Time         `json:"createdAt"`
+	UpdatedAt          time.Time         `json:"updatedAt"`
 }
 
-type UsageStatsResponse struct {
-	TotalUsages     int64   `json:"totalUsages"`
-	ActiveUsages    int64   `json:"activeUsages"`
+// UsageImpact is the estimated environmental impact of a completed usage.
+// Listings without a readable weight report zeros with Estimated false.
+type UsageImpact struct {
+	WasteDivertedKg float64 `json:"wasteDivertedKg"`
+	CO2eAvoidedKg   float64 `json:"co2eAvoidedKg"`
+	Estimated       bool    `json:"estimated"`
+}
+
+// ImpactResponse totals the estimated impact of a user's completed usages.
+// EstimatedUsages counts those whose dumpster lists a readable weight; the
+// others add nothing to the totals.
+type ImpactResponse struct {
 	CompletedUsages int64   `json:"completedUsages"`
-	TotalMinutes    int64   `json:"totalMinutes"`
-	TotalRevenue    float64 `json:"totalRevenue"`
+	EstimatedUsages int64   `json:"estimatedUsages"`
+	WasteDivertedKg float64 `json:"wasteDivertedKg"`
+	CO2eAvoidedKg   float64 `json:"co2eAvoidedKg"`
+}
+
+// UsageImpactGroup counts completed usages of dumpsters sharing a size and
+// listed weight.
+type UsageImpactGroup struct {
+	Size   string
+	Weight string
+	Usages int64
+}
+
+// UsageRunningCostResponse is the cost of a usage so far. For active usages
+// it is priced up to AsOf (the time of the request); for completed usages it
+// is the final charge and Final is true.
+type UsageRunningCostResponse struct {
+	UsageID         string       `json:"usageId"`
+	Status          string       `json:"status"`
+	StartTime       time.Time    `json:"startTime"`
+	AsOf            time.Time    `json:"asOf"`
+	DurationMinutes int          `json:"durationMinutes"`
+	Cost            money.Amount `json:"cost"`
+	CostFormatted   string       `json:"costFormatted"`
+	Currency        string       `json:"currency"`
+	Final           bool         `json:"final"`
+}
+
+// BalanceResponse is what a user owes for completed usages not yet paid.
+type BalanceResponse struct {
+	Balance      money.Amount `json:"balance"`
+	Currency     string       `json:"currency"`
+	UnpaidUsages int64        `json:"unpaidUsages"`
+}
+
+type UsageListResponse = PaginatedResponse[UsageResponse]
+
+type UsageStatsResponse struct {
+	TotalUsages     int64        `json:"totalUsages"`
+	ActiveUsages    int64        `json:"activeUsages"`
+	CompletedUsages int64        `json:"completedUsages"`
+	TotalMinutes    int64        `json:"totalMinutes"`
+	TotalRevenue    money.Amount `json:"totalRevenue"`
 }
 
 type UsageListRequest struct {
 	Page       int    `form:"page" validate:"omitempty,min=1"`
 	Limit      int    `form:"limit" validate:"omitempty,min=1,max=100"`
-	Status     string `form:"status" validate:"omitempty,oneof=active completed cancelled"`
+	Status     string `form:"status" validate:"omitempty,oneof=draft active completed cancelled"`
 	DumpsterID string `form:"dumpsterId"`
 	UserID     string `form:"userId"`
 }
+
+type ReceiptLineItem struct {
+	Description string       `json:"description"`
+	Quantity    float64      `json:"quantity"`
+	Unit        string       `json:"unit"`
+	UnitPrice   money.Amount `json:"unitPrice"`
+	Amount      money.Amount `json:"amount"`
+}
+
+type ReceiptDumpster struct {
+	ID      string `json:"id"`
+	Title   string `json:"title"`
+	Address string `json:"address"`
+	City    string `json:"city"`
+	State   string `json:"state"`
+	ZipCode string `json:"zipCode"`
+	Size    string `json:"size"`
+}
+
+type ReceiptParty struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Email       string `json:"email"`
+	PhoneNumber string `json:"phoneNumber"`
+}
+
+type UsageReceiptResponse struct {
+	ReceiptNumber   string            `json:"receiptNumber"`
+	UsageID         string            `json:"usageId"`
+	IssuedAt        time.Time         `json:"issuedAt"`
+	Dumpster        ReceiptDumpster   `json:"dumpster"`
+	Owner           *ReceiptParty     `json:"owner,omitempty"`
+	Customer        *ReceiptParty     `json:"customer,omitempty"`
+	StartTime       time.Time         `json:"startTime"`
+	EndTime         time.Time         `json:"endTime"`
+	DurationMinutes int               `json:"durationMinutes"`
+	LineItems       []ReceiptLineItem `json:"lineItems"`
+	Subtotal        money.Amount      `json:"subtotal"`
+	Fees            money.Amount      `json:"fees"`
+	TaxRate         float64           `json:"taxRate"`
+	Tax             money.Amount      `json:"tax"`
+	Total           money.Amount      `json:"total"`
+	Currency        string            `json:"currency"`
+	Impact          *UsageImpact      `json:"impact,omitempty"`
+}
+
+type UsageTrendsRequest struct {
+	DumpsterID  string     `form:"dumpsterId"`
+	OwnerID     string     `form:"ownerId"`
+	Granularity string     `form:"granularity" validate:"omitempty,oneof=day week month"`
+	From        *time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To          *time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+}
+
+type OccupancyRequest struct {
+	From *time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To   *time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+}
+
+// OccupancyResponse is the share of the period a dumpster spent in completed
+// usages. UsedMinutes sums each usage's overlap with the period; Percentage
+// is capped at 100 for dumpsters that allow concurrent usages.
+type OccupancyResponse struct {
+	DumpsterID    string    `json:"dumpsterId"`
+	From          time.Time `json:"from"`
+	To            time.Time `json:"to"`
+	UsedMinutes   int64     `json:"usedMinutes"`
+	PeriodMinutes int64     `json:"periodMinutes"`
+	Percentage    float64   `json:"percentage"`
+}
+
+type SpendingReportRequest struct {
+	From *time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To   *time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+}
+
+type SpendingByMonth struct {
+	Month  time.Time    `json:"month"`
+	Usages int64        `json:"usages"`
+	Total  money.Amount `json:"total"`
+}
+
+type SpendingBySize struct {
+	Size   string       `json:"size" enums:"small,medium,large,extraLarge"`
+	Usages int64        `json:"usages"`
+	Total  money.Amount `json:"total"`
+}
+
+// SpendingReportResponse totals the user's completed usages by the month
+// they ended in and by dumpster size. Every month in the range and every
+// size is listed, with zeros where nothing was spent.
+type SpendingReportResponse struct {
+	From     time.Time         `json:"from"`
+	To       time.Time         `json:"to"`
+	Currency string            `json:"currency"`
+	Total    money.Amount      `json:"total"`
+	ByMonth  []SpendingByMonth `json:"byMonth"`
+	BySize   []SpendingBySize  `json:"bySize"`
+}
+
+type UsageTrendBucket struct {
+	Start   time.Time    `json:"start"`
+	Usages  int64        `json:"usages"`
+	Revenue money.Amount `json:"revenue"`
+}
+
+type UsageTrendsResponse struct {
+	Granularity string             `json:"granularity"`
+	From        time.Time          `json:"from"`
+	To          time.Time          `json:"to"`
+	Currency    string             `json:"currency"`
+	Buckets     []UsageTrendBucket `json:"buckets"`
+}
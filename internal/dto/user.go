@@ -1,6 +1,9 @@
 package dto
 
-import "time"
+import (
+	"time"
+	"waste-space/pkg/money"
+)
 
 type CreateUserRequest struct {
 	FirstName   string    `json:"firstName" validate:"required,min=2,max=100"`
@@ -53,7 +56,56 @@ type UserResponse struct {
 	IsEmailVerified bool       `json:"isEmailVerified"`
 	IsPhoneVerified bool       `json:"isPhoneVerified"`
 	IsActive        bool       `json:"isActive"`
+	Role            string     `json:"role"`
 	LastLoginAt     *time.Time `json:"lastLoginAt,omitempty"`
 	CreatedAt       time.Time  `json:"createdAt"`
 	UpdatedAt       time.Time  `json:"updatedAt"`
 }
+
+type PublicUserResponse struct {
+	ID        string    `json:"id"`
+	FirstName string    `json:"firstName"`
+	LastName  string    `json:"lastName"`
+	City      string    `json:"city"`
+	State     string    `json:"state"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type UserProximityRequest struct {
+	Latitude  float64 `form:"lat" validate:"required,latitude"`
+	Longitude float64 `form:"lng" validate:"required,longitude"`
+	Radius    float64 `form:"radius" validate:"required,gt=0,max=100"`
+}
+
+// UserProximityResponse is deliberately aggregate-only: it must never carry
+// individual user identifiers or locations.
+type UserProximityResponse struct {
+	Latitude  float64 `json:"lat"`
+	Longitude float64 `json:"lng"`
+	Radius    float64 `json:"radius"`
+	UserCount int64   `json:"userCount"`
+}
+
+// UserEngagementResponse pairs the user's active usages with lifetime usage
+// totals. TotalSpend sums the cost of every usage, active ones included.
+type UserEngagementResponse struct {
+	ActiveUsages        []UsageResponse `json:"activeUsages"`
+	TotalUsages         int64           `json:"totalUsages"`
+	CompletedUsages     int64           `json:"completedUsages"`
+	TotalMinutes        int64           `json:"totalMinutes"`
+	TotalSpend          money.Amount    `json:"totalSpend"`
+	TotalSpendFormatted string          `json:"totalSpendFormatted"`
+	Currency            string          `json:"currency"`
+}
+
+// UserOverviewResponse gathers the account tab in one call. Every section
+// but Profile is best effort: one that fails to load is null. OwnerStats is
+// also null for users without listings.
+type UserOverviewResponse struct {
+	Profile             UserResponse            `json:"profile"`
+	ActiveUsages        []UsageResponse         `json:"activeUsages"`
+	UpcomingBookings    []BookingResponse       `json:"upcomingBookings"`
+	UnreadNotifications *int64                  `json:"unreadNotifications"`
+	Balance             *BalanceResponse        `json:"balance"`
+	OwnerStats          *OwnerDashboardResponse `json:"ownerStats"`
+}
@@ -1,9 +1,12 @@
 package middleware
 
 import (
+	"context"
+	"crypto/subtle"
 	"net/http"
 	"strings"
 	"waste-space/pkg/auth"
+	apperrors "waste-space/pkg/errors"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -11,13 +14,44 @@ import (
 
 const (
 	authorizationHeader = "Authorization"
+	serviceTokenHeader  = "X-Service-Token"
+	apiKeyHeader        = "X-API-Key"
 	bearerPrefix        = "Bearer "
 	userIDKey           = "userID"
+	sessionIDKey        = "sessionID"
 	emailKey            = "email"
+	roleKey             = "role"
+	apiKeyAuthKey       = "apiKeyAuth"
+	adminRole           = "admin"
 )
 
-func Auth(tokenService auth.TokenService) gin.HandlerFunc {
+// APIKeyAuthenticator resolves a raw X-API-Key value to the claims of the
+// user the key belongs to.
+type APIKeyAuthenticator interface {
+	AuthenticateAPIKey(ctx context.Context, key string) (*auth.Claims, error)
+}
+
+// Auth requires a valid bearer token, or a valid X-API-Key when one is sent
+// instead.
+func Auth(tokenService auth.TokenService, apiKeys APIKeyAuthenticator) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		if key := c.GetHeader(apiKeyHeader); key != "" {
+			claims, err := apiKeys.AuthenticateAPIKey(c.Request.Context(), key)
+			if err != nil {
+				if apperrors.Is(err, apperrors.ErrorTypeUnauthorized) {
+					c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid API key"})
+				} else {
+					c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to authenticate API key"})
+				}
+				c.Abort()
+				return
+			}
+
+			setAPIKeyClaims(c, claims)
+			c.Next()
+			return
+		}
+
 		authHeader := c.GetHeader(authorizationHeader)
 		if authHeader == "" {
 			c.JSON(http.StatusUnauthorized, gin.H{"error": "authorization header required"})
@@ -40,7 +74,81 @@ func Auth(tokenService auth.TokenService) gin.HandlerFunc {
 		}
 
 		c.Set(userIDKey, claims.UserID)
+		c.Set(sessionIDKey, claims.SessionID)
+		c.Set(emailKey, claims.Email)
+		c.Set(roleKey, claims.Role)
+		c.Next()
+	}
+}
+
+// OptionalAuth identifies the caller when a valid bearer token or API key is
+// present and otherwise lets the request through anonymously. Use it on
+// public routes that personalize their behavior for signed-in users.
+func OptionalAuth(tokenService auth.TokenService, apiKeys APIKeyAuthenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if key := c.GetHeader(apiKeyHeader); key != "" {
+			if claims, err := apiKeys.AuthenticateAPIKey(c.Request.Context(), key); err == nil {
+				setAPIKeyClaims(c, claims)
+			}
+			c.Next()
+			return
+		}
+
+		authHeader := c.GetHeader(authorizationHeader)
+		if !strings.HasPrefix(authHeader, bearerPrefix) {
+			c.Next()
+			return
+		}
+
+		claims, err := tokenService.ValidateToken(strings.TrimPrefix(authHeader, bearerPrefix))
+		if err != nil {
+			c.Next()
+			return
+		}
+
+		c.Set(userIDKey, claims.UserID)
+		c.Set(sessionIDKey, claims.SessionID)
 		c.Set(emailKey, claims.Email)
+		c.Set(roleKey, claims.Role)
+		c.Next()
+	}
+}
+
+// AuthOrServiceToken admits callers presenting the shared service credential
+// in X-Service-Token, and otherwise falls back to regular bearer auth. An
+// empty serviceToken disables the service credential entirely.
+func AuthOrServiceToken(tokenService auth.TokenService, apiKeys APIKeyAuthenticator, serviceToken string) gin.HandlerFunc {
+	bearerAuth := Auth(tokenService, apiKeys)
+
+	return func(c *gin.Context) {
+		provided := c.GetHeader(serviceTokenHeader)
+		if serviceToken != "" && provided != "" &&
+			subtle.ConstantTimeCompare([]byte(provided), []byte(serviceToken)) == 1 {
+			c.Next()
+			return
+		}
+
+		bearerAuth(c)
+	}
+}
+
+// setAPIKeyClaims records an API key caller. There is no session behind an
+// API key, so no session ID is set.
+func setAPIKeyClaims(c *gin.Context, claims *auth.Claims) {
+	c.Set(userIDKey, claims.UserID)
+	c.Set(emailKey, claims.Email)
+	c.Set(roleKey, claims.Role)
+	c.Set(apiKeyAuthKey, true)
+}
+
+func RequireAdmin() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !IsAdmin(c) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "admin access required"})
+			c.Abort()
+			return
+		}
+
 		c.Next()
 	}
 }
@@ -54,3 +162,32 @@ func GetUserID(c *gin.Context) (uuid.UUID, bool) {
 	id, ok := userID.(uuid.UUID)
 	return id, ok
 }
+
+func GetSessionID(c *gin.Context) (uuid.UUID, bool) {
+	sessionID, exists := c.Get(sessionIDKey)
+	if !exists {
+		return uuid.Nil, false
+	}
+
+	id, ok := sessionID.(uuid.UUID)
+	return id, ok
+}
+
+// GetAccessToken returns the bearer token from the Authorization header.
+func GetAccessToken(c *gin.Context) string {
+	return strings.TrimPrefix(c.GetHeader(authorizationHeader), bearerPrefix)
+}
+
+func GetRole(c *gin.Context) string {
+	return c.GetString(roleKey)
+}
+
+func IsAdmin(c *gin.Context) bool {
+	return GetRole(c) == adminRole
+}
+
+// IsAPIKeyAuth reports whether the caller authenticated with an API key
+// rather than a bearer token.
+func IsAPIKeyAuth(c *gin.Context) bool {
+	return c.GetBool(apiKeyAuthKey)
+}
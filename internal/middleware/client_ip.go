@@ -0,0 +1,13 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// ClientIP returns the caller's address for rate limiting and audit logs.
+// Forwarding headers are only honoured when the direct peer is one of the
+// proxies configured on the engine via SetTrustedProxies; otherwise the
+// socket address is used, so clients can't spoof it with X-Forwarded-For.
+func ClientIP(c *gin.Context) string {
+	return c.ClientIP()
+}
@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	listEnvelopeHeader = "X-List-Envelope"
+	legacyListKey      = "legacyListEnvelope"
+
+	ListEnvelopeItems  = "items"
+	ListEnvelopeLegacy = "legacy"
+)
+
+// ListEnvelope picks the JSON shape paginated lists are written in. The
+// default comes from config while clients migrate; a request can override
+// it with an X-List-Envelope header of "items" or "legacy".
+func ListEnvelope(legacyByDefault bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		legacy := legacyByDefault
+		switch strings.ToLower(strings.TrimSpace(c.GetHeader(listEnvelopeHeader))) {
+		case ListEnvelopeItems:
+			legacy = false
+		case ListEnvelopeLegacy:
+			legacy = true
+		}
+
+		c.Set(legacyListKey, legacy)
+		c.Next()
+	}
+}
+
+// UsesLegacyListEnvelope reports whether the request's paginated lists
+// should use the per-endpoint item key with top-level paging fields.
+func UsesLegacyListEnvelope(c *gin.Context) bool {
+	return c.GetBool(legacyListKey)
+}
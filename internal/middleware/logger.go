@@ -1,23 +1,188 @@
 package middleware
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
 	"log"
+	"net/http"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
-func Logger() gin.HandlerFunc {
+const (
+	redactedValue = "[REDACTED]"
+	// maxLoggedBodyBytes caps how much of each body is kept for logging.
+	// Larger bodies aren't parsed, so they are never logged in part.
+	maxLoggedBodyBytes = 8 << 10
+)
+
+// sensitiveFields are the JSON keys always redacted from logged bodies,
+// compared case-insensitively at any depth.
+var sensitiveFields = []string{
+	"password",
+	"currentPassword",
+	"newPassword",
+	"token",
+	"accessToken",
+	"refreshToken",
+	"holdToken",
+	"otp",
+	"secret",
+	"shareSecret",
+	"key",
+	"apiKey",
+}
+
+// sensitiveHeaders are the request headers whose values are never logged.
+var sensitiveHeaders = []string{authorizationHeader, serviceTokenHeader, apiKeyHeader, "Cookie"}
+
+// LoggerConfig controls the request log. With LogBodies off only the request
+// line, status and latency are logged. RedactFields adds JSON keys to redact
+// on top of the built-in password and token fields.
+type LoggerConfig struct {
+	LogBodies    bool
+	RedactFields []string
+}
+
+func Logger(cfg LoggerConfig) gin.HandlerFunc {
+	redact := make(map[string]struct{}, len(sensitiveFields)+len(cfg.RedactFields))
+	for _, field := range slices.Concat(sensitiveFields, cfg.RedactFields) {
+		if field = strings.TrimSpace(field); field != "" {
+			redact[strings.ToLower(field)] = struct{}{}
+		}
+	}
+
 	return func(c *gin.Context) {
 		start := time.Now()
 		path := c.Request.URL.Path
 		method := c.Request.Method
 
+		var requestBody []byte
+		var responseBody *limitedBuffer
+		if cfg.LogBodies {
+			requestBody = peekBody(c.Request)
+			responseBody = &limitedBuffer{}
+			c.Writer = &bodyLogWriter{ResponseWriter: c.Writer, body: responseBody}
+		}
+
 		c.Next()
 
 		latency := time.Since(start)
 		status := c.Writer.Status()
 
-		log.Printf("%s %s %d %v", method, path, status, latency)
+		log.Printf("%s %s %s %d %v", ClientIP(c), method, path, status, latency)
+
+		if cfg.LogBodies {
+			log.Printf("%s %s headers=%s request=%s response=%s",
+				method, path,
+				redactHeaders(c.Request.Header),
+				redactBody(requestBody, redact),
+				redactBody(responseBody.Bytes(), redact))
+		}
+	}
+}
+
+// peekBody reads up to maxLoggedBodyBytes+1 bytes of the request body and
+// puts them back in front of the rest so the handler still sees all of it.
+func peekBody(r *http.Request) []byte {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil
+	}
+
+	head, _ := io.ReadAll(io.LimitReader(r.Body, maxLoggedBodyBytes+1))
+	r.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(head), r.Body), r.Body}
+
+	return head
+}
+
+// redactBody renders a body for the log with every sensitive key's value
+// replaced. Anything that isn't complete JSON is summarised by size only,
+// since there's no telling what it contains.
+func redactBody(body []byte, redact map[string]struct{}) string {
+	if len(body) == 0 {
+		return "-"
+	}
+	if len(body) > maxLoggedBodyBytes {
+		return fmt.Sprintf("[%d+ bytes omitted]", maxLoggedBodyBytes)
+	}
+
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		return fmt.Sprintf("[%d bytes omitted]", len(body))
 	}
+
+	out, err := json.Marshal(redactValue(value, redact))
+	if err != nil {
+		return fmt.Sprintf("[%d bytes omitted]", len(body))
+	}
+	return string(out)
+}
+
+func redactValue(value any, redact map[string]struct{}) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if _, ok := redact[strings.ToLower(key)]; ok {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redactValue(field, redact)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redactValue(item, redact)
+		}
+	}
+	return value
+}
+
+func redactHeaders(header http.Header) string {
+	logged := header.Clone()
+	for _, name := range sensitiveHeaders {
+		if logged.Get(name) != "" {
+			logged.Set(name, redactedValue)
+		}
+	}
+
+	out, err := json.Marshal(logged)
+	if err != nil {
+		return "-"
+	}
+	return string(out)
+}
+
+// limitedBuffer keeps the first maxLoggedBodyBytes+1 bytes written to it and
+// drops the rest, so logging never holds a whole large response in memory.
+type limitedBuffer struct {
+	bytes.Buffer
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if room := maxLoggedBodyBytes + 1 - b.Len(); room > 0 {
+		b.Buffer.Write(p[:min(len(p), room)])
+	}
+	return len(p), nil
+}
+
+type bodyLogWriter struct {
+	gin.ResponseWriter
+	body *limitedBuffer
+}
+
+func (w *bodyLogWriter) Write(p []byte) (int, error) {
+	w.body.Write(p)
+	return w.ResponseWriter.Write(p)
+}
+
+func (w *bodyLogWriter) WriteString(s string) (int, error) {
+	w.body.Write([]byte(s))
+	return w.ResponseWriter.WriteString(s)
 }
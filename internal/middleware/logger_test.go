@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestLoggerRedactsRegisterPassword(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var logs bytes.Buffer
+	previous := log.Writer()
+	log.SetOutput(&logs)
+	t.Cleanup(func() { log.SetOutput(previous) })
+
+	const password = "hunter2-secret-pass"
+
+	var received string
+	router := gin.New()
+	router.Use(Logger(LoggerConfig{LogBodies: true, RedactFields: []string{"phone"}}))
+	router.POST("/api/v1/auth/register", func(c *gin.Context) {
+		body, _ := io.ReadAll(c.Request.Body)
+		received = string(body)
+		c.JSON(http.StatusCreated, gin.H{"accessToken": "issued-token", "user": gin.H{"email": "a@example.com"}})
+	})
+
+	body := `{"email":"a@example.com","password":"` + password + `","phone":"+15550100","profile":{"newPassword":"` + password + `"}}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/register", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+password)
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	if received != body {
+		t.Errorf("handler got body %q, want the request unchanged", received)
+	}
+
+	out := logs.String()
+	for _, leaked := range []string{password, "+15550100", "issued-token"} {
+		if strings.Contains(out, leaked) {
+			t.Errorf("log output contains %q:\n%s", leaked, out)
+		}
+	}
+	if !strings.Contains(out, "a@example.com") {
+		t.Errorf("log output is missing non-sensitive fields:\n%s", out)
+	}
+	if !strings.Contains(out, redactedValue) {
+		t.Errorf("log output has no %s marker:\n%s", redactedValue, out)
+	}
+}
@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"waste-space/internal/model"
+	"waste-space/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+func Maintenance(maintenanceService service.MaintenanceService, exemptPaths ...string) gin.HandlerFunc {
+	exempt := make(map[string]struct{}, len(exemptPaths))
+	for _, path := range exemptPaths {
+		exempt[path] = struct{}{}
+	}
+
+	return func(c *gin.Context) {
+		if _, ok := exempt[c.Request.URL.Path]; ok {
+			c.Next()
+			return
+		}
+
+		state := maintenanceService.CurrentState(c.Request.Context())
+		if !blockedByMaintenance(state.Mode, c.Request.Method) {
+			c.Next()
+			return
+		}
+
+		message := state.Message
+		if message == "" {
+			message = "service is under maintenance"
+		}
+
+		c.Header("Retry-After", strconv.Itoa(state.RetryAfterSeconds))
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": message})
+		c.Abort()
+	}
+}
+
+func blockedByMaintenance(mode model.MaintenanceMode, method string) bool {
+	switch mode {
+	case model.MaintenanceModeFull:
+		return true
+	case model.MaintenanceModeReadOnly:
+		return method != http.MethodGet && method != http.MethodHead && method != http.MethodOptions
+	default:
+		return false
+	}
+}
@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+	"waste-space/internal/storage/cache"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RateLimitPolicy caps requests per Window. Anonymous callers are counted
+// per client IP; signed-in callers are counted per user with the
+// Authenticated allowance, or per IP like everyone else when it is zero.
+// A zero Anonymous limit disables the policy.
+type RateLimitPolicy struct {
+	Name          string
+	Window        time.Duration
+	Anonymous     int
+	Authenticated int
+}
+
+// RateLimit rejects callers over the policy's allowance with 429 and a
+// Retry-After header. Every counted response carries X-RateLimit-Limit,
+// X-RateLimit-Remaining and X-RateLimit-Reset (seconds until the window
+// resets) so clients can slow down before they are rejected; where policies
+// are stacked, the innermost one's headers are the ones sent. Run it after
+// OptionalAuth or Auth so signed-in users are keyed by user ID. Limiter
+// errors let the request through rather than taking the API down with the
+// cache.
+func RateLimit(limiter cache.RateLimitCache, policy RateLimitPolicy) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if policy.Anonymous <= 0 {
+			c.Next()
+			return
+		}
+
+		key := fmt.Sprintf("%s:ip:%s", policy.Name, ClientIP(c))
+		limit := policy.Anonymous
+		if userID, ok := GetUserID(c); ok && policy.Authenticated > 0 {
+			key = fmt.Sprintf("%s:user:%s", policy.Name, userID.String())
+			limit = policy.Authenticated
+		}
+
+		count, resetIn, err := limiter.Hit(c.Request.Context(), key, policy.Window)
+		if err != nil {
+			log.Printf("rate limit check failed for %s: %v", key, err)
+			c.Next()
+			return
+		}
+
+		resetSeconds := strconv.Itoa(int(math.Ceil(resetIn.Seconds())))
+		c.Header("X-RateLimit-Limit", strconv.Itoa(limit))
+		c.Header("X-RateLimit-Remaining", strconv.FormatInt(max(int64(limit)-count, 0), 10))
+		c.Header("X-RateLimit-Reset", resetSeconds)
+
+		if count > int64(limit) {
+			c.Header("Retry-After", resetSeconds)
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": "too many requests"})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
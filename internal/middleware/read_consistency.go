@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+	"waste-space/internal/storage/repository"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	readConsistencyHeader  = "X-Read-Consistency"
+	readConsistencyPrimary = "primary"
+)
+
+// ReadConsistency sends every read of a write request to the primary, so a
+// handler never reads back stale data from the replica. GET and HEAD requests
+// can opt in with an X-Read-Consistency: primary header, e.g. a client
+// listing its dumpsters right after creating one.
+func ReadConsistency() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		safe := c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead
+		header := strings.ToLower(strings.TrimSpace(c.GetHeader(readConsistencyHeader)))
+
+		if !safe || header == readConsistencyPrimary {
+			c.Request = c.Request.WithContext(repository.WithPrimary(c.Request.Context()))
+		}
+
+		c.Next()
+	}
+}
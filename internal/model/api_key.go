@@ -0,0 +1,46 @@
+package model
+
+import (
+	"time"
+	"waste-space/internal/dto"
+
+	"github.com/google/uuid"
+)
+
+// APIKey is a long-lived credential for programmatic access. Only the
+// SHA-256 of the key is stored; Prefix is its first characters, kept so the
+// owner can tell keys apart.
+type APIKey struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	UserID     uuid.UUID  `gorm:"type:uuid;not null" json:"userId" validate:"required"`
+	KeyHash    string     `gorm:"type:varchar(64);not null" json:"-"`
+	Prefix     string     `gorm:"type:varchar(16);not null" json:"prefix"`
+	Label      string     `gorm:"type:varchar(100);not null" json:"label"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+	CreatedAt  time.Time  `gorm:"autoCreateTime;not null" json:"createdAt"`
+}
+
+func NewAPIKey(userID uuid.UUID, keyHash, prefix, label string) *APIKey {
+	return &APIKey{
+		UserID:  userID,
+		KeyHash: keyHash,
+		Prefix:  prefix,
+		Label:   label,
+	}
+}
+
+func (k *APIKey) IsRevoked() bool {
+	return k.RevokedAt != nil
+}
+
+func (k *APIKey) ToResponse() dto.APIKeyResponse {
+	return dto.APIKeyResponse{
+		ID:         k.ID.String(),
+		Prefix:     k.Prefix,
+		Label:      k.Label,
+		LastUsedAt: k.LastUsedAt,
+		RevokedAt:  k.RevokedAt,
+		CreatedAt:  k.CreatedAt,
+	}
+}
@@ -0,0 +1,21 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AvailabilityAlert struct {
+	ID         uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	UserID     uuid.UUID `gorm:"type:uuid;not null" json:"userId" validate:"required"`
+	DumpsterID uuid.UUID `gorm:"type:uuid;not null;index" json:"dumpsterId" validate:"required"`
+	CreatedAt  time.Time `gorm:"autoCreateTime;not null" json:"createdAt"`
+}
+
+func NewAvailabilityAlert(userID, dumpsterID uuid.UUID) *AvailabilityAlert {
+	return &AvailabilityAlert{
+		UserID:     userID,
+		DumpsterID: dumpsterID,
+	}
+}
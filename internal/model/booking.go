@@ -0,0 +1,81 @@
+package model
+
+import (
+	"time"
+	"waste-space/internal/dto"
+	"waste-space/pkg/money"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type Booking struct {
+	ID           uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	DumpsterID   uuid.UUID      `gorm:"type:uuid;not null;index" json:"dumpsterId" validate:"required"`
+	Dumpster     *Dumpster      `gorm:"foreignKey:DumpsterID" json:"dumpster,omitempty"`
+	UserID       uuid.UUID      `gorm:"type:uuid;not null;index" json:"userId" validate:"required"`
+	User         *User          `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	StartDate    time.Time      `gorm:"not null" json:"startDate" validate:"required"`
+	EndDate      time.Time      `gorm:"not null" json:"endDate" validate:"required,gtfield=StartDate"`
+	Subtotal     money.Amount   `gorm:"type:decimal(10,2);not null" json:"subtotal"`
+	TaxRate      float64        `gorm:"type:decimal(6,4);not null;default:0" json:"taxRate"`
+	Tax          money.Amount   `gorm:"type:decimal(10,2);not null;default:0" json:"tax"`
+	TotalPrice   money.Amount   `gorm:"type:decimal(10,2);not null" json:"totalPrice"`
+	Status       BookingStatus  `gorm:"type:varchar(20);not null;default:'pending';index" json:"status"`
+	ConfirmedAt  *time.Time     `gorm:"type:timestamp" json:"confirmedAt,omitempty"`
+	ExpiredAt    *time.Time     `gorm:"type:timestamp" json:"expiredAt,omitempty"`
+	PaidAt       *time.Time     `gorm:"type:timestamp" json:"paidAt,omitempty"`
+	EndedEarlyAt *time.Time     `gorm:"type:timestamp" json:"endedEarlyAt,omitempty"`
+	CreatedAt    time.Time      `gorm:"autoCreateTime;not null" json:"createdAt"`
+	UpdatedAt    time.Time      `gorm:"autoUpdateTime;not null" json:"updatedAt"`
+	DeletedAt    gorm.DeletedAt `gorm:"index" json:"-"`
+}
+
+type BookingStatus string
+
+const (
+	BookingStatusPending   BookingStatus = "pending"
+	BookingStatusConfirmed BookingStatus = "confirmed"
+	BookingStatusCancelled BookingStatus = "cancelled"
+	BookingStatusExpired   BookingStatus = "expired"
+)
+
+func NewBookingFromDTO(userID, dumpsterID uuid.UUID, req dto.BookDumpsterRequest) *Booking {
+	return &Booking{
+		UserID:     userID,
+		DumpsterID: dumpsterID,
+		StartDate:  req.StartDate,
+		EndDate:    req.EndDate,
+		Status:     BookingStatusPending,
+	}
+}
+
+func (b *Booking) ToResponse() dto.BookingResponse {
+	resp := dto.BookingResponse{
+		ID:                  b.ID.String(),
+		DumpsterID:          b.DumpsterID.String(),
+		UserID:              b.UserID.String(),
+		StartDate:           b.StartDate,
+		EndDate:             b.EndDate,
+		Subtotal:            b.Subtotal,
+		TaxRate:             b.TaxRate,
+		Tax:                 b.Tax,
+		TotalPrice:          b.TotalPrice,
+		TotalPriceFormatted: money.Format(b.TotalPrice),
+		Currency:            money.Currency(),
+		Status:              string(b.Status),
+		ConfirmedAt:         b.ConfirmedAt,
+		ExpiredAt:           b.ExpiredAt,
+		PaidAt:              b.PaidAt,
+		EndedEarlyAt:        b.EndedEarlyAt,
+		CreatedAt:           b.CreatedAt,
+		UpdatedAt:           b.UpdatedAt,
+	}
+
+	if b.Dumpster != nil {
+		dumpsterResp := b.Dumpster.ToResponse()
+		resp.Dumpster = &dumpsterResp
+	}
+
+	return resp
+}
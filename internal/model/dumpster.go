@@ -1,36 +1,48 @@
 package model
 
 import (
+	"slices"
 	"time"
 	"waste-space/internal/dto"
+	"waste-space/pkg/money"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
 type Dumpster struct {
-	ID          uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
-	OwnerID     uuid.UUID      `gorm:"type:uuid;not null;index" json:"ownerId" validate:"required"`
-	Owner       *User          `gorm:"foreignKey:OwnerID" json:"owner,omitempty"`
-	Title       string         `gorm:"type:varchar(255);not null" json:"title" validate:"required,min=5,max=255"`
-	Description string         `gorm:"type:text" json:"description"`
-	Location    string         `gorm:"type:varchar(255);not null" json:"location" validate:"required"`
-	Latitude    float64        `gorm:"type:decimal(10,8);not null" json:"latitude" validate:"required,latitude"`
-	Longitude   float64        `gorm:"type:decimal(11,8);not null" json:"longitude" validate:"required,longitude"`
-	Address     string         `gorm:"type:varchar(255);not null" json:"address" validate:"required"`
-	City        string         `gorm:"type:varchar(100);not null" json:"city" validate:"required"`
-	State       string         `gorm:"type:varchar(50);not null" json:"state" validate:"required"`
-	ZipCode     string         `gorm:"type:varchar(10);not null" json:"zipCode" validate:"required"`
-	PricePerDay float64        `gorm:"type:decimal(10,2);not null" json:"pricePerDay" validate:"required,gt=0"`
-	Size        DumpsterSize   `gorm:"type:varchar(20);not null" json:"size" validate:"required,oneof=small medium large extraLarge"`
-	IsAvailable bool           `gorm:"default:true;not null" json:"isAvailable"`
-	Rating      float64        `gorm:"type:decimal(3,2);default:0.0" json:"rating" validate:"gte=0,lte=5"`
-	ReviewCount int            `gorm:"default:0" json:"reviewCount"`
-	Capacity    string         `gorm:"type:varchar(50)" json:"capacity"`
-	Weight      string         `gorm:"type:varchar(50)" json:"weight"`
-	CreatedAt   time.Time      `gorm:"autoCreateTime;not null" json:"createdAt"`
-	UpdatedAt   time.Time      `gorm:"autoUpdateTime;not null" json:"updatedAt"`
-	DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
+	ID               uuid.UUID    `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	OwnerID          uuid.UUID    `gorm:"type:uuid;not null;index" json:"ownerId" validate:"required"`
+	Owner            *User        `gorm:"foreignKey:OwnerID" json:"owner,omitempty"`
+	Title            string       `gorm:"type:varchar(255);not null" json:"title" validate:"required,min=5,max=255"`
+	Slug             string       `gorm:"type:varchar(80);not null;uniqueIndex" json:"slug"`
+	Description      string       `gorm:"type:text" json:"description"`
+	Location         string       `gorm:"type:varchar(255);not null" json:"location" validate:"required"`
+	Latitude         float64      `gorm:"type:decimal(10,8);not null" json:"latitude" validate:"required,latitude"`
+	Longitude        float64      `gorm:"type:decimal(11,8);not null" json:"longitude" validate:"required,longitude"`
+	Address          string       `gorm:"type:varchar(255);not null" json:"address" validate:"required"`
+	City             string       `gorm:"type:varchar(100);not null" json:"city" validate:"required"`
+	State            string       `gorm:"type:varchar(50);not null" json:"state" validate:"required"`
+	ZipCode          string       `gorm:"type:varchar(10);not null" json:"zipCode" validate:"required"`
+	PricePerDay      money.Amount `gorm:"type:decimal(10,2);not null" json:"pricePerDay" validate:"required,gt=0"`
+	Size             DumpsterSize `gorm:"type:varchar(20);not null" json:"size" validate:"required,oneof=small medium large extraLarge"`
+	IsAvailable      bool         `gorm:"default:true;not null" json:"isAvailable"`
+	UnavailableUntil *time.Time   `gorm:"type:timestamp" json:"unavailableUntil,omitempty"`
+	Rating           float64      `gorm:"type:decimal(3,2);default:0.0" json:"rating" validate:"gte=0,lte=5"`
+	ReviewCount      int          `gorm:"default:0" json:"reviewCount"`
+	Capacity         string       `gorm:"type:varchar(50)" json:"capacity"`
+	Weight           string       `gorm:"type:varchar(50)" json:"weight"`
+	AutoRelease      bool         `gorm:"default:false;not null" json:"autoRelease"`
+	ExclusiveUse     bool         `gorm:"default:false;not null" json:"exclusiveUse"`
+	IsFeatured       bool         `gorm:"default:false;not null" json:"isFeatured"`
+	UnpublishedAt    *time.Time   `gorm:"type:timestamp" json:"unpublishedAt,omitempty"`
+	// ShareSecret signs share links; rotating it revokes every link issued.
+	ShareSecret *string         `gorm:"type:varchar(64)" json:"-"`
+	Tags        []DumpsterTag   `gorm:"foreignKey:DumpsterID" json:"tags,omitempty"`
+	Images      []DumpsterImage `gorm:"foreignKey:DumpsterID" json:"images,omitempty"`
+	CreatedAt   time.Time       `gorm:"autoCreateTime;not null" json:"createdAt"`
+	UpdatedAt   time.Time       `gorm:"autoUpdateTime;not null" json:"updatedAt"`
+	DeletedAt   gorm.DeletedAt  `gorm:"index" json:"-"`
 }
 
 type DumpsterSize string
@@ -42,47 +54,148 @@ const (
 	DumpsterSizeExtraLarge DumpsterSize = "extraLarge"
 )
 
+func (s DumpsterSize) IsValid() bool {
+	switch s {
+	case DumpsterSizeSmall, DumpsterSizeMedium, DumpsterSizeLarge, DumpsterSizeExtraLarge:
+		return true
+	default:
+		return false
+	}
+}
+
 func NewDumpsterFromDTO(ownerID uuid.UUID, req dto.CreateDumpsterRequest) *Dumpster {
 	return &Dumpster{
-		OwnerID:     ownerID,
-		Title:       req.Title,
-		Description: req.Description,
-		Location:    req.Location,
-		Latitude:    req.Latitude,
-		Longitude:   req.Longitude,
-		Address:     req.Address,
-		City:        req.City,
-		State:       req.State,
-		ZipCode:     req.ZipCode,
-		PricePerDay: req.PricePerDay,
-		Size:        DumpsterSize(req.Size),
-		Capacity:    req.Capacity,
-		Weight:      req.Weight,
+		OwnerID:      ownerID,
+		Title:        req.Title,
+		Slug:         NewDumpsterSlug(req.Title),
+		Description:  req.Description,
+		Location:     req.Location,
+		Latitude:     req.Latitude,
+		Longitude:    req.Longitude,
+		Address:      req.Address,
+		City:         req.City,
+		State:        req.State,
+		ZipCode:      req.ZipCode,
+		PricePerDay:  req.PricePerDay,
+		Size:         DumpsterSize(req.Size),
+		Capacity:     req.Capacity,
+		Weight:       req.Weight,
+		AutoRelease:  req.AutoRelease,
+		ExclusiveUse: req.ExclusiveUse,
+		Tags:         newDumpsterTags(uuid.Nil, NormalizeTags(req.Tags)),
+	}
+}
+
+func (d *Dumpster) TagNames() []string {
+	names := make([]string, len(d.Tags))
+	for i, tag := range d.Tags {
+		names[i] = tag.Tag
+	}
+	return names
+}
+
+// ImageURLs returns the listing's image URLs in display order.
+func (d *Dumpster) ImageURLs() []string {
+	images := slices.Clone(d.Images)
+	slices.SortStableFunc(images, func(a, b DumpsterImage) int {
+		return a.Position - b.Position
+	})
+
+	urls := make([]string, len(images))
+	for i, image := range images {
+		urls[i] = image.URL
+	}
+	return urls
+}
+
+func (d *Dumpster) SetTags(tags []string) {
+	d.Tags = newDumpsterTags(d.ID, tags)
+}
+
+func (d *Dumpster) IsSnoozed() bool {
+	return d.UnavailableUntil != nil && time.Now().Before(*d.UnavailableUntil)
+}
+
+// IsUnpublished reports whether the listing is hidden, either by moderation
+// or because it is a draft its owner has not published yet.
+func (d *Dumpster) IsUnpublished() bool {
+	return d.UnpublishedAt != nil
+}
+
+const (
+	cloneTitleSuffix = " (copy)"
+	maxTitleLen      = 255
+)
+
+// Clone copies the listing's details and tags into a new unpublished draft
+// for the same owner, with " (copy)" appended to the title. Reviews, ratings,
+// usages, images and moderation state are not carried over.
+func (d *Dumpster) Clone(now time.Time) *Dumpster {
+	title := []rune(d.Title)
+	if limit := maxTitleLen - len([]rune(cloneTitleSuffix)); len(title) > limit {
+		title = title[:limit]
+	}
+	cloneTitle := string(title) + cloneTitleSuffix
+
+	return &Dumpster{
+		OwnerID:       d.OwnerID,
+		Title:         cloneTitle,
+		Slug:          NewDumpsterSlug(cloneTitle),
+		Description:   d.Description,
+		Location:      d.Location,
+		Latitude:      d.Latitude,
+		Longitude:     d.Longitude,
+		Address:       d.Address,
+		City:          d.City,
+		State:         d.State,
+		ZipCode:       d.ZipCode,
+		PricePerDay:   d.PricePerDay,
+		Size:          d.Size,
+		IsAvailable:   true,
+		Capacity:      d.Capacity,
+		Weight:        d.Weight,
+		AutoRelease:   d.AutoRelease,
+		ExclusiveUse:  d.ExclusiveUse,
+		UnpublishedAt: &now,
+		Tags:          newDumpsterTags(uuid.Nil, d.TagNames()),
 	}
 }
 
 func (d *Dumpster) ToResponse() dto.DumpsterResponse {
 	resp := dto.DumpsterResponse{
-		ID:          d.ID.String(),
-		OwnerID:     d.OwnerID.String(),
-		Title:       d.Title,
-		Description: d.Description,
-		Location:    d.Location,
-		Latitude:    d.Latitude,
-		Longitude:   d.Longitude,
-		Address:     d.Address,
-		City:        d.City,
-		State:       d.State,
-		ZipCode:     d.ZipCode,
-		PricePerDay: d.PricePerDay,
-		Size:        string(d.Size),
-		IsAvailable: d.IsAvailable,
-		Rating:      d.Rating,
-		ReviewCount: d.ReviewCount,
-		Capacity:    d.Capacity,
-		Weight:      d.Weight,
-		CreatedAt:   d.CreatedAt,
-		UpdatedAt:   d.UpdatedAt,
+		ID:                   d.ID.String(),
+		OwnerID:              d.OwnerID.String(),
+		Title:                d.Title,
+		Slug:                 d.Slug,
+		Description:          d.Description,
+		Location:             d.Location,
+		Latitude:             d.Latitude,
+		Longitude:            d.Longitude,
+		Address:              d.Address,
+		City:                 d.City,
+		State:                d.State,
+		ZipCode:              d.ZipCode,
+		PricePerDay:          d.PricePerDay,
+		PricePerDayFormatted: money.Format(d.PricePerDay),
+		Currency:             money.Currency(),
+		Size:                 string(d.Size),
+		IsAvailable:          d.IsAvailable,
+		Rating:               d.Rating,
+		ReviewCount:          d.ReviewCount,
+		Capacity:             d.Capacity,
+		Weight:               d.Weight,
+		AutoRelease:          d.AutoRelease,
+		ExclusiveUse:         d.ExclusiveUse,
+		IsFeatured:           d.IsFeatured,
+		Unpublished:          d.IsUnpublished(),
+		Tags:                 d.TagNames(),
+		Images:               d.ImageURLs(),
+		CreatedAt:            d.CreatedAt,
+		UpdatedAt:            d.UpdatedAt,
+	}
+
+	if d.IsSnoozed() {
+		resp.UnavailableUntil = d.UnavailableUntil
 	}
 
 	if d.Owner != nil {
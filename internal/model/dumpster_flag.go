@@ -0,0 +1,54 @@
+package model
+
+import (
+	"time"
+	"waste-space/internal/dto"
+
+	"github.com/google/uuid"
+)
+
+type DumpsterFlag struct {
+	ID         uuid.UUID          `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	DumpsterID uuid.UUID          `gorm:"type:uuid;not null;index" json:"dumpsterId" validate:"required"`
+	ReporterID uuid.UUID          `gorm:"type:uuid;not null" json:"reporterId" validate:"required"`
+	Reason     string             `gorm:"type:varchar(500);not null" json:"reason" validate:"required,max=500"`
+	Status     DumpsterFlagStatus `gorm:"type:varchar(20);not null;default:'open'" json:"status"`
+	ResolvedBy *uuid.UUID         `gorm:"type:uuid" json:"resolvedBy,omitempty"`
+	ResolvedAt *time.Time         `gorm:"type:timestamp" json:"resolvedAt,omitempty"`
+	CreatedAt  time.Time          `gorm:"autoCreateTime;not null" json:"createdAt"`
+}
+
+type DumpsterFlagStatus string
+
+const (
+	DumpsterFlagStatusOpen      DumpsterFlagStatus = "open"
+	DumpsterFlagStatusDismissed DumpsterFlagStatus = "dismissed"
+	DumpsterFlagStatusUpheld    DumpsterFlagStatus = "upheld"
+)
+
+func NewDumpsterFlag(dumpsterID, reporterID uuid.UUID, reason string) *DumpsterFlag {
+	return &DumpsterFlag{
+		DumpsterID: dumpsterID,
+		ReporterID: reporterID,
+		Reason:     reason,
+		Status:     DumpsterFlagStatusOpen,
+	}
+}
+
+func (f *DumpsterFlag) ToResponse() dto.DumpsterFlagResponse {
+	resp := dto.DumpsterFlagResponse{
+		ID:         f.ID.String(),
+		DumpsterID: f.DumpsterID.String(),
+		ReporterID: f.ReporterID.String(),
+		Reason:     f.Reason,
+		Status:     string(f.Status),
+		ResolvedAt: f.ResolvedAt,
+		CreatedAt:  f.CreatedAt,
+	}
+
+	if f.ResolvedBy != nil {
+		resp.ResolvedBy = f.ResolvedBy.String()
+	}
+
+	return resp
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"time"
+	"waste-space/internal/dto"
+
+	"github.com/google/uuid"
+)
+
+const MaxDumpsterImages = 10
+
+// DumpsterImage is an uploaded photo of a listing. The bytes live in object
+// storage under ObjectKey; URL is where clients fetch them from.
+type DumpsterImage struct {
+	ID         uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	DumpsterID uuid.UUID `gorm:"type:uuid;not null;index" json:"dumpsterId"`
+	ObjectKey  string    `gorm:"type:varchar(255);not null;uniqueIndex" json:"objectKey"`
+	URL        string    `gorm:"type:text;not null" json:"url"`
+	Position   int       `gorm:"not null;default:0" json:"position"`
+	CreatedAt  time.Time `gorm:"autoCreateTime;not null" json:"createdAt"`
+}
+
+func (i *DumpsterImage) ToResponse() dto.DumpsterImageResponse {
+	return dto.DumpsterImageResponse{
+		ID:         i.ID.String(),
+		DumpsterID: i.DumpsterID.String(),
+		URL:        i.URL,
+		Position:   i.Position,
+		CreatedAt:  i.CreatedAt,
+	}
+}
@@ -0,0 +1,120 @@
+package model
+
+import (
+	"reflect"
+	"strings"
+	"time"
+	"waste-space/internal/dto"
+
+	"github.com/google/uuid"
+)
+
+// DumpsterRevision snapshots a listing after an owner edit. Revisions are
+// numbered from 1 per dumpster. RevertedFrom is set when the edit restored
+// an earlier revision.
+type DumpsterRevision struct {
+	ID            uuid.UUID            `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	DumpsterID    uuid.UUID            `gorm:"type:uuid;not null;index" json:"dumpsterId" validate:"required"`
+	Revision      int                  `gorm:"not null" json:"revision"`
+	Snapshot      dto.DumpsterSnapshot `gorm:"type:jsonb;serializer:json;not null" json:"snapshot"`
+	ChangedFields []string             `gorm:"type:jsonb;serializer:json;not null" json:"changedFields"`
+	RevertedFrom  *int                 `json:"revertedFrom,omitempty"`
+	CreatedAt     time.Time            `gorm:"autoCreateTime;not null" json:"createdAt"`
+}
+
+// NewDumpsterRevision records current as a revision of previous, or returns
+// nil when the edit changed nothing a snapshot captures.
+func NewDumpsterRevision(previous, current *Dumpster) *DumpsterRevision {
+	before := previous.Snapshot()
+	after := current.Snapshot()
+
+	changed := changedSnapshotFields(before, after)
+	if len(changed) == 0 {
+		return nil
+	}
+
+	return &DumpsterRevision{
+		DumpsterID:    current.ID,
+		Snapshot:      after,
+		ChangedFields: changed,
+	}
+}
+
+// ApplySnapshot overwrites the listing's editable fields and tags with those
+// saved in snapshot.
+func (d *Dumpster) ApplySnapshot(snapshot dto.DumpsterSnapshot) {
+	d.Title = snapshot.Title
+	d.Description = snapshot.Description
+	d.Location = snapshot.Location
+	d.Latitude = snapshot.Latitude
+	d.Longitude = snapshot.Longitude
+	d.Address = snapshot.Address
+	d.City = snapshot.City
+	d.State = snapshot.State
+	d.ZipCode = snapshot.ZipCode
+	d.PricePerDay = snapshot.PricePerDay
+	d.Size = DumpsterSize(snapshot.Size)
+	d.Capacity = snapshot.Capacity
+	d.Weight = snapshot.Weight
+	d.IsAvailable = snapshot.IsAvailable
+	d.AutoRelease = snapshot.AutoRelease
+	d.ExclusiveUse = snapshot.ExclusiveUse
+	d.SetTags(snapshot.Tags)
+}
+
+func (d *Dumpster) Snapshot() dto.DumpsterSnapshot {
+	return dto.DumpsterSnapshot{
+		Title:        d.Title,
+		Description:  d.Description,
+		Location:     d.Location,
+		Latitude:     d.Latitude,
+		Longitude:    d.Longitude,
+		Address:      d.Address,
+		City:         d.City,
+		State:        d.State,
+		ZipCode:      d.ZipCode,
+		PricePerDay:  d.PricePerDay,
+		Size:         string(d.Size),
+		Capacity:     d.Capacity,
+		Weight:       d.Weight,
+		IsAvailable:  d.IsAvailable,
+		AutoRelease:  d.AutoRelease,
+		ExclusiveUse: d.ExclusiveUse,
+		Tags:         d.TagNames(),
+	}
+}
+
+// changedSnapshotFields lists, by JSON name, the fields that differ between
+// two snapshots.
+func changedSnapshotFields(before, after dto.DumpsterSnapshot) []string {
+	b := reflect.ValueOf(before)
+	a := reflect.ValueOf(after)
+
+	var changed []string
+	for i := range b.NumField() {
+		if reflect.DeepEqual(b.Field(i).Interface(), a.Field(i).Interface()) {
+			continue
+		}
+		name, _, _ := strings.Cut(b.Type().Field(i).Tag.Get("json"), ",")
+		changed = append(changed, name)
+	}
+	return changed
+}
+
+// ToResponse leaves out the snapshot; see ToDetailResponse.
+func (r *DumpsterRevision) ToResponse() dto.DumpsterRevisionResponse {
+	return dto.DumpsterRevisionResponse{
+		Revision:      r.Revision,
+		DumpsterID:    r.DumpsterID.String(),
+		ChangedFields: r.ChangedFields,
+		RevertedFrom:  r.RevertedFrom,
+		CreatedAt:     r.CreatedAt,
+	}
+}
+
+func (r *DumpsterRevision) ToDetailResponse() dto.DumpsterRevisionResponse {
+	resp := r.ToResponse()
+	snapshot := r.Snapshot
+	resp.Snapshot = &snapshot
+	return resp
+}
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+)
+
+const (
+	maxSlugBaseLen  = 60
+	slugHashBytes   = 3
+	defaultSlugBase = "dumpster"
+)
+
+// NewDumpsterSlug builds a URL-friendly identifier from the title followed by
+// a short random hash, e.g. "20-yard-roll-off-3fa9c1". Titles with nothing
+// slug-safe in them fall back to "dumpster".
+func NewDumpsterSlug(title string) string {
+	hash := make([]byte, slugHashBytes)
+	_, _ = rand.Read(hash)
+	return slugBase(title) + "-" + hex.EncodeToString(hash)
+}
+
+func slugBase(title string) string {
+	var b strings.Builder
+	pendingDash := false
+	for _, r := range strings.ToLower(title) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if pendingDash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			pendingDash = false
+			b.WriteRune(r)
+			if b.Len() >= maxSlugBaseLen {
+				break
+			}
+			continue
+		}
+		pendingDash = true
+	}
+
+	if b.Len() == 0 {
+		return defaultSlugBase
+	}
+	return b.String()
+}
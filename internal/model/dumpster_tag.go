@@ -0,0 +1,45 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	MaxDumpsterTags   = 20
+	MaxDumpsterTagLen = 50
+)
+
+type DumpsterTag struct {
+	DumpsterID uuid.UUID `gorm:"type:uuid;primaryKey" json:"dumpsterId"`
+	Tag        string    `gorm:"type:varchar(50);primaryKey" json:"tag"`
+}
+
+// NormalizeTags lowercases and trims free-form tags, collapses inner
+// whitespace to single hyphens, and drops empties and duplicates while
+// keeping the caller's order.
+func NormalizeTags(tags []string) []string {
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		tag = strings.Join(strings.Fields(strings.ToLower(tag)), "-")
+		if tag == "" {
+			continue
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		normalized = append(normalized, tag)
+	}
+	return normalized
+}
+
+func newDumpsterTags(dumpsterID uuid.UUID, tags []string) []DumpsterTag {
+	dumpsterTags := make([]DumpsterTag, len(tags))
+	for i, tag := range tags {
+		dumpsterTags[i] = DumpsterTag{DumpsterID: dumpsterID, Tag: tag}
+	}
+	return dumpsterTags
+}
@@ -0,0 +1,25 @@
+package model
+
+import "waste-space/internal/dto"
+
+type MaintenanceMode string
+
+const (
+	MaintenanceModeOff      MaintenanceMode = "off"
+	MaintenanceModeReadOnly MaintenanceMode = "readOnly"
+	MaintenanceModeFull     MaintenanceMode = "full"
+)
+
+type MaintenanceState struct {
+	Mode              MaintenanceMode `json:"mode"`
+	RetryAfterSeconds int             `json:"retryAfterSeconds"`
+	Message           string          `json:"message"`
+}
+
+func (m *MaintenanceState) ToResponse() dto.MaintenanceResponse {
+	return dto.MaintenanceResponse{
+		Mode:              string(m.Mode),
+		RetryAfterSeconds: m.RetryAfterSeconds,
+		Message:           m.Message,
+	}
+}
@@ -0,0 +1,61 @@
+package model
+
+import (
+	"time"
+	"waste-space/internal/dto"
+
+	"github.com/google/uuid"
+)
+
+type Notification struct {
+	ID          uuid.UUID        `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	UserID      uuid.UUID        `gorm:"type:uuid;not null;index" json:"userId" validate:"required"`
+	Type        NotificationType `gorm:"type:varchar(50);not null" json:"type" validate:"required"`
+	Title       string           `gorm:"type:varchar(255);not null" json:"title" validate:"required"`
+	Message     string           `gorm:"type:text;not null" json:"message" validate:"required"`
+	ReferenceID *uuid.UUID       `gorm:"type:uuid" json:"referenceId,omitempty"`
+	ReadAt      *time.Time       `gorm:"type:timestamp" json:"readAt,omitempty"`
+	CreatedAt   time.Time        `gorm:"autoCreateTime;not null" json:"createdAt"`
+}
+
+type NotificationType string
+
+const (
+	NotificationTypeBookingConfirmed    NotificationType = "booking_confirmed"
+	NotificationTypeBookingExpired      NotificationType = "booking_expired"
+	NotificationTypeDumpsterAvailable   NotificationType = "dumpster_available"
+	NotificationTypeDumpsterUnpublished NotificationType = "dumpster_unpublished"
+	NotificationTypeDumpsterRepublished NotificationType = "dumpster_republished"
+)
+
+func NewNotification(
+	userID uuid.UUID,
+	notificationType NotificationType,
+	title, message string,
+	referenceID *uuid.UUID) *Notification {
+	return &Notification{
+		UserID:      userID,
+		Type:        notificationType,
+		Title:       title,
+		Message:     message,
+		ReferenceID: referenceID,
+	}
+}
+
+func (n *Notification) ToResponse() dto.NotificationResponse {
+	resp := dto.NotificationResponse{
+		ID:        n.ID.String(),
+		Type:      string(n.Type),
+		Title:     n.Title,
+		Message:   n.Message,
+		IsRead:    n.ReadAt != nil,
+		ReadAt:    n.ReadAt,
+		CreatedAt: n.CreatedAt,
+	}
+
+	if n.ReferenceID != nil {
+		resp.ReferenceID = n.ReferenceID.String()
+	}
+
+	return resp
+}
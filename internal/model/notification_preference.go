@@ -0,0 +1,66 @@
+package model
+
+import (
+	"time"
+	"waste-space/internal/dto"
+
+	"github.com/google/uuid"
+)
+
+// NotificationPreference is a user's channel choice for one notification
+// type. Rows exist only for types the user has changed; every other type
+// falls back to DefaultNotificationPreference.
+type NotificationPreference struct {
+	UserID    uuid.UUID        `gorm:"type:uuid;primaryKey" json:"userId"`
+	Type      NotificationType `gorm:"type:varchar(50);primaryKey" json:"type"`
+	InApp     bool             `gorm:"not null" json:"inApp"`
+	Email     bool             `gorm:"not null" json:"email"`
+	CreatedAt time.Time        `gorm:"autoCreateTime;not null" json:"createdAt"`
+	UpdatedAt time.Time        `gorm:"autoUpdateTime;not null" json:"updatedAt"`
+}
+
+// NotificationTypes lists every notification type in the order preferences
+// are reported.
+var NotificationTypes = []NotificationType{
+	NotificationTypeBookingConfirmed,
+	NotificationTypeBookingExpired,
+	NotificationTypeDumpsterAvailable,
+	NotificationTypeDumpsterUnpublished,
+	NotificationTypeDumpsterRepublished,
+}
+
+// criticalNotificationTypes are the types that affect money or a listing's
+// visibility, and so are emailed unless the user opts out.
+var criticalNotificationTypes = map[NotificationType]bool{
+	NotificationTypeBookingConfirmed:    true,
+	NotificationTypeBookingExpired:      true,
+	NotificationTypeDumpsterUnpublished: true,
+}
+
+func (t NotificationType) IsValid() bool {
+	for _, known := range NotificationTypes {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultNotificationPreference is used for a type the user has not
+// configured: always in-app, and email only for critical types.
+func DefaultNotificationPreference(userID uuid.UUID, notificationType NotificationType) *NotificationPreference {
+	return &NotificationPreference{
+		UserID: userID,
+		Type:   notificationType,
+		InApp:  true,
+		Email:  criticalNotificationTypes[notificationType],
+	}
+}
+
+func (p *NotificationPreference) ToResponse() dto.NotificationPreferenceResponse {
+	return dto.NotificationPreferenceResponse{
+		Type:  string(p.Type),
+		InApp: p.InApp,
+		Email: p.Email,
+	}
+}
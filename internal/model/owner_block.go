@@ -0,0 +1,31 @@
+package model
+
+import (
+	"time"
+	"waste-space/internal/dto"
+
+	"github.com/google/uuid"
+)
+
+// OwnerBlock stops BlockedUserID from booking or using any of OwnerID's
+// dumpsters.
+type OwnerBlock struct {
+	ID            uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	OwnerID       uuid.UUID `gorm:"type:uuid;not null" json:"ownerId" validate:"required"`
+	BlockedUserID uuid.UUID `gorm:"type:uuid;not null" json:"blockedUserId" validate:"required"`
+	CreatedAt     time.Time `gorm:"autoCreateTime;not null" json:"createdAt"`
+}
+
+func NewOwnerBlock(ownerID, blockedUserID uuid.UUID) *OwnerBlock {
+	return &OwnerBlock{
+		OwnerID:       ownerID,
+		BlockedUserID: blockedUserID,
+	}
+}
+
+func (b *OwnerBlock) ToResponse() dto.OwnerBlockResponse {
+	return dto.OwnerBlockResponse{
+		BlockedUserID: b.BlockedUserID.String(),
+		CreatedAt:     b.CreatedAt,
+	}
+}
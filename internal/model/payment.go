@@ -0,0 +1,79 @@
+package model
+
+import (
+	"time"
+	"waste-space/internal/dto"
+	"waste-space/pkg/money"
+
+	"github.com/google/uuid"
+)
+
+// Payment is one charge attempt against a booking or a usage session;
+// exactly one of BookingID and UsageID is set.
+type Payment struct {
+	ID                uuid.UUID     `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	UserID            uuid.UUID     `gorm:"type:uuid;not null;index" json:"userId" validate:"required"`
+	BookingID         *uuid.UUID    `gorm:"type:uuid" json:"bookingId,omitempty"`
+	UsageID           *uuid.UUID    `gorm:"type:uuid" json:"usageId,omitempty"`
+	Amount            money.Amount  `gorm:"type:decimal(10,2);not null" json:"amount"`
+	Currency          string        `gorm:"type:varchar(3);not null" json:"currency"`
+	Status            PaymentStatus `gorm:"type:varchar(20);not null;default:'pending'" json:"status"`
+	IdempotencyKey    string        `gorm:"type:varchar(255);not null;uniqueIndex" json:"idempotencyKey"`
+	ProviderReference string        `gorm:"type:varchar(255)" json:"providerReference,omitempty"`
+	FailureReason     string        `gorm:"type:text" json:"failureReason,omitempty"`
+	RefundedAmount    money.Amount  `gorm:"type:decimal(10,2);not null;default:0" json:"refundedAmount"`
+	RefundReference   string        `gorm:"type:varchar(255)" json:"refundReference,omitempty"`
+	RefundedAt        *time.Time    `gorm:"type:timestamp" json:"refundedAt,omitempty"`
+	CreatedAt         time.Time     `gorm:"autoCreateTime;not null" json:"createdAt"`
+	UpdatedAt         time.Time     `gorm:"autoUpdateTime;not null" json:"updatedAt"`
+}
+
+type PaymentStatus string
+
+const (
+	PaymentStatusPending PaymentStatus = "pending"
+	PaymentStatusPaid    PaymentStatus = "paid"
+	PaymentStatusFailed  PaymentStatus = "failed"
+)
+
+func NewBookingPayment(booking *Booking, idempotencyKey string) *Payment {
+	return &Payment{
+		UserID:         booking.UserID,
+		BookingID:      &booking.ID,
+		Amount:         booking.TotalPrice,
+		Currency:       money.Currency(),
+		Status:         PaymentStatusPending,
+		IdempotencyKey: idempotencyKey,
+	}
+}
+
+// Refundable is what is left of the payment after earlier refunds.
+func (p *Payment) Refundable() money.Amount {
+	return max(p.Amount-p.RefundedAmount, 0)
+}
+
+func (p *Payment) ToResponse() dto.PaymentResponse {
+	resp := dto.PaymentResponse{
+		ID:                p.ID.String(),
+		UserID:            p.UserID.String(),
+		Amount:            p.Amount,
+		Currency:          p.Currency,
+		Status:            string(p.Status),
+		ProviderReference: p.ProviderReference,
+		FailureReason:     p.FailureReason,
+		RefundedAmount:    p.RefundedAmount,
+		RefundedAt:        p.RefundedAt,
+		CreatedAt:         p.CreatedAt,
+		UpdatedAt:         p.UpdatedAt,
+	}
+
+	if p.BookingID != nil {
+		resp.BookingID = p.BookingID.String()
+	}
+
+	if p.UsageID != nil {
+		resp.UsageID = p.UsageID.String()
+	}
+
+	return resp
+}
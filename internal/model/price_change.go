@@ -0,0 +1,24 @@
+package model
+
+import (
+	"time"
+	"waste-space/pkg/money"
+
+	"github.com/google/uuid"
+)
+
+type DumpsterPriceChange struct {
+	ID         uuid.UUID    `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	DumpsterID uuid.UUID    `gorm:"type:uuid;not null;index" json:"dumpsterId" validate:"required"`
+	OldPrice   money.Amount `gorm:"type:decimal(10,2);not null" json:"oldPrice"`
+	NewPrice   money.Amount `gorm:"type:decimal(10,2);not null" json:"newPrice"`
+	CreatedAt  time.Time    `gorm:"autoCreateTime;not null" json:"createdAt"`
+}
+
+func NewDumpsterPriceChange(dumpsterID uuid.UUID, oldPrice, newPrice money.Amount) *DumpsterPriceChange {
+	return &DumpsterPriceChange{
+		DumpsterID: dumpsterID,
+		OldPrice:   oldPrice,
+		NewPrice:   newPrice,
+	}
+}
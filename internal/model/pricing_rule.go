@@ -0,0 +1,132 @@
+package model
+
+import (
+	"math"
+	"sort"
+	"time"
+	"waste-space/internal/dto"
+	"waste-space/pkg/money"
+
+	"github.com/google/uuid"
+)
+
+// PricingRule adjusts a dumpster's daily price on matching days. It applies
+// either to one weekday every week or to an inclusive date range, and
+// either scales PricePerDay by Multiplier or replaces it with PriceOverride.
+// Days are calendar days in UTC.
+type PricingRule struct {
+	ID            uuid.UUID     `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	DumpsterID    uuid.UUID     `gorm:"type:uuid;not null;index" json:"dumpsterId" validate:"required"`
+	Label         string        `gorm:"type:varchar(100);not null;default:''" json:"label"`
+	DayOfWeek     *time.Weekday `gorm:"type:smallint" json:"dayOfWeek,omitempty"`
+	StartDate     *time.Time    `gorm:"type:date" json:"startDate,omitempty"`
+	EndDate       *time.Time    `gorm:"type:date" json:"endDate,omitempty"`
+	Multiplier    *float64      `gorm:"type:decimal(6,3)" json:"multiplier,omitempty"`
+	PriceOverride *money.Amount `gorm:"type:decimal(10,2)" json:"priceOverride,omitempty"`
+	CreatedAt     time.Time     `gorm:"autoCreateTime;not null" json:"createdAt"`
+	UpdatedAt     time.Time     `gorm:"autoUpdateTime;not null" json:"updatedAt"`
+}
+
+// IsDateRange reports whether the rule targets a date range rather than a
+// weekday. Date-range rules take precedence over weekday rules.
+func (r *PricingRule) IsDateRange() bool {
+	return r.StartDate != nil
+}
+
+// Matches reports whether the rule applies to the UTC calendar day of day.
+func (r *PricingRule) Matches(day time.Time) bool {
+	day = truncateToDay(day)
+	if r.IsDateRange() {
+		return !day.Before(truncateToDay(*r.StartDate)) && !day.After(truncateToDay(*r.EndDate))
+	}
+	return r.DayOfWeek != nil && day.Weekday() == *r.DayOfWeek
+}
+
+// DailyPrice returns the price for a matching day given the base price.
+func (r *PricingRule) DailyPrice(base money.Amount) money.Amount {
+	if r.PriceOverride != nil {
+		return *r.PriceOverride
+	}
+	return base.Mul(*r.Multiplier)
+}
+
+func (r *PricingRule) ToResponse() dto.PricingRuleResponse {
+	resp := dto.PricingRuleResponse{
+		ID:            r.ID.String(),
+		DumpsterID:    r.DumpsterID.String(),
+		Label:         r.Label,
+		Multiplier:    r.Multiplier,
+		PriceOverride: r.PriceOverride,
+		CreatedAt:     r.CreatedAt,
+		UpdatedAt:     r.UpdatedAt,
+	}
+
+	if r.PriceOverride != nil {
+		resp.Currency = money.Currency()
+	}
+
+	if r.DayOfWeek != nil {
+		day := int(*r.DayOfWeek)
+		resp.DayOfWeek = &day
+	}
+
+	if r.StartDate != nil {
+		resp.StartDate = r.StartDate.Format(time.DateOnly)
+	}
+
+	if r.EndDate != nil {
+		resp.EndDate = r.EndDate.Format(time.DateOnly)
+	}
+
+	return resp
+}
+
+// PriceForPeriod prices [start, end) day by day: each UTC calendar day, or
+// the part of it inside the period, is charged at the price of the rule
+// that applies to it, falling back to base. A date-range rule beats a
+// weekday rule, and among rules of the same kind the newest wins. The total
+// is rounded to the cent once, at the end.
+func PriceForPeriod(base money.Amount, rules []PricingRule, start, end time.Time) money.Amount {
+	if !end.After(start) {
+		return 0
+	}
+
+	if len(rules) == 0 {
+		return base.Mul(end.Sub(start).Hours() / 24)
+	}
+
+	ordered := make([]PricingRule, len(rules))
+	copy(ordered, rules)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if ordered[i].IsDateRange() != ordered[j].IsDateRange() {
+			return ordered[i].IsDateRange()
+		}
+		return ordered[i].CreatedAt.After(ordered[j].CreatedAt)
+	})
+
+	var cents float64
+	for cursor := start.UTC(); cursor.Before(end); {
+		next := truncateToDay(cursor).AddDate(0, 0, 1)
+		if next.After(end) {
+			next = end
+		}
+
+		price := base
+		for i := range ordered {
+			if ordered[i].Matches(cursor) {
+				price = ordered[i].DailyPrice(base)
+				break
+			}
+		}
+
+		cents += float64(price.Cents()) * next.Sub(cursor).Hours() / 24
+		cursor = next
+	}
+
+	return money.FromCents(int64(math.Round(cents)))
+}
+
+func truncateToDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,108 @@
+package model
+
+import (
+	"testing"
+	"time"
+	"waste-space/pkg/money"
+)
+
+func TestPriceForPeriod(t *testing.T) {
+	base := money.FromCents(10000)
+	day := func(d, hour int) time.Time {
+		return time.Date(2026, time.March, d, hour, 0, 0, 0, time.UTC)
+	}
+	weekday := func(wd time.Weekday) *time.Weekday { return &wd }
+	date := func(d int) *time.Time {
+		t := day(d, 0)
+		return &t
+	}
+	multiplier := func(m float64) *float64 { return &m }
+	override := func(cents int64) *money.Amount {
+		a := money.FromCents(cents)
+		return &a
+	}
+	older := day(1, 0)
+	newer := day(1, 1)
+
+	if day(7, 0).Weekday() != time.Saturday {
+		t.Fatalf("fixture dates assume 2026-03-07 is a Saturday")
+	}
+
+	tests := []struct {
+		name       string
+		rules      []PricingRule
+		start, end time.Time
+		want       money.Amount
+	}{
+		{
+			name:  "no rules prorates base",
+			start: day(2, 0),
+			end:   day(3, 12),
+			want:  money.FromCents(15000),
+		},
+		{
+			name:  "weekday rule",
+			rules: []PricingRule{{DayOfWeek: weekday(time.Saturday), Multiplier: multiplier(1.5)}},
+			start: day(2, 0),
+			end:   day(9, 0),
+			want:  money.FromCents(6*10000 + 15000),
+		},
+		{
+			name: "date range beats weekday",
+			rules: []PricingRule{
+				{DayOfWeek: weekday(time.Saturday), Multiplier: multiplier(1.5), CreatedAt: newer},
+				{StartDate: date(7), EndDate: date(7), PriceOverride: override(8000), CreatedAt: older},
+			},
+			start: day(2, 0),
+			end:   day(9, 0),
+			want:  money.FromCents(6*10000 + 8000),
+		},
+		{
+			name: "newest rule of the same kind wins",
+			rules: []PricingRule{
+				{DayOfWeek: weekday(time.Saturday), Multiplier: multiplier(2), CreatedAt: older},
+				{DayOfWeek: weekday(time.Saturday), Multiplier: multiplier(3), CreatedAt: newer},
+			},
+			start: day(7, 0),
+			end:   day(8, 0),
+			want:  money.FromCents(30000),
+		},
+		{
+			name:  "partial first and last days",
+			rules: []PricingRule{{DayOfWeek: weekday(time.Monday), PriceOverride: override(20000)}},
+			start: day(2, 12),
+			end:   day(4, 6),
+			want:  money.FromCents(10000 + 10000 + 2500),
+		},
+		{
+			name:  "whole days across a rule edge",
+			rules: []PricingRule{{StartDate: date(3), EndDate: date(4), Multiplier: multiplier(2)}},
+			start: day(2, 0),
+			end:   day(6, 0),
+			want:  money.FromCents(10000 + 20000 + 20000 + 10000),
+		},
+		{
+			name:  "partial days across a rule edge",
+			rules: []PricingRule{{StartDate: date(3), EndDate: date(4), Multiplier: multiplier(2)}},
+			start: day(4, 18),
+			end:   day(5, 6),
+			want:  money.FromCents(5000 + 2500),
+		},
+		{
+			name:  "empty period",
+			rules: []PricingRule{{DayOfWeek: weekday(time.Monday), Multiplier: multiplier(2)}},
+			start: day(3, 0),
+			end:   day(2, 0),
+			want:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PriceForPeriod(base, tt.rules, tt.start, tt.end)
+			if got != tt.want {
+				t.Errorf("PriceForPeriod() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -9,18 +9,31 @@ import (
 )
 
 type Review struct {
-	ID          uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
-	DumpsterID  uuid.UUID      `gorm:"type:uuid;not null;index" json:"dumpsterId" validate:"required"`
-	Dumpster    *Dumpster      `gorm:"foreignKey:DumpsterID" json:"dumpster,omitempty"`
-	UserID      uuid.UUID      `gorm:"type:uuid;not null;index" json:"userId" validate:"required"`
-	User        *User          `gorm:"foreignKey:UserID" json:"user,omitempty"`
-	Rating      int            `gorm:"not null" json:"rating" validate:"required,min=1,max=5"`
-	Comment     string         `gorm:"type:text" json:"comment"`
-	CreatedAt   time.Time      `gorm:"autoCreateTime;not null" json:"createdAt"`
-	UpdatedAt   time.Time      `gorm:"autoUpdateTime;not null" json:"updatedAt"`
-	DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
+	ID              uuid.UUID             `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	DumpsterID      uuid.UUID             `gorm:"type:uuid;not null;index" json:"dumpsterId" validate:"required"`
+	Dumpster        *Dumpster             `gorm:"foreignKey:DumpsterID" json:"dumpster,omitempty"`
+	UserID          uuid.UUID             `gorm:"type:uuid;not null;index" json:"userId" validate:"required"`
+	User            *User                 `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Rating          int                   `gorm:"not null" json:"rating" validate:"required,min=1,max=5"`
+	Comment         string                `gorm:"type:text" json:"comment"`
+	OwnerReply      *string               `gorm:"type:text" json:"ownerReply,omitempty"`
+	OwnerRepliedAt  *time.Time            `json:"ownerRepliedAt,omitempty"`
+	HelpfulCount    int                   `gorm:"default:0;not null" json:"helpfulCount"`
+	NotHelpfulCount int                   `gorm:"default:0;not null" json:"notHelpfulCount"`
+	CreatedAt       time.Time             `gorm:"autoCreateTime;not null" json:"createdAt"`
+	UpdatedAt       time.Time             `gorm:"autoUpdateTime;not null" json:"updatedAt"`
+	DeletedAt       gorm.DeletedAt        `gorm:"index" json:"-"`
+	DeletedReason   *ReviewDeletionReason `gorm:"type:varchar(20)" json:"deletedReason,omitempty"`
 }
 
+// ReviewDeletionReason records who soft-deleted a review.
+type ReviewDeletionReason string
+
+const (
+	ReviewDeletedByAuthor    ReviewDeletionReason = "author"
+	ReviewRemovedByModerator ReviewDeletionReason = "moderator"
+)
+
 func NewReviewFromDTO(userID, dumpsterID uuid.UUID, req dto.CreateReviewRequest) *Review {
 	return &Review{
 		UserID:     userID,
@@ -30,15 +43,33 @@ func NewReviewFromDTO(userID, dumpsterID uuid.UUID, req dto.CreateReviewRequest)
 	}
 }
 
+// IsEditable reports whether the author may still edit the review at now.
+// A zero window never closes.
+func (r *Review) IsEditable(window time.Duration, now time.Time) bool {
+	return window == 0 || now.Before(r.CreatedAt.Add(window))
+}
+
 func (r *Review) ToResponse() dto.ReviewResponse {
 	resp := dto.ReviewResponse{
-		ID:         r.ID.String(),
-		DumpsterID: r.DumpsterID.String(),
-		UserID:     r.UserID.String(),
-		Rating:     r.Rating,
-		Comment:    r.Comment,
-		CreatedAt:  r.CreatedAt,
-		UpdatedAt:  r.UpdatedAt,
+		ID:              r.ID.String(),
+		DumpsterID:      r.DumpsterID.String(),
+		UserID:          r.UserID.String(),
+		Rating:          r.Rating,
+		Comment:         r.Comment,
+		OwnerReply:      r.OwnerReply,
+		OwnerRepliedAt:  r.OwnerRepliedAt,
+		HelpfulCount:    r.HelpfulCount,
+		NotHelpfulCount: r.NotHelpfulCount,
+		CreatedAt:       r.CreatedAt,
+		UpdatedAt:       r.UpdatedAt,
+	}
+
+	if r.DeletedAt.Valid {
+		resp.DeletedAt = &r.DeletedAt.Time
+	}
+
+	if r.DeletedReason != nil {
+		resp.DeletedReason = string(*r.DeletedReason)
 	}
 
 	if r.User != nil {
@@ -46,5 +77,9 @@ func (r *Review) ToResponse() dto.ReviewResponse {
 		resp.User = &userResp
 	}
 
+	if r.Dumpster != nil {
+		resp.DumpsterTitle = r.Dumpster.Title
+	}
+
 	return resp
 }
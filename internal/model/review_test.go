@@ -0,0 +1,32 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReviewIsEditable(t *testing.T) {
+	created := time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
+	review := Review{CreatedAt: created}
+	const window = 24 * time.Hour
+
+	tests := []struct {
+		name   string
+		window time.Duration
+		now    time.Time
+		want   bool
+	}{
+		{name: "just inside", window: window, now: created.Add(window - time.Nanosecond), want: true},
+		{name: "at the deadline", window: window, now: created.Add(window), want: false},
+		{name: "just outside", window: window, now: created.Add(window + time.Nanosecond), want: false},
+		{name: "zero window", window: 0, now: created.AddDate(1, 0, 0), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := review.IsEditable(tt.window, tt.now); got != tt.want {
+				t.Errorf("IsEditable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReviewVote struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	ReviewID  uuid.UUID `gorm:"type:uuid;not null;index" json:"reviewId" validate:"required"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null" json:"userId" validate:"required"`
+	Helpful   bool      `gorm:"not null" json:"helpful"`
+	CreatedAt time.Time `gorm:"autoCreateTime;not null" json:"createdAt"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime;not null" json:"updatedAt"`
+}
+
+func NewReviewVote(userID, reviewID uuid.UUID, helpful bool) *ReviewVote {
+	return &ReviewVote{
+		UserID:   userID,
+		ReviewID: reviewID,
+		Helpful:  helpful,
+	}
+}
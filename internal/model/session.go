@@ -0,0 +1,51 @@
+package model
+
+import (
+	"time"
+	"waste-space/internal/dto"
+
+	"github.com/google/uuid"
+)
+
+// Session is a single logged-in device. It lives in the cache, keyed by user
+// and session ID, and expires together with its refresh token.
+type Session struct {
+	ID           uuid.UUID `json:"id"`
+	UserID       uuid.UUID `json:"userId"`
+	RefreshToken string    `json:"refreshToken"`
+	UserAgent    string    `json:"userAgent"`
+	IPAddress    string    `json:"ipAddress"`
+	CreatedAt    time.Time `json:"createdAt"`
+	LastUsedAt   time.Time `json:"lastUsedAt"`
+	ExpiresAt    time.Time `json:"expiresAt"`
+}
+
+func NewSession(
+	id, userID uuid.UUID,
+	refreshToken string,
+	client dto.ClientInfo,
+	expiresAt time.Time) *Session {
+	now := time.Now()
+	return &Session{
+		ID:           id,
+		UserID:       userID,
+		RefreshToken: refreshToken,
+		UserAgent:    client.UserAgent,
+		IPAddress:    client.IPAddress,
+		CreatedAt:    now,
+		LastUsedAt:   now,
+		ExpiresAt:    expiresAt,
+	}
+}
+
+func (s *Session) ToResponse(currentSessionID uuid.UUID) dto.SessionResponse {
+	return dto.SessionResponse{
+		ID:         s.ID.String(),
+		UserAgent:  s.UserAgent,
+		IPAddress:  s.IPAddress,
+		CreatedAt:  s.CreatedAt,
+		LastUsedAt: s.LastUsedAt,
+		ExpiresAt:  s.ExpiresAt,
+		Current:    s.ID == currentSessionID,
+	}
+}
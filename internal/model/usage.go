@@ -1,38 +1,53 @@
 package model
 
 import (
+	"slices"
 	"time"
 	"waste-space/internal/dto"
+	"waste-space/pkg/money"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
 type DumpsterUsage struct {
-	ID              uuid.UUID       `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
-	DumpsterID      uuid.UUID       `gorm:"type:uuid;not null;index" json:"dumpsterId" validate:"required"`
-	Dumpster        *Dumpster       `gorm:"foreignKey:DumpsterID" json:"dumpster,omitempty"`
-	UserID          uuid.UUID       `gorm:"type:uuid;not null;index" json:"userId" validate:"required"`
-	User            *User           `gorm:"foreignKey:UserID" json:"user,omitempty"`
-	StartTime       time.Time       `gorm:"not null;index" json:"startTime" validate:"required"`
-	EndTime         *time.Time      `json:"endTime"`
-	DurationMinutes *int            `json:"durationMinutes"`
-	TotalCost       *float64        `gorm:"type:decimal(10,2)" json:"totalCost"`
-	Status          UsageStatus     `gorm:"type:varchar(20);not null;default:'active';index" json:"status" validate:"required,oneof=active completed cancelled"`
-	Notes           string          `gorm:"type:text" json:"notes"`
-	CreatedAt       time.Time       `gorm:"autoCreateTime;not null" json:"createdAt"`
-	UpdatedAt       time.Time       `gorm:"autoUpdateTime;not null" json:"updatedAt"`
-	DeletedAt       gorm.DeletedAt  `gorm:"index" json:"-"`
+	ID              uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	DumpsterID      uuid.UUID      `gorm:"type:uuid;not null;index" json:"dumpsterId" validate:"required"`
+	Dumpster        *Dumpster      `gorm:"foreignKey:DumpsterID" json:"dumpster,omitempty"`
+	UserID          uuid.UUID      `gorm:"type:uuid;not null;index" json:"userId" validate:"required"`
+	User            *User          `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	StartTime       time.Time      `gorm:"not null;index" json:"startTime" validate:"required"`
+	EndTime         *time.Time     `json:"endTime"`
+	DurationMinutes *int           `json:"durationMinutes"`
+	TotalCost       *money.Amount  `gorm:"type:decimal(10,2)" json:"totalCost"`
+	Status          UsageStatus    `gorm:"type:varchar(20);not null;default:'active';index" json:"status" validate:"required,oneof=draft active completed cancelled"`
+	Notes           string         `gorm:"type:text" json:"notes"`
+	PaidAt          *time.Time     `gorm:"type:timestamp" json:"paidAt,omitempty"`
+	CreatedAt       time.Time      `gorm:"autoCreateTime;not null" json:"createdAt"`
+	UpdatedAt       time.Time      `gorm:"autoUpdateTime;not null" json:"updatedAt"`
+	DeletedAt       gorm.DeletedAt `gorm:"index" json:"-"`
 }
 
 type UsageStatus string
 
 const (
+	UsageStatusDraft     UsageStatus = "draft"
 	UsageStatusActive    UsageStatus = "active"
 	UsageStatusCompleted UsageStatus = "completed"
 	UsageStatusCancelled UsageStatus = "cancelled"
 )
 
+// usageTransitions lists the statuses a usage may move to from each status.
+// Completed and cancelled sessions are final.
+var usageTransitions = map[UsageStatus][]UsageStatus{
+	UsageStatusDraft:  {UsageStatusActive},
+	UsageStatusActive: {UsageStatusCompleted, UsageStatusCancelled},
+}
+
+func (s UsageStatus) CanTransitionTo(next UsageStatus) bool {
+	return slices.Contains(usageTransitions[s], next)
+}
+
 func NewDumpsterUsageFromDTO(
 	userID, dumpsterID uuid.UUID,
 	req dto.StartUsageRequest) *DumpsterUsage {
@@ -45,14 +60,40 @@ func NewDumpsterUsageFromDTO(
 	}
 }
 
+// NewDraftUsageFromDTO builds a draft session. Without a planned start time
+// the draft starts now; activation sets the real start.
+func NewDraftUsageFromDTO(
+	userID, dumpsterID uuid.UUID,
+	req dto.CreateUsageDraftRequest) *DumpsterUsage {
+	startTime := time.Now()
+	if req.StartTime != nil {
+		startTime = *req.StartTime
+	}
+
+	return &DumpsterUsage{
+		UserID:     userID,
+		DumpsterID: dumpsterID,
+		StartTime:  startTime,
+		Status:     UsageStatusDraft,
+		Notes:      req.Notes,
+	}
+}
+
+func (u *DumpsterUsage) IsPaid() bool {
+	return u.PaidAt != nil
+}
+
 func (u *DumpsterUsage) ToResponse() dto.UsageResponse {
 	resp := dto.UsageResponse{
 		ID:         u.ID.String(),
 		DumpsterID: u.DumpsterID.String(),
 		UserID:     u.UserID.String(),
 		StartTime:  u.StartTime,
+		Currency:   money.Currency(),
 		Status:     string(u.Status),
 		Notes:      u.Notes,
+		IsPaid:     u.IsPaid(),
+		PaidAt:     u.PaidAt,
 		CreatedAt:  u.CreatedAt,
 		UpdatedAt:  u.UpdatedAt,
 	}
@@ -67,6 +108,7 @@ func (u *DumpsterUsage) ToResponse() dto.UsageResponse {
 
 	if u.TotalCost != nil {
 		resp.TotalCost = u.TotalCost
+		resp.TotalCostFormatted = money.Format(*u.TotalCost)
 	}
 
 	if u.User != nil {
@@ -21,15 +21,25 @@ type User struct {
 	City            string         `gorm:"type:varchar(100);not null" json:"city" validate:"required"`
 	State           string         `gorm:"type:varchar(50)" json:"state" validate:"omitempty,len=2"`
 	ZipCode         string         `gorm:"type:varchar(10);not null" json:"zipCode" validate:"required,numeric"`
+	Latitude        *float64       `gorm:"type:decimal(10,8)" json:"-"` // Geocoded from address; never exposed
+	Longitude       *float64       `gorm:"type:decimal(11,8)" json:"-"`
 	IsEmailVerified bool           `gorm:"default:false;not null" json:"isEmailVerified"`
 	IsPhoneVerified bool           `gorm:"default:false;not null" json:"isPhoneVerified"`
 	IsActive        bool           `gorm:"default:true;not null" json:"isActive"`
+	Role            UserRole       `gorm:"type:varchar(20);not null;default:'user'" json:"role"`
 	LastLoginAt     *time.Time     `gorm:"type:timestamp" json:"lastLoginAt,omitempty"`
 	CreatedAt       time.Time      `gorm:"autoCreateTime;not null" json:"createdAt"`
 	UpdatedAt       time.Time      `gorm:"autoUpdateTime;not null" json:"updatedAt"`
 	DeletedAt       gorm.DeletedAt `gorm:"index" json:"-"` // Soft delete
 }
 
+type UserRole string
+
+const (
+	UserRoleUser  UserRole = "user"
+	UserRoleAdmin UserRole = "admin"
+)
+
 func NewUserFromDTO(req dto.CreateUserRequest) (*User, error) {
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
 	if err != nil {
@@ -47,6 +57,7 @@ func NewUserFromDTO(req dto.CreateUserRequest) (*User, error) {
 		City:         req.City,
 		State:        req.State,
 		ZipCode:      req.ZipCode,
+		Role:         UserRoleUser,
 	}, nil
 }
 
@@ -65,8 +76,20 @@ func (u *User) ToResponse() dto.UserResponse {
 		IsEmailVerified: u.IsEmailVerified,
 		IsPhoneVerified: u.IsPhoneVerified,
 		IsActive:        u.IsActive,
+		Role:            string(u.Role),
 		LastLoginAt:     u.LastLoginAt,
 		CreatedAt:       u.CreatedAt,
 		UpdatedAt:       u.UpdatedAt,
 	}
 }
+
+func (u *User) ToPublicResponse() dto.PublicUserResponse {
+	return dto.PublicUserResponse{
+		ID:        u.ID.String(),
+		FirstName: u.FirstName,
+		LastName:  u.LastName,
+		City:      u.City,
+		State:     u.State,
+		CreatedAt: u.CreatedAt,
+	}
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+	"waste-space/internal/dto"
+	"waste-space/internal/model"
+	"waste-space/pkg/auth"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	apiKeyPrefix        = "wsk_"
+	apiKeyDisplayChars  = 12
+	maxAPIKeysPerUser   = 10
+	apiKeyTouchInterval = 5 * time.Minute
+)
+
+// CreateAPIKey issues a new key for the user. The raw key is in the response
+// and nowhere else; only its hash is stored.
+func (s *userService) CreateAPIKey(
+	ctx context.Context,
+	userID string,
+	req dto.CreateAPIKeyRequest) (*dto.CreatedAPIKeyResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	active, err := s.apiKeyRepo.CountActiveByUser(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+	if active >= maxAPIKeysPerUser {
+		return nil, apperrors.BadRequest(fmt.Sprintf("at most %d active API keys are allowed; revoke one first", maxAPIKeysPerUser))
+	}
+
+	raw, err := newAPIKey()
+	if err != nil {
+		return nil, apperrors.Internal("failed to generate API key", err)
+	}
+
+	key := model.NewAPIKey(userUUID, hashAPIKey(raw), raw[:apiKeyDisplayChars], req.Label)
+	if err := s.apiKeyRepo.Create(ctx, key); err != nil {
+		s.logger.Error("failed to create API key", zap.String("userId", userID), zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("API key created", zap.String("userId", userID), zap.String("apiKeyId", key.ID.String()))
+
+	return &dto.CreatedAPIKeyResponse{
+		APIKeyResponse: key.ToResponse(),
+		Key:            raw,
+	}, nil
+}
+
+func (s *userService) ListAPIKeys(ctx context.Context, userID string) ([]dto.APIKeyResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	keys, err := s.apiKeyRepo.ListByUser(ctx, userUUID)
+	if err != nil {
+		s.logger.Error("failed to list API keys", zap.String("userId", userID), zap.Error(err))
+		return nil, err
+	}
+
+	responses := make([]dto.APIKeyResponse, len(keys))
+	for i, key := range keys {
+		responses[i] = key.ToResponse()
+	}
+
+	return responses, nil
+}
+
+func (s *userService) RevokeAPIKey(ctx context.Context, userID, id string) error {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return apperrors.BadRequest("invalid user ID")
+	}
+
+	keyID, err := uuid.Parse(id)
+	if err != nil {
+		return apperrors.BadRequest("invalid API key ID")
+	}
+
+	if err := s.apiKeyRepo.Revoke(ctx, userUUID, keyID, time.Now()); err != nil {
+		return err
+	}
+
+	s.logger.Info("API key revoked", zap.String("userId", userID), zap.String("apiKeyId", id))
+	return nil
+}
+
+// AuthenticateAPIKey resolves a raw key to its user's claims. Revoked keys
+// and keys of deactivated users are rejected. The key's last-used time is
+// only written once it is apiKeyTouchInterval old, not on every request.
+func (s *userService) AuthenticateAPIKey(ctx context.Context, raw string) (*auth.Claims, error) {
+	key, err := s.apiKeyRepo.GetByHash(ctx, hashAPIKey(raw))
+	if err != nil {
+		if apperrors.Is(err, apperrors.ErrorTypeNotFound) {
+			return nil, apperrors.Unauthorized("invalid API key")
+		}
+		return nil, err
+	}
+
+	if key.IsRevoked() {
+		return nil, apperrors.Unauthorized("invalid API key")
+	}
+
+	user, err := s.userRepo.GetByID(ctx, key.UserID)
+	if err != nil {
+		if apperrors.Is(err, apperrors.ErrorTypeNotFound) {
+			return nil, apperrors.Unauthorized("invalid API key")
+		}
+		return nil, err
+	}
+
+	if !user.IsActive {
+		return nil, apperrors.Unauthorized("account is deactivated")
+	}
+
+	now := time.Now()
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= apiKeyTouchInterval {
+		if err := s.apiKeyRepo.TouchLastUsed(ctx, key.ID, now); err != nil {
+			s.logger.Warn("failed to record API key use", zap.String("apiKeyId", key.ID.String()), zap.Error(err))
+		}
+	}
+
+	return &auth.Claims{
+		UserID: user.ID,
+		Email:  user.Email,
+		Role:   string(user.Role),
+	}, nil
+}
+
+func newAPIKey() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return apiKeyPrefix + hex.EncodeToString(secret), nil
+}
+
+func hashAPIKey(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
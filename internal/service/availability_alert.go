@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"waste-space/internal/model"
+	"waste-space/internal/storage/repository"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type AvailabilityAlertService interface {
+	Subscribe(ctx context.Context, userID, dumpsterID string) error
+	Unsubscribe(ctx context.Context, userID, dumpsterID string) error
+	NotifyAvailable(ctx context.Context, dumpster *model.Dumpster)
+}
+
+type availabilityAlertService struct {
+	alertRepo           repository.AvailabilityAlertRepository
+	dumpsterRepo        repository.DumpsterRepository
+	notificationService NotificationService
+	logger              *zap.Logger
+}
+
+func NewAvailabilityAlertService(
+	alertRepo repository.AvailabilityAlertRepository,
+	dumpsterRepo repository.DumpsterRepository,
+	notificationService NotificationService,
+	logger *zap.Logger) AvailabilityAlertService {
+	return &availabilityAlertService{
+		alertRepo:           alertRepo,
+		dumpsterRepo:        dumpsterRepo,
+		notificationService: notificationService,
+		logger:              logger,
+	}
+}
+
+func (s *availabilityAlertService) Subscribe(ctx context.Context, userID, dumpsterID string) error {
+	userUUID, dumpsterUUID, err := parseAlertIDs(userID, dumpsterID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.dumpsterRepo.GetByID(ctx, dumpsterUUID, repository.WithPreload()); err != nil {
+		return err
+	}
+
+	if err := s.alertRepo.Create(ctx, model.NewAvailabilityAlert(userUUID, dumpsterUUID)); err != nil {
+		s.logger.Error("failed to create availability alert",
+			zap.String("userId", userID),
+			zap.String("dumpsterId", dumpsterID),
+			zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *availabilityAlertService) Unsubscribe(ctx context.Context, userID, dumpsterID string) error {
+	userUUID, dumpsterUUID, err := parseAlertIDs(userID, dumpsterID)
+	if err != nil {
+		return err
+	}
+
+	return s.alertRepo.Delete(ctx, userUUID, dumpsterUUID)
+}
+
+// NotifyAvailable tells every subscriber that the dumpster has freed up and
+// clears their alerts. Failures are logged rather than returned so they never
+// fail the change that made the dumpster available.
+func (s *availabilityAlertService) NotifyAvailable(ctx context.Context, dumpster *model.Dumpster) {
+	alerts, err := s.alertRepo.ClaimByDumpsterID(ctx, dumpster.ID)
+	if err != nil {
+		s.logger.Error("failed to claim availability alerts", zap.String("dumpsterId", dumpster.ID.String()), zap.Error(err))
+		return
+	}
+
+	for _, alert := range alerts {
+		notification := model.NewNotification(
+			alert.UserID,
+			model.NotificationTypeDumpsterAvailable,
+			"Dumpster available",
+			fmt.Sprintf("%s is available again.", dumpster.Title),
+			&dumpster.ID,
+		)
+		if err := s.notificationService.Notify(ctx, notification); err != nil {
+			s.logger.Warn("failed to send availability alert",
+				zap.String("userId", alert.UserID.String()),
+				zap.String("dumpsterId", dumpster.ID.String()),
+				zap.Error(err))
+		}
+	}
+}
+
+func parseAlertIDs(userID, dumpsterID string) (uuid.UUID, uuid.UUID, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	dumpsterUUID, err := uuid.Parse(dumpsterID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, apperrors.BadRequest("invalid dumpster ID")
+	}
+
+	return userUUID, dumpsterUUID, nil
+}
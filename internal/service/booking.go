@@ -0,0 +1,371 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+	"waste-space/internal/dto"
+	"waste-space/internal/model"
+	"waste-space/internal/storage/repository"
+	apperrors "waste-space/pkg/errors"
+	"waste-space/pkg/money"
+	"waste-space/pkg/payment"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type BookingService interface {
+	GetByID(ctx context.Context, userID, id string) (*dto.BookingResponse, error)
+	GetUpcoming(ctx context.Context, userID string) ([]dto.BookingResponse, error)
+	Confirm(ctx context.Context, ownerID, id string) (*dto.BookingResponse, error)
+	EndEarly(ctx context.Context, userID, id string, endDate time.Time) (*dto.EndBookingEarlyResponse, error)
+	ExpirePending(ctx context.Context) (int, error)
+	ResendNotification(ctx context.Context, userID, id string) error
+	GetLeadTimeStats(ctx context.Context, ownerID, dumpsterID string, isAdmin bool) (*dto.BookingLeadTimeResponse, error)
+}
+
+type BookingServiceConfig struct {
+	PendingTTL time.Duration
+}
+
+type bookingService struct {
+	bookingRepo         repository.BookingRepository
+	dumpsterRepo        repository.DumpsterRepository
+	paymentRepo         repository.PaymentRepository
+	notificationService NotificationService
+	pricing             PricingRuleService
+	processor           payment.Processor
+	ownership           *OwnershipGuard
+	cfg                 BookingServiceConfig
+	logger              *zap.Logger
+}
+
+func NewBookingService(
+	bookingRepo repository.BookingRepository,
+	dumpsterRepo repository.DumpsterRepository,
+	paymentRepo repository.PaymentRepository,
+	notificationService NotificationService,
+	pricing PricingRuleService,
+	processor payment.Processor,
+	ownership *OwnershipGuard,
+	cfg BookingServiceConfig,
+	logger *zap.Logger) BookingService {
+	return &bookingService{
+		bookingRepo:         bookingRepo,
+		dumpsterRepo:        dumpsterRepo,
+		paymentRepo:         paymentRepo,
+		notificationService: notificationService,
+		pricing:             pricing,
+		processor:           processor,
+		ownership:           ownership,
+		cfg:                 cfg,
+		logger:              logger,
+	}
+}
+
+func (s *bookingService) GetByID(ctx context.Context, userID, id string) (*dto.BookingResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	booking, err := s.getBooking(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ownership.CheckAny(userUUID, "booking", "view", booking.UserID, booking.Dumpster.OwnerID); err != nil {
+		return nil, err
+	}
+
+	response := booking.ToResponse()
+	return &response, nil
+}
+
+// GetUpcoming lists the user's confirmed bookings that have not started
+// yet, soonest first.
+func (s *bookingService) GetUpcoming(ctx context.Context, userID string) ([]dto.BookingResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	bookings, err := s.bookingRepo.GetUpcomingByUser(ctx, userUUID, time.Now())
+	if err != nil {
+		s.logger.Error("failed to get upcoming bookings", zap.String("userId", userID), zap.Error(err))
+		return nil, err
+	}
+
+	responses := make([]dto.BookingResponse, len(bookings))
+	for i, booking := range bookings {
+		responses[i] = booking.ToResponse()
+	}
+
+	return responses, nil
+}
+
+func (s *bookingService) Confirm(ctx context.Context, ownerID, id string) (*dto.BookingResponse, error) {
+	ownerUUID, err := uuid.Parse(ownerID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	booking, err := s.getBooking(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ownership.Check(booking.Dumpster.OwnerID, ownerUUID, "booking", "confirm"); err != nil {
+		return nil, err
+	}
+
+	if booking.Status != model.BookingStatusPending {
+		return nil, apperrors.BadRequest("booking is no longer pending")
+	}
+
+	if err := s.bookingRepo.Confirm(ctx, booking.ID, time.Now()); err != nil {
+		s.logger.Error("failed to confirm booking", zap.String("bookingId", id), zap.Error(err))
+		return nil, err
+	}
+
+	confirmed, err := s.bookingRepo.GetByID(ctx, booking.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.notificationService.Notify(ctx, bookingConfirmedNotification(confirmed)); err != nil {
+		s.logger.Warn("failed to notify booker of confirmation", zap.String("bookingId", id), zap.Error(err))
+	}
+
+	response := confirmed.ToResponse()
+	return &response, nil
+}
+
+// EndEarly shortens a confirmed booking to endDate, reprices it for the days
+// used and refunds the difference. Retries are never refunded twice.
+func (s *bookingService) EndEarly(
+	ctx context.Context,
+	userID, id string,
+	endDate time.Time) (*dto.EndBookingEarlyResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	booking, err := s.getBooking(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ownership.Check(booking.UserID, userUUID, "booking", "end"); err != nil {
+		return nil, err
+	}
+
+	if booking.Status != model.BookingStatusConfirmed {
+		return nil, apperrors.BadRequest("only confirmed bookings can be ended early")
+	}
+
+	if !endDate.After(booking.StartDate) || !endDate.Before(booking.EndDate) {
+		return nil, apperrors.BadRequest("end date must be after the start date and before the current end date")
+	}
+
+	subtotal, err := s.pricing.Quote(ctx, booking.Dumpster, booking.StartDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	originalEnd := booking.EndDate
+	originalTotal := booking.TotalPrice
+	now := time.Now()
+
+	booking.EndDate = endDate
+	booking.Subtotal = subtotal
+	booking.Tax = subtotal.Mul(booking.TaxRate)
+	booking.TotalPrice = subtotal + booking.Tax
+	booking.EndedEarlyAt = &now
+
+	var refund money.Amount
+	var paid, refunded *model.Payment
+	if booking.PaidAt != nil {
+		paid, err = s.paymentRepo.GetPaidByBookingID(ctx, booking.ID)
+		if err != nil {
+			return nil, err
+		}
+		if paid != nil {
+			refund = min(max(originalTotal-booking.TotalPrice, 0), paid.Refundable())
+		}
+	}
+
+	if refund > 0 {
+		result, err := s.processor.Refund(ctx, payment.RefundRequest{
+			ChargeReference: paid.ProviderReference,
+			Amount:          refund,
+			Currency:        paid.Currency,
+			IdempotencyKey:  fmt.Sprintf("refund_%s_%d", booking.ID, endDate.Unix()),
+			Description:     "Early end of booking " + booking.ID.String(),
+		})
+		if err != nil {
+			s.logger.Error("failed to refund booking", zap.String("bookingId", id), zap.Error(err))
+			return nil, apperrors.Internal("failed to process refund", err)
+		}
+
+		paid.RefundedAmount += refund
+		paid.RefundReference = result.Reference
+		paid.RefundedAt = &now
+		refunded = paid
+	}
+
+	if err := s.bookingRepo.EndEarly(ctx, booking, originalEnd, refunded); err != nil {
+		if refunded != nil {
+			s.logger.Error("refund issued but booking was not ended early; manual review required",
+				zap.String("bookingId", id),
+				zap.String("refundReference", refunded.RefundReference),
+				zap.Error(err))
+		}
+		return nil, err
+	}
+
+	response := &dto.EndBookingEarlyResponse{
+		Booking:         booking.ToResponse(),
+		Refund:          refund,
+		RefundFormatted: money.Format(refund),
+		Currency:        money.Currency(),
+	}
+	if refunded != nil {
+		response.RefundReference = refunded.RefundReference
+	}
+
+	return response, nil
+}
+
+func (s *bookingService) ExpirePending(ctx context.Context) (int, error) {
+	cutoff := time.Now().Add(-s.cfg.PendingTTL)
+
+	expired, err := s.bookingRepo.ExpirePending(ctx, cutoff)
+	if err != nil {
+		s.logger.Error("failed to expire pending bookings", zap.Error(err))
+		return 0, err
+	}
+
+	for _, booking := range expired {
+		if err := s.notificationService.Notify(ctx, bookingExpiredNotification(booking)); err != nil {
+			s.logger.Warn("failed to notify booker of expiry", zap.String("bookingId", booking.ID.String()), zap.Error(err))
+		}
+	}
+
+	return len(expired), nil
+}
+
+// ResendNotification sends the booker the notification for the booking's
+// current state again. Either the booker or the dumpster's owner may ask for
+// it; bookings still pending or cancelled have nothing to resend.
+func (s *bookingService) ResendNotification(ctx context.Context, userID, id string) error {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return apperrors.BadRequest("invalid user ID")
+	}
+
+	booking, err := s.getBooking(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.ownership.CheckAny(userUUID, "booking", "access", booking.UserID, booking.Dumpster.OwnerID); err != nil {
+		return err
+	}
+
+	var notification *model.Notification
+	switch booking.Status {
+	case model.BookingStatusConfirmed:
+		notification = bookingConfirmedNotification(booking)
+	case model.BookingStatusExpired:
+		notification = bookingExpiredNotification(booking)
+	default:
+		return apperrors.BadRequest(fmt.Sprintf("there is no notification to resend for a %s booking", booking.Status))
+	}
+
+	if err := s.notificationService.Notify(ctx, notification); err != nil {
+		s.logger.Error("failed to resend booking notification", zap.String("bookingId", id), zap.Error(err))
+		return err
+	}
+
+	s.logger.Info("booking notification resent",
+		zap.String("bookingId", id),
+		zap.String("requestedBy", userID),
+		zap.String("status", string(booking.Status)))
+
+	return nil
+}
+
+// GetLeadTimeStats reports how far ahead of the start date the dumpster's
+// confirmed bookings were made. Only its owner, or an admin, may see it.
+func (s *bookingService) GetLeadTimeStats(
+	ctx context.Context,
+	ownerID, dumpsterID string,
+	isAdmin bool) (*dto.BookingLeadTimeResponse, error) {
+	dumpsterUUID, err := uuid.Parse(dumpsterID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid dumpster ID")
+	}
+
+	ownerUUID, err := uuid.Parse(ownerID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid owner ID")
+	}
+
+	dumpster, err := s.dumpsterRepo.GetByID(ctx, dumpsterUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isAdmin {
+		if err := s.ownership.Check(dumpster.OwnerID, ownerUUID, "dumpster", "view the booking lead time of"); err != nil {
+			return nil, err
+		}
+	}
+
+	stats, err := s.bookingRepo.GetLeadTimeStats(ctx, dumpster.ID)
+	if err != nil {
+		s.logger.Error("failed to get booking lead time", zap.String("dumpsterId", dumpsterID), zap.Error(err))
+		return nil, err
+	}
+
+	stats.DumpsterID = dumpster.ID.String()
+	stats.AverageDays = math.Round(stats.AverageDays*10) / 10
+	stats.MedianDays = math.Round(stats.MedianDays*10) / 10
+
+	return stats, nil
+}
+
+func bookingConfirmedNotification(booking *model.Booking) *model.Notification {
+	return model.NewNotification(
+		booking.UserID,
+		model.NotificationTypeBookingConfirmed,
+		"Booking confirmed",
+		fmt.Sprintf("Your booking for %s starting %s has been confirmed.",
+			booking.Dumpster.Title, booking.StartDate.Format(time.DateOnly)),
+		&booking.ID,
+	)
+}
+
+func bookingExpiredNotification(booking *model.Booking) *model.Notification {
+	return model.NewNotification(
+		booking.UserID,
+		model.NotificationTypeBookingExpired,
+		"Booking expired",
+		fmt.Sprintf("Your booking starting %s expired because the owner did not confirm it in time.",
+			booking.StartDate.Format(time.DateOnly)),
+		&booking.ID,
+	)
+}
+
+func (s *bookingService) getBooking(ctx context.Context, id string) (*model.Booking, error) {
+	bookingUUID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid booking ID")
+	}
+
+	return s.bookingRepo.GetByID(ctx, bookingUUID)
+}
@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"waste-space/internal/dto"
+	"waste-space/internal/storage/repository"
+	apperrors "waste-space/pkg/errors"
+	"waste-space/pkg/money"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type DashboardService interface {
+	GetOwnerDashboard(ctx context.Context, ownerID string) (*dto.OwnerDashboardResponse, error)
+	GetMonthlyStatement(ctx context.Context, ownerID string, year, month int) (*dto.MonthlyStatementResponse, error)
+}
+
+type DashboardServiceConfig struct {
+	// PlatformFeeRate is the share of statement revenue kept as fees, from 0
+	// to 1.
+	PlatformFeeRate float64
+}
+
+const minStatementYear = 2000
+
+type dashboardService struct {
+	dumpsterRepo repository.DumpsterRepository
+	usageRepo    repository.UsageRepository
+	bookingRepo  repository.BookingRepository
+	cfg          DashboardServiceConfig
+	logger       *zap.Logger
+}
+
+func NewDashboardService(
+	dumpsterRepo repository.DumpsterRepository,
+	usageRepo repository.UsageRepository,
+	bookingRepo repository.BookingRepository,
+	cfg DashboardServiceConfig,
+	logger *zap.Logger) DashboardService {
+	return &dashboardService{
+		dumpsterRepo: dumpsterRepo,
+		usageRepo:    usageRepo,
+		bookingRepo:  bookingRepo,
+		cfg:          cfg,
+		logger:       logger,
+	}
+}
+
+// GetOwnerDashboard summarizes the owner's listings. Revenue counts usages
+// completed since the start of the current calendar month (UTC).
+func (s *dashboardService) GetOwnerDashboard(ctx context.Context, ownerID string) (*dto.OwnerDashboardResponse, error) {
+	ownerUUID, err := uuid.Parse(ownerID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	now := time.Now().UTC()
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	listingCount, avgRating, err := s.dumpsterRepo.GetOwnerListingSummary(ctx, ownerUUID)
+	if err != nil {
+		s.logger.Error("failed to summarize owner listings", zap.String("ownerId", ownerID), zap.Error(err))
+		return nil, err
+	}
+
+	activeUsages, revenue, err := s.usageRepo.GetOwnerActivity(ctx, ownerUUID, monthStart)
+	if err != nil {
+		s.logger.Error("failed to summarize owner usage activity", zap.String("ownerId", ownerID), zap.Error(err))
+		return nil, err
+	}
+
+	pendingBookings, err := s.bookingRepo.CountPendingByOwner(ctx, ownerUUID)
+	if err != nil {
+		s.logger.Error("failed to count pending bookings", zap.String("ownerId", ownerID), zap.Error(err))
+		return nil, err
+	}
+
+	return &dto.OwnerDashboardResponse{
+		ListingCount:     listingCount,
+		ActiveUsages:     activeUsages,
+		PendingBookings:  pendingBookings,
+		RevenueThisMonth: revenue,
+		Currency:         money.Currency(),
+		AverageRating:    math.Round(avgRating*100) / 100,
+		PeriodStart:      monthStart,
+	}, nil
+}
+
+// GetMonthlyStatement builds the owner's statement for a calendar month
+// (UTC) from usages completed and confirmed bookings ended within it. The
+// current month can be requested and is marked not final; later months
+// cannot. Listings are ordered by title so the layout is stable.
+func (s *dashboardService) GetMonthlyStatement(
+	ctx context.Context,
+	ownerID string,
+	year, month int) (*dto.MonthlyStatementResponse, error) {
+	ownerUUID, err := uuid.Parse(ownerID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	if month < 1 || month > 12 {
+		return nil, apperrors.BadRequest("month must be between 1 and 12")
+	}
+
+	now := time.Now().UTC()
+	periodStart := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+	periodEnd := periodStart.AddDate(0, 1, 0)
+	if year < minStatementYear || periodStart.After(now) {
+		return nil, apperrors.BadRequest("no statement exists for that month yet")
+	}
+
+	cutoff := periodEnd
+	if now.Before(cutoff) {
+		cutoff = now
+	}
+
+	usages, err := s.usageRepo.GetOwnerRevenueByDumpster(ctx, ownerUUID, periodStart, cutoff)
+	if err != nil {
+		s.logger.Error("failed to get usage revenue for statement", zap.String("ownerId", ownerID), zap.Error(err))
+		return nil, err
+	}
+
+	bookings, err := s.bookingRepo.GetOwnerRevenueByDumpster(ctx, ownerUUID, periodStart, cutoff)
+	if err != nil {
+		s.logger.Error("failed to get booking revenue for statement", zap.String("ownerId", ownerID), zap.Error(err))
+		return nil, err
+	}
+
+	lines := make(map[string]*dto.StatementDumpsterLine)
+	lineFor := func(row dto.StatementRevenueRow) *dto.StatementDumpsterLine {
+		line, ok := lines[row.DumpsterID]
+		if !ok {
+			line = &dto.StatementDumpsterLine{DumpsterID: row.DumpsterID, Title: row.Title}
+			lines[row.DumpsterID] = line
+		}
+		return line
+	}
+	for _, row := range usages {
+		line := lineFor(row)
+		line.Usages = row.Count
+		line.UsageRevenue = row.Revenue
+	}
+	for _, row := range bookings {
+		line := lineFor(row)
+		line.Bookings = row.Count
+		line.BookingRevenue = row.Revenue
+	}
+
+	statement := &dto.MonthlyStatementResponse{
+		StatementNumber: fmt.Sprintf("STM-%04d%02d-%s", year, month, strings.ToUpper(ownerUUID.String()[:8])),
+		OwnerID:         ownerUUID.String(),
+		Year:            year,
+		Month:           month,
+		PeriodStart:     periodStart,
+		PeriodEnd:       periodEnd,
+		GeneratedAt:     now,
+		Final:           !periodEnd.After(now),
+		Currency:        money.Currency(),
+		FeeRate:         s.cfg.PlatformFeeRate,
+		Dumpsters:       make([]dto.StatementDumpsterLine, 0, len(lines)),
+	}
+
+	for _, line := range lines {
+		line.Revenue = line.UsageRevenue + line.BookingRevenue
+		line.Fees = line.Revenue.Mul(s.cfg.PlatformFeeRate)
+		line.Payout = line.Revenue - line.Fees
+		statement.Dumpsters = append(statement.Dumpsters, *line)
+
+		statement.Totals.Usages += line.Usages
+		statement.Totals.UsageRevenue += line.UsageRevenue
+		statement.Totals.Bookings += line.Bookings
+		statement.Totals.BookingRevenue += line.BookingRevenue
+		statement.Totals.Revenue += line.Revenue
+		statement.Totals.Fees += line.Fees
+		statement.Totals.Payout += line.Payout
+	}
+
+	sort.Slice(statement.Dumpsters, func(i, j int) bool {
+		a, b := statement.Dumpsters[i], statement.Dumpsters[j]
+		if a.Title != b.Title {
+			return a.Title < b.Title
+		}
+		return a.DumpsterID < b.DumpsterID
+	})
+
+	return statement, nil
+}
@@ -2,13 +2,28 @@ package service
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"math"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
+	"unicode"
 	"waste-space/internal/dto"
 	"waste-space/internal/model"
+	"waste-space/internal/storage/cache"
 	"waste-space/internal/storage/repository"
 	apperrors "waste-space/pkg/errors"
+	"waste-space/pkg/geo"
+	"waste-space/pkg/money"
+	"waste-space/pkg/tax"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -16,26 +31,188 @@ import (
 
 type DumpsterService interface {
 	Create(ctx context.Context, ownerID string, req dto.CreateDumpsterRequest) (*dto.DumpsterResponse, error)
-	GetByID(ctx context.Context, id string) (*dto.DumpsterResponse, error)
-	Update(ctx context.Context, ownerID, id string, req dto.UpdateDumpsterRequest) (*dto.DumpsterResponse, error)
+	GetByID(ctx context.Context, viewerID string, isAdmin bool, id string) (*dto.DumpsterResponse, error)
+	GetRecentlyViewed(ctx context.Context, userID string) ([]dto.DumpsterResponse, error)
+	Replace(ctx context.Context, ownerID, id string, req dto.ReplaceDumpsterRequest) (*dto.DumpsterResponse, error)
+	Patch(ctx context.Context, ownerID, id string, req dto.PatchDumpsterRequest) (*dto.DumpsterResponse, error)
 	Delete(ctx context.Context, ownerID, id string) error
+	DeleteAllByOwner(ctx context.Context, ownerID string, req dto.DeleteOwnerDumpstersRequest) (*dto.DeleteOwnerDumpstersResponse, error)
+	Clone(ctx context.Context, ownerID, id string) (*dto.DumpsterResponse, error)
+	Publish(ctx context.Context, ownerID, id string) (*dto.DumpsterResponse, error)
+	FindPotentialDuplicates(ctx context.Context, ownerID string) (*dto.DumpsterDuplicatesResponse, error)
+	GetNeedsAttention(ctx context.Context, ownerID string) (*dto.DumpsterNeedsAttentionResponse, error)
+	MergeDuplicates(ctx context.Context, ownerID string, req dto.MergeDumpstersRequest) (*dto.DumpsterMergeResponse, error)
 	List(ctx context.Context, req dto.DumpsterListRequest) (*dto.DumpsterListResponse, error)
 	Search(ctx context.Context, req dto.DumpsterSearchRequest) (*dto.DumpsterListResponse, error)
+	ListUnreviewed(ctx context.Context, req dto.UnreviewedDumpsterListRequest) (*dto.DumpsterListResponse, error)
 	FindNearby(ctx context.Context, req dto.NearbyDumpstersRequest) ([]dto.DumpsterResponse, error)
 	CheckAvailability(ctx context.Context, id string) (*dto.AvailabilityResponse, error)
+	CheckAvailabilityBatch(ctx context.Context, ids []string) (map[string]dto.AvailabilityResponse, error)
 	BookDumpster(ctx context.Context, userID, dumpsterID string, req dto.BookDumpsterRequest) (*dto.BookingResponse, error)
+	Hold(ctx context.Context, userID, dumpsterID string, req dto.HoldDumpsterRequest) (*dto.DumpsterHoldResponse, error)
+	Snooze(ctx context.Context, ownerID, id string, req dto.SnoozeDumpsterRequest) (*dto.DumpsterResponse, error)
+	Unsnooze(ctx context.Context, ownerID, id string) (*dto.DumpsterResponse, error)
+	GetDensity(ctx context.Context, req dto.DumpsterDensityRequest) (*dto.DumpsterDensityResponse, error)
+	CreateShareLink(ctx context.Context, ownerID, id string, ttl time.Duration) (*dto.ShareLinkResponse, error)
+	RevokeShareLinks(ctx context.Context, ownerID, id string) error
+	GetShared(ctx context.Context, token string) (*dto.DumpsterResponse, error)
+	GetBySlug(ctx context.Context, viewerID string, isAdmin bool, slug string) (*dto.DumpsterResponse, error)
+	Quote(ctx context.Context, id string, req dto.DumpsterQuoteRequest) (*dto.DumpsterQuoteResponse, error)
+	ListFeatured(ctx context.Context) ([]dto.DumpsterResponse, error)
+	SetFeatured(ctx context.Context, id string, req dto.SetFeaturedRequest) (*dto.DumpsterResponse, error)
+	SuggestPrice(ctx context.Context, lat, lng float64, size string) (*dto.PriceSuggestionResponse, error)
+	GetSizeGuide(ctx context.Context, project string) (*dto.SizeGuideResponse, error)
+	GetDistance(ctx context.Context, id string, lat, lng float64) (*dto.DumpsterDistanceResponse, error)
+	ValidateAddress(ctx context.Context, req dto.ValidateAddressRequest) (*dto.AddressValidationResponse, error)
+	GetFrequentlyPairedWith(ctx context.Context, id string, limit int) ([]dto.PairedDumpsterResponse, error)
+	GetPriceStats(ctx context.Context, req dto.DumpsterPriceStatsRequest) (*dto.DumpsterPriceStatsResponse, error)
+	GetRegionCounts(ctx context.Context, req dto.DumpsterRegionCountsRequest) (*dto.DumpsterRegionCountsResponse, error)
+	GetTimeline(ctx context.Context, ownerID, id string, isAdmin bool, req dto.DumpsterTimelineRequest) (*dto.DumpsterTimelineResponse, error)
+	ListRevisions(ctx context.Context, userID, id string, isAdmin bool, req dto.DumpsterRevisionListRequest) (*dto.DumpsterRevisionListResponse, error)
+	GetRevision(ctx context.Context, userID, id string, isAdmin bool, revision int) (*dto.DumpsterRevisionResponse, error)
+	RevertToRevision(ctx context.Context, ownerID, id, revisionID string) (*dto.DumpsterResponse, error)
+	Flag(ctx context.Context, reporterID, id string, req dto.FlagDumpsterRequest) error
+	ListFlags(ctx context.Context, req dto.DumpsterFlagListRequest) (*dto.DumpsterFlagListResponse, error)
+	ResolveFlags(ctx context.Context, adminID, id string, req dto.ResolveDumpsterFlagsRequest) (*dto.DumpsterResponse, error)
+}
+
+const (
+	defaultDensityGridSize = 10
+	maxDensityGridSize     = 50
+	defaultTimelineLimit   = 20
+	maxTimelineLimit       = 100
+	maxFlagReasonLen       = 500
+	maxAvailabilityBatch   = 50
+
+	attentionOpenFlags       = "open_flags"
+	attentionNegativeReviews = "negative_reviews"
+	attentionLowRating       = "low_rating"
+	attentionInactive        = "inactive"
+
+	unavailableUnpublished   = "unpublished"
+	unavailableOwnerDisabled = "owner_disabled"
+	unavailableSnoozed       = "snoozed"
+	unavailableInUse         = "in_use"
+	unavailableBooked        = "booked"
+
+	attentionOpenFlagsWeight       = 3
+	attentionNegativeReviewsWeight = 2
+	attentionLowRatingWeight       = 2
+	attentionInactiveWeight        = 1
+
+	duplicateRadiusKm        = 0.1
+	duplicateTitleSimilarity = 0.5
+
+	priceSuggestionRadiusKm       = 25.0
+	priceSuggestionMinComparables = 5
+
+	defaultRegionCityLimit = 50
+	maxRegionCityLimit     = 500
+
+	defaultPairedLimit = 10
+	maxPairedLimit     = 50
+	pairedSourceUsers  = 500
+
+	DefaultShareLinkTTL = 7 * 24 * time.Hour
+	MaxShareLinkTTL     = 30 * 24 * time.Hour
+)
+
+type DumpsterServiceConfig struct {
+	AvailabilityTracksUsage bool
+	RecentlyViewedLimit     int
+	FlagThreshold           int
+	FeaturedLimit           int
+	HoldTTL                 time.Duration
+	Attention               AttentionThresholds
+	// CacheTTL keeps dumpster-by-ID responses in Redis; 0 disables the
+	// cache. NegativeCacheTTL does the same for IDs that weren't found.
+	CacheTTL         time.Duration
+	NegativeCacheTTL time.Duration
+	// SizeGuide adds projects to the built-in size guide or overrides their
+	// recommended size, keyed by normalized project name.
+	SizeGuide map[string]string
+}
+
+// AttentionThresholds decide which listings land on the owner's
+// needs-attention list. A zero threshold disables its check.
+type AttentionThresholds struct {
+	// MinRating flags reviewed listings rated below it.
+	MinRating float64
+	// NegativeReviews flags listings with at least this many negative
+	// reviews within NegativeReviewWindow.
+	NegativeReviews      int
+	NegativeReviewWindow time.Duration
+	// OpenFlags flags listings with at least this many unresolved flags.
+	OpenFlags int
+	// Inactivity flags listings with no usage started for this long,
+	// counting from creation for listings never used.
+	Inactivity time.Duration
 }
 
 type dumpsterService struct {
 	dumpsterRepo repository.DumpsterRepository
+	usageRepo    repository.UsageRepository
+	bookingRepo  repository.BookingRepository
+	reviewRepo   repository.ReviewRepository
+	priceRepo    repository.PriceChangeRepository
+	flagRepo     repository.DumpsterFlagRepository
+	revisionRepo repository.DumpsterRevisionRepository
+	blockRepo    repository.OwnerBlockRepository
+	recentCache  cache.RecentlyViewedCache
+	holds        cache.BookingHoldCache
+	byID         cache.DumpsterCache
+	alerts       AvailabilityAlertService
+	notifier     NotificationService
+	pricing      PricingRuleService
+	taxCalc      tax.Calculator
+	routes       geo.RouteEstimator
+	geocoder     geo.Geocoder
+	ownership    *OwnershipGuard
+	cfg          DumpsterServiceConfig
 	logger       *zap.Logger
 }
 
 func NewDumpsterService(
 	dumpsterRepo repository.DumpsterRepository,
+	usageRepo repository.UsageRepository,
+	bookingRepo repository.BookingRepository,
+	reviewRepo repository.ReviewRepository,
+	priceRepo repository.PriceChangeRepository,
+	flagRepo repository.DumpsterFlagRepository,
+	revisionRepo repository.DumpsterRevisionRepository,
+	blockRepo repository.OwnerBlockRepository,
+	recentCache cache.RecentlyViewedCache,
+	holds cache.BookingHoldCache,
+	byID cache.DumpsterCache,
+	alerts AvailabilityAlertService,
+	notifier NotificationService,
+	pricing PricingRuleService,
+	taxCalc tax.Calculator,
+	routes geo.RouteEstimator,
+	geocoder geo.Geocoder,
+	ownership *OwnershipGuard,
+	cfg DumpsterServiceConfig,
 	logger *zap.Logger) DumpsterService {
 	return &dumpsterService{
 		dumpsterRepo: dumpsterRepo,
+		usageRepo:    usageRepo,
+		bookingRepo:  bookingRepo,
+		reviewRepo:   reviewRepo,
+		priceRepo:    priceRepo,
+		flagRepo:     flagRepo,
+		revisionRepo: revisionRepo,
+		blockRepo:    blockRepo,
+		recentCache:  recentCache,
+		holds:        holds,
+		byID:         byID,
+		alerts:       alerts,
+		notifier:     notifier,
+		pricing:      pricing,
+		taxCalc:      taxCalc,
+		routes:       routes,
+		geocoder:     geocoder,
+		ownership:    ownership,
+		cfg:          cfg,
 		logger:       logger,
 	}
 }
@@ -49,7 +226,14 @@ func (s *dumpsterService) Create(
 		return nil, apperrors.BadRequest("invalid owner ID")
 	}
 
+	if err := validateTags(model.NormalizeTags(req.Tags)); err != nil {
+		return nil, err
+	}
+
 	dumpster := model.NewDumpsterFromDTO(ownerUUID, req)
+	if err := validateDumpster(dumpster); err != nil {
+		return nil, err
+	}
 
 	if err := s.dumpsterRepo.Create(ctx, dumpster); err != nil {
 		s.logger.Error("failed to create dumpster", zap.String("ownerId", ownerID), zap.Error(err))
@@ -60,272 +244,2230 @@ func (s *dumpsterService) Create(
 	return &response, nil
 }
 
-func (s *dumpsterService) GetByID(ctx context.Context, id string) (*dto.DumpsterResponse, error) {
+// GetByID returns the dumpster and, for signed-in viewers other than the
+// owner, records the view in their recently viewed list. viewerID is empty
+// for anonymous requests. Unpublished listings are only shown to their
+// owner and admins.
+func (s *dumpsterService) GetByID(ctx context.Context, viewerID string, isAdmin bool, id string) (*dto.DumpsterResponse, error) {
 	dumpsterID, err := uuid.Parse(id)
 	if err != nil {
 		return nil, apperrors.BadRequest("invalid dumpster ID")
 	}
 
-	dumpster, err := s.dumpsterRepo.GetByID(ctx, dumpsterID)
+	response, err := s.getCachedByID(ctx, dumpsterID)
 	if err != nil {
 		return nil, err
 	}
 
-	response := dumpster.ToResponse()
-	return &response, nil
+	if err := checkVisible(response, viewerID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	s.recordView(ctx, viewerID, response)
+
+	return response, nil
 }
 
-func (s *dumpsterService) Update(
-	ctx context.Context,
-	ownerID, id string,
-	req dto.UpdateDumpsterRequest) (*dto.DumpsterResponse, error) {
-	dumpsterID, err := uuid.Parse(id)
-	if err != nil {
-		return nil, apperrors.BadRequest("invalid dumpster ID")
+func (s *dumpsterService) getCachedByID(ctx context.Context, id uuid.UUID) (*dto.DumpsterResponse, error) {
+	if s.cfg.CacheTTL > 0 {
+		cached, err := s.byID.Get(ctx, id)
+		switch {
+		case err == nil && cached == nil:
+			return nil, apperrors.NotFound("dumpster not found")
+		case err == nil:
+			return cached, nil
+		case !errors.Is(err, cache.ErrDumpsterNotCached):
+			s.logger.Warn("failed to read cached dumpster", zap.String("dumpsterId", id.String()), zap.Error(err))
+		}
 	}
 
-	ownerUUID, err := uuid.Parse(ownerID)
+	dumpster, err := s.dumpsterRepo.GetByID(ctx, id)
 	if err != nil {
-		return nil, apperrors.BadRequest("invalid owner ID")
+		if apperrors.Is(err, apperrors.ErrorTypeNotFound) && s.cfg.CacheTTL > 0 && s.cfg.NegativeCacheTTL > 0 {
+			if err := s.byID.SetMissing(ctx, id, s.cfg.NegativeCacheTTL); err != nil {
+				s.logger.Warn("failed to cache missing dumpster", zap.String("dumpsterId", id.String()), zap.Error(err))
+			}
+		}
+		return nil, err
 	}
 
-	dumpster, err := s.dumpsterRepo.GetByID(ctx, dumpsterID)
-	if err != nil {
-		return nil, err
+	response := dumpster.ToResponse()
+	if s.cfg.CacheTTL <= 0 {
+		return &response, nil
 	}
 
-	if dumpster.OwnerID != ownerUUID {
-		return nil, apperrors.Forbidden("you don't have permission to update this dumpster")
+	ttl := s.cfg.CacheTTL
+	if dumpster.IsSnoozed() {
+		ttl = min(ttl, time.Until(*dumpster.UnavailableUntil))
+	}
+	if ttl <= 0 {
+		return &response, nil
+	}
+	if err := s.byID.Set(ctx, &response, ttl); err != nil {
+		s.logger.Warn("failed to cache dumpster", zap.String("dumpsterId", id.String()), zap.Error(err))
 	}
 
-	s.applyDumpsterUpdates(dumpster, req)
+	return &response, nil
+}
 
-	if err := s.dumpsterRepo.Update(ctx, dumpster); err != nil {
-		s.logger.Error("failed to update dumpster", zap.String("dumpsterId", id), zap.Error(err))
+func (s *dumpsterService) invalidateCached(ctx context.Context, ids ...uuid.UUID) {
+	invalidateDumpsterCache(ctx, s.byID, s.logger, ids...)
+}
+
+func invalidateDumpsterCache(ctx context.Context, byID cache.DumpsterCache, logger *zap.Logger, ids ...uuid.UUID) {
+	if err := byID.Invalidate(ctx, ids...); err != nil {
+		keys := make([]string, len(ids))
+		for i, id := range ids {
+			keys[i] = id.String()
+		}
+		logger.Warn("failed to invalidate cached dumpsters", zap.Strings("dumpsterIds", keys), zap.Error(err))
+	}
+}
+
+func checkVisible(response *dto.DumpsterResponse, viewerID string, isAdmin bool) error {
+	if response.Unpublished && !isAdmin && viewerID != response.OwnerID {
+		return apperrors.NotFound("dumpster not found")
+	}
+	return nil
+}
+
+func (s *dumpsterService) GetBySlug(ctx context.Context, viewerID string, isAdmin bool, slug string) (*dto.DumpsterResponse, error) {
+	if slug == "" {
+		return nil, apperrors.BadRequest("invalid dumpster slug")
+	}
+
+	dumpster, err := s.dumpsterRepo.GetBySlug(ctx, slug)
+	if err != nil {
 		return nil, err
 	}
 
 	response := dumpster.ToResponse()
+	if err := checkVisible(&response, viewerID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	s.recordView(ctx, viewerID, &response)
+
 	return &response, nil
 }
 
-func (s *dumpsterService) Delete(ctx context.Context, ownerID, id string) error {
-	dumpsterID, err := uuid.Parse(id)
+func (s *dumpsterService) recordView(ctx context.Context, viewerID string, dumpster *dto.DumpsterResponse) {
+	viewerUUID, err := uuid.Parse(viewerID)
+	if err != nil || viewerUUID.String() == dumpster.OwnerID {
+		return
+	}
+
+	dumpsterID, err := uuid.Parse(dumpster.ID)
 	if err != nil {
-		return apperrors.BadRequest("invalid dumpster ID")
+		return
 	}
 
-	ownerUUID, err := uuid.Parse(ownerID)
+	if err := s.recentCache.Record(ctx, viewerUUID, dumpsterID, s.cfg.RecentlyViewedLimit); err != nil {
+		s.logger.Warn("failed to record recently viewed dumpster",
+			zap.String("userId", viewerID),
+			zap.String("dumpsterId", dumpster.ID),
+			zap.Error(err))
+	}
+}
+
+// GetRecentlyViewed hydrates the user's recently viewed list, most recent
+// first. Dumpsters deleted since they were viewed are left out.
+func (s *dumpsterService) GetRecentlyViewed(ctx context.Context, userID string) ([]dto.DumpsterResponse, error) {
+	userUUID, err := uuid.Parse(userID)
 	if err != nil {
-		return apperrors.BadRequest("invalid owner ID")
+		return nil, apperrors.BadRequest("invalid user ID")
 	}
 
-	dumpster, err := s.dumpsterRepo.GetByID(ctx, dumpsterID)
+	ids, err := s.recentCache.List(ctx, userUUID)
 	if err != nil {
-		return err
+		s.logger.Error("failed to list recently viewed dumpsters", zap.String("userId", userID), zap.Error(err))
+		return nil, apperrors.Internal("failed to list recently viewed dumpsters", err)
 	}
 
-	if dumpster.OwnerID != ownerUUID {
-		return apperrors.Forbidden("you don't have permission to delete this dumpster")
+	dumpsters, err := s.dumpsterRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		s.logger.Error("failed to load recently viewed dumpsters", zap.String("userId", userID), zap.Error(err))
+		return nil, err
 	}
 
-	return s.dumpsterRepo.Delete(ctx, dumpsterID)
-}
+	byID := make(map[uuid.UUID]*model.Dumpster, len(dumpsters))
+	for _, dumpster := range dumpsters {
+		byID[dumpster.ID] = dumpster
+	}
 
-func (s *dumpsterService) List(ctx context.Context, req dto.DumpsterListRequest) (*dto.DumpsterListResponse, error) {
-	if req.Location != "" {
-		coords := s.parseLocation(req.Location)
-		if len(coords) == 2 {
-			nearbyReq := dto.NearbyDumpstersRequest{
-				Latitude:    coords[0],
-				Longitude:   coords[1],
-				MaxDistance: req.MaxDistance,
-				Limit:       req.Limit,
-			}
-			dumpsters, err := s.dumpsterRepo.FindNearby(ctx, nearbyReq)
-			if err != nil {
-				s.logger.Error("failed to find nearby dumpsters", zap.Error(err))
-				return nil, err
-			}
-			return s.buildDumpsterListResponse(dumpsters, int64(len(dumpsters)), req.Page, req.Limit), nil
+	responses := make([]dto.DumpsterResponse, 0, len(dumpsters))
+	for _, id := range ids {
+		if dumpster, ok := byID[id]; ok {
+			responses = append(responses, dumpster.ToResponse())
 		}
 	}
 
-	dumpsters, total, err := s.dumpsterRepo.List(ctx, req)
+	return responses, nil
+}
+
+// Replace overwrites every field of the dumpster (PUT). Fields missing from
+// req take their creation defaults, so optional text and tags are cleared.
+func (s *dumpsterService) Replace(
+	ctx context.Context,
+	ownerID, id string,
+	req dto.ReplaceDumpsterRequest) (*dto.DumpsterResponse, error) {
+	dumpster, err := s.getOwnedDumpster(ctx, ownerID, id, "update")
 	if err != nil {
-		s.logger.Error("failed to list dumpsters", zap.Error(err))
 		return nil, err
 	}
 
-	return s.buildDumpsterListResponse(dumpsters, total, req.Page, req.Limit), nil
+	tags := model.NormalizeTags(req.Tags)
+	if err := validateTags(tags); err != nil {
+		return nil, err
+	}
+
+	previous := *dumpster
+
+	dumpster.Title = req.Title
+	dumpster.Description = req.Description
+	dumpster.Location = req.Location
+	dumpster.Latitude = req.Latitude
+	dumpster.Longitude = req.Longitude
+	dumpster.Address = req.Address
+	dumpster.City = req.City
+	dumpster.State = req.State
+	dumpster.ZipCode = req.ZipCode
+	dumpster.PricePerDay = req.PricePerDay
+	dumpster.Size = model.DumpsterSize(req.Size)
+	dumpster.Capacity = req.Capacity
+	dumpster.Weight = req.Weight
+	dumpster.AutoRelease = req.AutoRelease
+	dumpster.ExclusiveUse = req.ExclusiveUse
+	dumpster.IsAvailable = req.IsAvailable == nil || *req.IsAvailable
+
+	return s.saveChanges(ctx, &previous, dumpster, &tags)
 }
 
-func (s *dumpsterService) Search(ctx context.Context, req dto.DumpsterSearchRequest) (*dto.DumpsterListResponse, error) {
-	dumpsters, total, err := s.dumpsterRepo.Search(ctx, req)
+// Patch applies a merge patch (PATCH): omitted fields are kept, and null
+// clears the nullable ones.
+func (s *dumpsterService) Patch(
+	ctx context.Context,
+	ownerID, id string,
+	req dto.PatchDumpsterRequest) (*dto.DumpsterResponse, error) {
+	dumpster, err := s.getOwnedDumpster(ctx, ownerID, id, "update")
 	if err != nil {
-		s.logger.Error("failed to search dumpsters", zap.Error(err))
 		return nil, err
 	}
 
-	return s.buildDumpsterListResponse(dumpsters, total, req.Page, req.Limit), nil
+	var tags *[]string
+	if req.Tags.Set {
+		normalized := model.NormalizeTags(req.Tags.Value)
+		if err := validateTags(normalized); err != nil {
+			return nil, err
+		}
+		tags = &normalized
+	}
+
+	previous := *dumpster
+
+	size := string(dumpster.Size)
+	for _, err := range []error{
+		patchRequired(req.Title, "title", &dumpster.Title),
+		patchRequired(req.Location, "location", &dumpster.Location),
+		patchRequired(req.Latitude, "latitude", &dumpster.Latitude),
+		patchRequired(req.Longitude, "longitude", &dumpster.Longitude),
+		patchRequired(req.Address, "address", &dumpster.Address),
+		patchRequired(req.City, "city", &dumpster.City),
+		patchRequired(req.State, "state", &dumpster.State),
+		patchRequired(req.ZipCode, "zipCode", &dumpster.ZipCode),
+		patchRequired(req.PricePerDay, "pricePerDay", &dumpster.PricePerDay),
+		patchRequired(req.Size, "size", &size),
+		patchRequired(req.IsAvailable, "isAvailable", &dumpster.IsAvailable),
+		patchRequired(req.AutoRelease, "autoRelease", &dumpster.AutoRelease),
+		patchRequired(req.ExclusiveUse, "exclusiveUse", &dumpster.ExclusiveUse),
+	} {
+		if err != nil {
+			return nil, err
+		}
+	}
+	dumpster.Size = model.DumpsterSize(size)
+
+	patchNullable(req.Description, &dumpster.Description)
+	patchNullable(req.Capacity, &dumpster.Capacity)
+	patchNullable(req.Weight, &dumpster.Weight)
+
+	return s.saveChanges(ctx, &previous, dumpster, tags)
 }
 
-func (s *dumpsterService) FindNearby(ctx context.Context, req dto.NearbyDumpstersRequest) ([]dto.DumpsterResponse, error) {
-	dumpsters, err := s.dumpsterRepo.FindNearby(ctx, req)
+// Clone copies one of the caller's listings into a new unpublished draft,
+// which the owner edits and then publishes.
+func (s *dumpsterService) Clone(ctx context.Context, ownerID, id string) (*dto.DumpsterResponse, error) {
+	source, err := s.getOwnedDumpster(ctx, ownerID, id, "clone")
 	if err != nil {
-		s.logger.Error("failed to find nearby dumpsters", zap.Error(err))
 		return nil, err
 	}
 
-	responses := make([]dto.DumpsterResponse, len(dumpsters))
-	for i, dumpster := range dumpsters {
-		responses[i] = dumpster.ToResponse()
+	clone := source.Clone(time.Now())
+	if err := s.dumpsterRepo.Create(ctx, clone); err != nil {
+		s.logger.Error("failed to clone dumpster", zap.String("dumpsterId", id), zap.Error(err))
+		return nil, err
 	}
 
-	return responses, nil
+	response := clone.ToResponse()
+	return &response, nil
 }
 
-func (s *dumpsterService) CheckAvailability(ctx context.Context, id string) (*dto.AvailabilityResponse, error) {
-	dumpsterID, err := uuid.Parse(id)
+// Publish makes an unpublished listing, such as a clone, visible. Listings
+// hidden by moderation, i.e. with open or upheld flags, stay hidden until an
+// admin resolves them.
+func (s *dumpsterService) Publish(ctx context.Context, ownerID, id string) (*dto.DumpsterResponse, error) {
+	dumpster, err := s.getOwnedDumpster(ctx, ownerID, id, "publish")
 	if err != nil {
-		return nil, apperrors.BadRequest("invalid dumpster ID")
+		return nil, err
 	}
 
-	dumpster, err := s.dumpsterRepo.GetByID(ctx, dumpsterID)
+	if !dumpster.IsUnpublished() {
+		response := dumpster.ToResponse()
+		return &response, nil
+	}
+
+	flags, err := s.flagRepo.CountByStatus(ctx, dumpster.ID, model.DumpsterFlagStatusOpen, model.DumpsterFlagStatusUpheld)
 	if err != nil {
 		return nil, err
 	}
 
-	message := ""
-	if !dumpster.IsAvailable {
-		message = "Dumpster is currently unavailable"
+	if flags > 0 {
+		return nil, apperrors.Forbidden("listing is hidden by moderation and can't be published")
 	}
 
-	return &dto.AvailabilityResponse{
-		DumpsterID:  id,
-		IsAvailable: dumpster.IsAvailable,
-		Message:     message,
-	}, nil
+	if _, err := s.dumpsterRepo.SetUnpublished(ctx, dumpster.ID, false); err != nil {
+		s.logger.Error("failed to publish dumpster", zap.String("dumpsterId", id), zap.Error(err))
+		return nil, err
+	}
+	s.invalidateCached(ctx, dumpster.ID)
+
+	dumpster.UnpublishedAt = nil
+	response := dumpster.ToResponse()
+	return &response, nil
 }
 
-func (s *dumpsterService) BookDumpster(
+// ListRevisions returns the listing's edit history, newest first. Only the
+// owner and admins may see it.
+func (s *dumpsterService) ListRevisions(
 	ctx context.Context,
-	userID, dumpsterID string,
-	req dto.BookDumpsterRequest) (*dto.BookingResponse, error) {
-	dumpsterUUID, err := uuid.Parse(dumpsterID)
+	userID, id string,
+	isAdmin bool,
+	req dto.DumpsterRevisionListRequest) (*dto.DumpsterRevisionListResponse, error) {
+	dumpster, err := s.getRevisionsDumpster(ctx, userID, id, isAdmin)
 	if err != nil {
-		return nil, apperrors.BadRequest("invalid dumpster ID")
+		return nil, err
 	}
 
-	dumpster, err := s.dumpsterRepo.GetByID(ctx, dumpsterUUID)
+	revisions, total, err := s.revisionRepo.List(ctx, dumpster.ID, req)
 	if err != nil {
+		s.logger.Error("failed to list dumpster revisions", zap.String("dumpsterId", id), zap.Error(err))
 		return nil, err
 	}
 
-	if !dumpster.IsAvailable {
-		return nil, apperrors.BadRequest("dumpster is not available")
+	responses := make([]dto.DumpsterRevisionResponse, len(revisions))
+	for i, revision := range revisions {
+		responses[i] = revision.ToResponse()
 	}
 
-	days := req.EndDate.Sub(req.StartDate).Hours() / 24
-	if days <= 0 {
-		return nil, apperrors.BadRequest("end date must be after start date")
+	return dto.NewPaginatedResponse(responses, total, req.Page, req.Limit), nil
+}
+
+func (s *dumpsterService) GetRevision(
+	ctx context.Context,
+	userID, id string,
+	isAdmin bool,
+	revision int) (*dto.DumpsterRevisionResponse, error) {
+	if revision < 1 {
+		return nil, apperrors.BadRequest("invalid revision")
 	}
 
-	totalPrice := dumpster.PricePerDay * days
+	dumpster, err := s.getRevisionsDumpster(ctx, userID, id, isAdmin)
+	if err != nil {
+		return nil, err
+	}
 
-	return &dto.BookingResponse{
-		ID:         uuid.New().String(),
-		DumpsterID: dumpsterID,
-		UserID:     userID,
-		StartDate:  req.StartDate,
-		EndDate:    req.EndDate,
-		TotalPrice: totalPrice,
-		Status:     "pending",
-		CreatedAt:  req.StartDate,
-	}, nil
+	rev, err := s.revisionRepo.Get(ctx, dumpster.ID, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	response := rev.ToDetailResponse()
+	return &response, nil
 }
 
-func (s *dumpsterService) applyDumpsterUpdates(dumpster *model.Dumpster, req dto.UpdateDumpsterRequest) {
-	if req.Title != nil {
-		dumpster.Title = *req.Title
+func (s *dumpsterService) getRevisionsDumpster(ctx context.Context, userID, id string, isAdmin bool) (*model.Dumpster, error) {
+	if isAdmin {
+		dumpsterID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, apperrors.BadRequest("invalid dumpster ID")
+		}
+		return s.dumpsterRepo.GetByID(ctx, dumpsterID, repository.WithPreload())
 	}
-	if req.Description != nil {
-		dumpster.Description = *req.Description
+
+	return s.getOwnedDumpster(ctx, userID, id, "view the revisions of")
+}
+
+func (s *dumpsterService) getOwnedDumpster(ctx context.Context, ownerID, id, action string) (*model.Dumpster, error) {
+	dumpsterID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid dumpster ID")
 	}
-	if req.Location != nil {
-		dumpster.Location = *req.Location
+
+	ownerUUID, err := uuid.Parse(ownerID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid owner ID")
 	}
-	if req.Latitude != nil {
-		dumpster.Latitude = *req.Latitude
+
+	dumpster, err := s.dumpsterRepo.GetByID(ctx, dumpsterID)
+	if err != nil {
+		return nil, err
 	}
-	if req.Longitude != nil {
-		dumpster.Longitude = *req.Longitude
+
+	if err := s.ownership.Check(dumpster.OwnerID, ownerUUID, "dumpster", action); err != nil {
+		return nil, err
 	}
-	if req.Address != nil {
-		dumpster.Address = *req.Address
+
+	return dumpster, nil
+}
+
+func (s *dumpsterService) saveChanges(
+	ctx context.Context,
+	previous, dumpster *model.Dumpster,
+	tags *[]string) (*dto.DumpsterResponse, error) {
+	if err := validateDumpster(dumpster); err != nil {
+		return nil, err
 	}
-	if req.City != nil {
-		dumpster.City = *req.City
+
+	id := dumpster.ID.String()
+
+	if err := s.dumpsterRepo.Update(ctx, dumpster); err != nil {
+		s.logger.Error("failed to update dumpster", zap.String("dumpsterId", id), zap.Error(err))
+		return nil, err
 	}
-	if req.State != nil {
-		dumpster.State = *req.State
+	s.invalidateCached(ctx, dumpster.ID)
+
+	if tags != nil {
+		if err := s.dumpsterRepo.ReplaceTags(ctx, dumpster.ID, *tags); err != nil {
+			s.logger.Error("failed to update dumpster tags", zap.String("dumpsterId", id), zap.Error(err))
+			return nil, err
+		}
+		dumpster.SetTags(*tags)
+		s.invalidateCached(ctx, dumpster.ID)
 	}
-	if req.ZipCode != nil {
-		dumpster.ZipCode = *req.ZipCode
+
+	if revision := model.NewDumpsterRevision(previous, dumpster); revision != nil {
+		if err := s.revisionRepo.Create(ctx, revision); err != nil {
+			s.logger.Warn("failed to record dumpster revision", zap.String("dumpsterId", id), zap.Error(err))
+		}
 	}
-	if req.PricePerDay != nil {
-		dumpster.PricePerDay = *req.PricePerDay
+
+	s.recordEditEffects(ctx, previous, dumpster)
+
+	response := dumpster.ToResponse()
+	return &response, nil
+}
+
+func (s *dumpsterService) recordEditEffects(ctx context.Context, previous, dumpster *model.Dumpster) {
+	if dumpster.PricePerDay != previous.PricePerDay {
+		change := model.NewDumpsterPriceChange(dumpster.ID, previous.PricePerDay, dumpster.PricePerDay)
+		if err := s.priceRepo.Create(ctx, change); err != nil {
+			s.logger.Warn("failed to record price change", zap.String("dumpsterId", dumpster.ID.String()), zap.Error(err))
+		}
 	}
-	if req.Size != nil {
-		dumpster.Size = model.DumpsterSize(*req.Size)
+
+	if !previous.IsAvailable && dumpster.IsAvailable {
+		s.alerts.NotifyAvailable(ctx, dumpster)
 	}
-	if req.IsAvailable != nil {
-		dumpster.IsAvailable = *req.IsAvailable
+}
+
+// RevertToRevision restores the listing to the snapshot saved by an earlier
+// revision. The revert is itself recorded as a new revision. It fails with
+// AlreadyExists if the listing is edited while the revert is in progress.
+func (s *dumpsterService) RevertToRevision(ctx context.Context, ownerID, id, revisionID string) (*dto.DumpsterResponse, error) {
+	number, err := strconv.Atoi(revisionID)
+	if err != nil || number < 1 {
+		return nil, apperrors.BadRequest("invalid revision")
 	}
-	if req.Capacity != nil {
-		dumpster.Capacity = *req.Capacity
+
+	dumpster, err := s.getOwnedDumpster(ctx, ownerID, id, "update")
+	if err != nil {
+		return nil, err
 	}
-	if req.Weight != nil {
-		dumpster.Weight = *req.Weight
+
+	target, err := s.revisionRepo.Get(ctx, dumpster.ID, number)
+	if err != nil {
+		return nil, err
 	}
-}
 
-func (s *dumpsterService) parseLocation(location string) []float64 {
-	parts := strings.Split(location, ",")
-	if len(parts) != 2 {
-		return nil
+	previous := *dumpster
+	dumpster.ApplySnapshot(target.Snapshot)
+	if err := validateDumpster(dumpster); err != nil {
+		return nil, err
 	}
 
-	var lat, lng float64
-	if _, err := fmt.Sscanf(strings.TrimSpace(parts[0]), "%f", &lat); err != nil {
-		return nil
+	revision := model.NewDumpsterRevision(&previous, dumpster)
+	if revision == nil {
+		response := dumpster.ToResponse()
+		return &response, nil
 	}
-	if _, err := fmt.Sscanf(strings.TrimSpace(parts[1]), "%f", &lng); err != nil {
-		return nil
+	revision.RevertedFrom = &target.Revision
+
+	if err := s.dumpsterRepo.ApplyRevision(ctx, dumpster, previous.UpdatedAt, revision); err != nil {
+		if !apperrors.Is(err, apperrors.ErrorTypeAlreadyExists) {
+			s.logger.Error("failed to revert dumpster", zap.String("dumpsterId", id), zap.Int("revision", number), zap.Error(err))
+		}
+		return nil, err
 	}
+	s.invalidateCached(ctx, dumpster.ID)
 
-	return []float64{lat, lng}
+	s.recordEditEffects(ctx, &previous, dumpster)
+
+	response := dumpster.ToResponse()
+	return &response, nil
 }
 
-func (s *dumpsterService) buildDumpsterListResponse(
-	dumpsters []*model.Dumpster,
-	total int64,
-	page, limit int) *dto.DumpsterListResponse {
-	page = max(page, 1)
-	limit = max(limit, 1)
+func (s *dumpsterService) Delete(ctx context.Context, ownerID, id string) error {
+	dumpsterID, err := uuid.Parse(id)
+	if err != nil {
+		return apperrors.BadRequest("invalid dumpster ID")
+	}
 
-	responses := make([]dto.DumpsterResponse, len(dumpsters))
-	for i, dumpster := range dumpsters {
-		responses[i] = dumpster.ToResponse()
+	ownerUUID, err := uuid.Parse(ownerID)
+	if err != nil {
+		return apperrors.BadRequest("invalid owner ID")
+	}
+
+	dumpster, err := s.dumpsterRepo.GetByID(ctx, dumpsterID, repository.WithPreload())
+	if err != nil {
+		return err
 	}
 
-	totalPages := int(math.Ceil(float64(total) / float64(limit)))
+	if err := s.ownership.Check(dumpster.OwnerID, ownerUUID, "dumpster", "delete"); err != nil {
+		return err
+	}
 
-	return &dto.DumpsterListResponse{
-		Dumpsters:  responses,
-		Total:      total,
-		Page:       page,
-		Limit:      limit,
-		TotalPages: totalPages,
+	if err := s.dumpsterRepo.Delete(ctx, dumpsterID); err != nil {
+		return err
 	}
+	s.invalidateCached(ctx, dumpsterID)
+
+	return nil
+}
+
+// DeleteAllByOwner removes every listing the caller owns. It refuses to run
+// without an explicit confirmation since there is no undo from the API.
+func (s *dumpsterService) DeleteAllByOwner(
+	ctx context.Context,
+	ownerID string,
+	req dto.DeleteOwnerDumpstersRequest) (*dto.DeleteOwnerDumpstersResponse, error) {
+	ownerUUID, err := uuid.Parse(ownerID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid owner ID")
+	}
+
+	if !req.Confirm {
+		return nil, apperrors.BadRequest("confirm=true is required to delete all of your dumpsters")
+	}
+
+	owned, err := s.dumpsterRepo.ListByOwner(ctx, ownerUUID, repository.WithPreload())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.dumpsterRepo.DeleteAllByOwner(ctx, ownerUUID, req.Force)
+	if err != nil {
+		s.logger.Error("failed to delete owner dumpsters", zap.String("ownerId", ownerID), zap.Error(err))
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(owned))
+	for i, dumpster := range owned {
+		ids[i] = dumpster.ID
+	}
+	s.invalidateCached(ctx, ids...)
+
+	s.logger.Info("deleted owner dumpsters",
+		zap.String("ownerId", ownerID),
+		zap.Int64("deleted", result.Deleted),
+		zap.Int64("skipped", result.Skipped),
+		zap.Int64("cancelledUsages", result.CancelledUsages))
+
+	return result, nil
+}
+
+// FindPotentialDuplicates pairs up the owner's listings that sit at the same
+// address or within duplicateRadiusKm of each other and have similar titles.
+func (s *dumpsterService) FindPotentialDuplicates(ctx context.Context, ownerID string) (*dto.DumpsterDuplicatesResponse, error) {
+	ownerUUID, err := uuid.Parse(ownerID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid owner ID")
+	}
+
+	dumpsters, err := s.dumpsterRepo.ListByOwner(ctx, ownerUUID)
+	if err != nil {
+		s.logger.Error("failed to list owner dumpsters", zap.String("ownerId", ownerID), zap.Error(err))
+		return nil, err
+	}
+
+	pairs := make([]dto.DumpsterDuplicatePair, 0)
+	for i, keep := range dumpsters {
+		for _, candidate := range dumpsters[i+1:] {
+			distance := geo.Distance(
+				geo.Coordinates{Latitude: keep.Latitude, Longitude: keep.Longitude},
+				geo.Coordinates{Latitude: candidate.Latitude, Longitude: candidate.Longitude},
+			)
+			sameAddress := sameListingAddress(keep, candidate)
+			if !sameAddress && distance > duplicateRadiusKm {
+				continue
+			}
+
+			similarity := titleSimilarity(keep.Title, candidate.Title)
+			if similarity < duplicateTitleSimilarity {
+				continue
+			}
+
+			pairs = append(pairs, dto.DumpsterDuplicatePair{
+				Keep:            keep.ToResponse(),
+				Duplicate:       candidate.ToResponse(),
+				DistanceKm:      math.Round(distance*1000) / 1000,
+				SameAddress:     sameAddress,
+				TitleSimilarity: math.Round(similarity*100) / 100,
+			})
+		}
+	}
+
+	return &dto.DumpsterDuplicatesResponse{Pairs: pairs}, nil
+}
+
+// GetNeedsAttention lists the owner's listings that trip any attention
+// threshold, most urgent first, each with the reasons it was picked.
+func (s *dumpsterService) GetNeedsAttention(ctx context.Context, ownerID string) (*dto.DumpsterNeedsAttentionResponse, error) {
+	ownerUUID, err := uuid.Parse(ownerID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid owner ID")
+	}
+
+	thresholds := s.cfg.Attention
+	now := time.Now()
+
+	dumpsters, err := s.dumpsterRepo.ListByOwner(ctx, ownerUUID)
+	if err != nil {
+		s.logger.Error("failed to list owner dumpsters", zap.String("ownerId", ownerID), zap.Error(err))
+		return nil, err
+	}
+
+	var openFlags, negativeReviews map[uuid.UUID]int64
+	var lastStarts map[uuid.UUID]time.Time
+
+	if thresholds.OpenFlags > 0 {
+		if openFlags, err = s.flagRepo.CountOpenByOwner(ctx, ownerUUID); err != nil {
+			return nil, err
+		}
+	}
+
+	if thresholds.NegativeReviews > 0 {
+		since := now.Add(-thresholds.NegativeReviewWindow)
+		if negativeReviews, err = s.reviewRepo.CountNegativeByOwnerSince(ctx, ownerUUID, since); err != nil {
+			return nil, err
+		}
+	}
+
+	if thresholds.Inactivity > 0 {
+		if lastStarts, err = s.usageRepo.GetLastStartByOwner(ctx, ownerUUID); err != nil {
+			return nil, err
+		}
+	}
+
+	items := make([]dto.DumpsterAttentionItem, 0)
+	for _, dumpster := range dumpsters {
+		var reasons []dto.DumpsterAttentionReason
+		score := 0
+
+		if count := openFlags[dumpster.ID]; thresholds.OpenFlags > 0 && count >= int64(thresholds.OpenFlags) {
+			reasons = append(reasons, dto.DumpsterAttentionReason{
+				Code:    attentionOpenFlags,
+				Message: fmt.Sprintf("%d open flag(s) awaiting review", count),
+			})
+			score += attentionOpenFlagsWeight
+		}
+
+		if count := negativeReviews[dumpster.ID]; thresholds.NegativeReviews > 0 && count >= int64(thresholds.NegativeReviews) {
+			reasons = append(reasons, dto.DumpsterAttentionReason{
+				Code: attentionNegativeReviews,
+				Message: fmt.Sprintf("%d negative review(s) in the last %d days",
+					count, int(thresholds.NegativeReviewWindow.Hours()/24)),
+			})
+			score += attentionNegativeReviewsWeight
+		}
+
+		if thresholds.MinRating > 0 && dumpster.ReviewCount > 0 && dumpster.Rating < thresholds.MinRating {
+			reasons = append(reasons, dto.DumpsterAttentionReason{
+				Code:    attentionLowRating,
+				Message: fmt.Sprintf("rated %.1f, below %.1f", dumpster.Rating, thresholds.MinRating),
+			})
+			score += attentionLowRatingWeight
+		}
+
+		if thresholds.Inactivity > 0 {
+			lastActive, used := lastStarts[dumpster.ID]
+			if !used {
+				lastActive = dumpster.CreatedAt
+			}
+			if idle := now.Sub(lastActive); idle >= thresholds.Inactivity {
+				message := fmt.Sprintf("no usage in %d days", int(idle.Hours()/24))
+				if !used {
+					message = fmt.Sprintf("never used since it was listed %d days ago", int(idle.Hours()/24))
+				}
+				reasons = append(reasons, dto.DumpsterAttentionReason{Code: attentionInactive, Message: message})
+				score += attentionInactiveWeight
+			}
+		}
+
+		if len(reasons) == 0 {
+			continue
+		}
+
+		items = append(items, dto.DumpsterAttentionItem{
+			Dumpster: dumpster.ToResponse(),
+			Reasons:  reasons,
+			Score:    score,
+		})
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Score > items[j].Score
+	})
+
+	return &dto.DumpsterNeedsAttentionResponse{Items: items}, nil
+}
+
+// MergeDuplicates moves the duplicate's reviews, usages and bookings onto the
+// kept listing and soft-deletes the duplicate. Both must belong to the caller.
+func (s *dumpsterService) MergeDuplicates(
+	ctx context.Context,
+	ownerID string,
+	req dto.MergeDumpstersRequest) (*dto.DumpsterMergeResponse, error) {
+	ownerUUID, err := uuid.Parse(ownerID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid owner ID")
+	}
+
+	keepID, err := uuid.Parse(req.KeepID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid keepId")
+	}
+
+	duplicateID, err := uuid.Parse(req.DuplicateID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid duplicateId")
+	}
+
+	if keepID == duplicateID {
+		return nil, apperrors.BadRequest("keepId and duplicateId must differ")
+	}
+
+	for _, id := range []uuid.UUID{keepID, duplicateID} {
+		dumpster, err := s.dumpsterRepo.GetByID(ctx, id, repository.WithPreload())
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.ownership.Check(dumpster.OwnerID, ownerUUID, "dumpster", "merge"); err != nil {
+			return nil, err
+		}
+	}
+
+	result, err := s.dumpsterRepo.Merge(ctx, keepID, duplicateID)
+	if err != nil {
+		s.logger.Error("failed to merge dumpsters",
+			zap.String("keepId", req.KeepID),
+			zap.String("duplicateId", req.DuplicateID),
+			zap.Error(err))
+		return nil, err
+	}
+	s.invalidateCached(ctx, keepID, duplicateID)
+
+	kept, err := s.dumpsterRepo.GetByID(ctx, keepID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("merged duplicate dumpster",
+		zap.String("keepId", req.KeepID),
+		zap.String("duplicateId", req.DuplicateID),
+		zap.Int64("reviewsMoved", result.ReviewsMoved),
+		zap.Int64("usagesMoved", result.UsagesMoved),
+		zap.Int64("bookingsMoved", result.BookingsMoved))
+
+	return &dto.DumpsterMergeResponse{
+		Dumpster:            kept.ToResponse(),
+		MergedID:            duplicateID.String(),
+		DumpsterMergeResult: *result,
+	}, nil
+}
+
+func (s *dumpsterService) List(ctx context.Context, req dto.DumpsterListRequest) (*dto.DumpsterListResponse, error) {
+	if err := validateCreatedRange(req.CreatedFrom, req.CreatedTo); err != nil {
+		return nil, err
+	}
+
+	if req.Location != "" {
+		coords := s.parseLocation(req.Location)
+		if len(coords) == 2 {
+			nearbyReq := dto.NearbyDumpstersRequest{
+				Latitude:     coords[0],
+				Longitude:    coords[1],
+				MaxDistance:  req.MaxDistance,
+				Limit:        req.Limit,
+				MaxPrice:     req.MaxPrice,
+				Size:         req.Size,
+				AvailableNow: req.AvailableNow,
+			}
+			dumpsters, err := s.dumpsterRepo.FindNearby(ctx, nearbyReq)
+			if err != nil {
+				s.logger.Error("failed to find nearby dumpsters", zap.Error(err))
+				return nil, err
+			}
+			return s.buildDumpsterListResponse(dumpsters, int64(len(dumpsters)), req.Page, req.Limit), nil
+		}
+	}
+
+	dumpsters, total, err := s.dumpsterRepo.List(ctx, req)
+	if err != nil {
+		s.logger.Error("failed to list dumpsters", zap.Error(err))
+		return nil, err
+	}
+
+	return s.buildDumpsterListResponse(dumpsters, total, req.Page, req.Limit), nil
+}
+
+func (s *dumpsterService) Search(ctx context.Context, req dto.DumpsterSearchRequest) (*dto.DumpsterListResponse, error) {
+	if err := validateCreatedRange(req.CreatedFrom, req.CreatedTo); err != nil {
+		return nil, err
+	}
+
+	dumpsters, total, err := s.dumpsterRepo.Search(ctx, req)
+	if err != nil {
+		s.logger.Error("failed to search dumpsters", zap.Error(err))
+		return nil, err
+	}
+
+	return s.buildDumpsterListResponse(dumpsters, total, req.Page, req.Limit), nil
+}
+
+// ListUnreviewed pages through listings that have no reviews yet, for
+// admins targeting review requests.
+func (s *dumpsterService) ListUnreviewed(
+	ctx context.Context,
+	req dto.UnreviewedDumpsterListRequest) (*dto.DumpsterListResponse, error) {
+	var ownerUUID *uuid.UUID
+	if req.OwnerID != "" {
+		parsed, err := uuid.Parse(req.OwnerID)
+		if err != nil {
+			return nil, apperrors.BadRequest("invalid owner ID")
+		}
+		ownerUUID = &parsed
+	}
+
+	if err := validateCreatedRange(req.CreatedFrom, req.CreatedTo); err != nil {
+		return nil, err
+	}
+
+	dumpsters, total, err := s.dumpsterRepo.ListUnreviewed(ctx, ownerUUID, req)
+	if err != nil {
+		s.logger.Error("failed to list unreviewed dumpsters", zap.Error(err))
+		return nil, err
+	}
+
+	return s.buildDumpsterListResponse(dumpsters, total, req.Page, req.Limit), nil
+}
+
+func validateCreatedRange(from, to *time.Time) error {
+	if from != nil && to != nil && !from.Before(*to) {
+		return apperrors.BadRequest("createdFrom must be before createdTo")
+	}
+	return nil
+}
+
+func (s *dumpsterService) FindNearby(ctx context.Context, req dto.NearbyDumpstersRequest) ([]dto.DumpsterResponse, error) {
+	dumpsters, err := s.dumpsterRepo.FindNearby(ctx, req)
+	if err != nil {
+		s.logger.Error("failed to find nearby dumpsters", zap.Error(err))
+		return nil, err
+	}
+
+	responses := make([]dto.DumpsterResponse, len(dumpsters))
+	for i, dumpster := range dumpsters {
+		responses[i] = dumpster.ToResponse()
+	}
+
+	return responses, nil
+}
+
+func (s *dumpsterService) CheckAvailability(ctx context.Context, id string) (*dto.AvailabilityResponse, error) {
+	dumpsterID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid dumpster ID")
+	}
+
+	dumpster, err := s.dumpsterRepo.GetByID(ctx, dumpsterID, repository.WithPreload())
+	if err != nil {
+		return nil, err
+	}
+
+	inUse, booked := false, false
+	if dumpster.IsAvailable && !dumpster.IsSnoozed() && !dumpster.IsUnpublished() {
+		if s.cfg.AvailabilityTracksUsage {
+			inUse, err = s.usageRepo.HasActiveUsage(ctx, dumpsterID)
+			if err != nil {
+				s.logger.Error("failed to check active usage", zap.String("dumpsterId", id), zap.Error(err))
+				return nil, err
+			}
+		}
+
+		if !inUse {
+			bookedIDs, err := s.bookingRepo.GetBookedDumpsterIDs(ctx, []uuid.UUID{dumpsterID}, time.Now())
+			if err != nil {
+				s.logger.Error("failed to check current bookings", zap.String("dumpsterId", id), zap.Error(err))
+				return nil, err
+			}
+			booked = bookedIDs[dumpsterID]
+		}
+	}
+
+	response := availabilityOf(dumpster, inUse, booked)
+	return &response, nil
+}
+
+// CheckAvailabilityBatch checks up to maxAvailabilityBatch dumpsters, keyed
+// by ID. IDs that match no dumpster are left out.
+func (s *dumpsterService) CheckAvailabilityBatch(ctx context.Context, ids []string) (map[string]dto.AvailabilityResponse, error) {
+	dumpsterIDs := make([]uuid.UUID, 0, len(ids))
+	seen := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			continue
+		}
+
+		dumpsterID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, apperrors.BadRequest("invalid dumpster ID " + id)
+		}
+		if !seen[dumpsterID] {
+			seen[dumpsterID] = true
+			dumpsterIDs = append(dumpsterIDs, dumpsterID)
+		}
+	}
+
+	if len(dumpsterIDs) == 0 {
+		return nil, apperrors.BadRequest("ids is required")
+	}
+
+	if len(dumpsterIDs) > maxAvailabilityBatch {
+		return nil, apperrors.BadRequest(fmt.Sprintf("at most %d dumpsters can be checked at once", maxAvailabilityBatch))
+	}
+
+	dumpsters, err := s.dumpsterRepo.GetByIDs(ctx, dumpsterIDs, repository.WithPreload())
+	if err != nil {
+		s.logger.Error("failed to get dumpsters", zap.Error(err))
+		return nil, err
+	}
+
+	inUse := map[uuid.UUID]bool{}
+	if s.cfg.AvailabilityTracksUsage {
+		inUse, err = s.usageRepo.GetInUseDumpsterIDs(ctx, dumpsterIDs)
+		if err != nil {
+			s.logger.Error("failed to check active usages", zap.Error(err))
+			return nil, err
+		}
+	}
+
+	booked, err := s.bookingRepo.GetBookedDumpsterIDs(ctx, dumpsterIDs, time.Now())
+	if err != nil {
+		s.logger.Error("failed to check current bookings", zap.Error(err))
+		return nil, err
+	}
+
+	responses := make(map[string]dto.AvailabilityResponse, len(dumpsters))
+	for _, dumpster := range dumpsters {
+		responses[dumpster.ID.String()] = availabilityOf(dumpster, inUse[dumpster.ID], booked[dumpster.ID])
+	}
+
+	return responses, nil
+}
+
+func availabilityOf(dumpster *model.Dumpster, inUse, booked bool) dto.AvailabilityResponse {
+	response := dto.AvailabilityResponse{
+		DumpsterID:  dumpster.ID.String(),
+		IsAvailable: false,
+	}
+
+	switch {
+	case dumpster.IsUnpublished():
+		response.Reason = unavailableUnpublished
+		response.Message = "Dumpster is not listed right now"
+	case !dumpster.IsAvailable:
+		response.Reason = unavailableOwnerDisabled
+		response.Message = "Dumpster is currently unavailable"
+	case dumpster.IsSnoozed():
+		response.Reason = unavailableSnoozed
+		response.UnavailableUntil = dumpster.UnavailableUntil
+		response.Message = "Dumpster is paused until " + dumpster.UnavailableUntil.Format(time.RFC3339)
+	case inUse:
+		response.Reason = unavailableInUse
+		response.InUse = true
+		response.Message = "Dumpster is currently in use"
+	case booked:
+		response.Reason = unavailableBooked
+		response.Message = "Dumpster is booked for the current dates"
+	default:
+		response.IsAvailable = true
+	}
+
+	return response
+}
+
+// Quote prices a rental the way BookDumpster would, without requiring a
+// signed-in user or reserving anything. Dates that could not be booked right
+// now are still priced, with Available false and the reason in Message.
+func (s *dumpsterService) Quote(
+	ctx context.Context,
+	id string,
+	req dto.DumpsterQuoteRequest) (*dto.DumpsterQuoteResponse, error) {
+	dumpsterID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid dumpster ID")
+	}
+
+	if req.StartDate.IsZero() || req.EndDate.IsZero() {
+		return nil, apperrors.BadRequest("start and end dates are required")
+	}
+
+	days := req.EndDate.Sub(req.StartDate).Hours() / 24
+	if days <= 0 {
+		return nil, apperrors.BadRequest("end date must be after start date")
+	}
+
+	dumpster, err := s.dumpsterRepo.GetByID(ctx, dumpsterID, repository.WithPreload())
+	if err != nil {
+		return nil, err
+	}
+
+	if dumpster.IsUnpublished() {
+		return nil, apperrors.NotFound("dumpster not found")
+	}
+
+	subtotal, err := s.pricing.Quote(ctx, dumpster, req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	taxResult, err := s.taxCalc.Calculate(ctx, dumpsterTaxLocation(dumpster), subtotal)
+	if err != nil {
+		s.logger.Error("failed to calculate tax for quote", zap.String("dumpsterId", id), zap.Error(err))
+		return nil, apperrors.Internal("failed to calculate tax", err)
+	}
+
+	total := subtotal + taxResult.Amount
+	response := &dto.DumpsterQuoteResponse{
+		DumpsterID:     dumpster.ID.String(),
+		Title:          dumpster.Title,
+		Size:           string(dumpster.Size),
+		City:           dumpster.City,
+		State:          dumpster.State,
+		StartDate:      req.StartDate,
+		EndDate:        req.EndDate,
+		Days:           math.Round(days*100) / 100,
+		Subtotal:       subtotal,
+		TaxRate:        taxResult.Rate,
+		Tax:            taxResult.Amount,
+		Total:          total,
+		TotalFormatted: money.Format(total),
+		Currency:       money.Currency(),
+		Available:      true,
+	}
+
+	switch {
+	case !dumpster.IsAvailable:
+		response.Available = false
+		response.Message = "Dumpster is currently unavailable"
+	case dumpster.UnavailableUntil != nil && req.StartDate.Before(*dumpster.UnavailableUntil):
+		response.Available = false
+		response.Message = "Dumpster is not available until " + dumpster.UnavailableUntil.Format(time.RFC3339)
+	default:
+		overlaps, err := s.bookingRepo.HasOverlap(ctx, dumpsterID, req.StartDate, req.EndDate)
+		if err != nil {
+			return nil, err
+		}
+		if overlaps {
+			response.Available = false
+			response.Message = "Dumpster is already booked for the requested dates"
+		} else if s.isHeld(ctx, dumpsterID, uuid.Nil, req.StartDate, req.EndDate) {
+			response.Available = false
+			response.Message = "Dumpster is on hold for the requested dates"
+		}
+	}
+
+	return response, nil
+}
+
+func (s *dumpsterService) BookDumpster(
+	ctx context.Context,
+	userID, dumpsterID string,
+	req dto.BookDumpsterRequest) (*dto.BookingResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	dumpsterUUID, err := uuid.Parse(dumpsterID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid dumpster ID")
+	}
+
+	dumpster, err := s.dumpsterRepo.GetByID(ctx, dumpsterUUID, repository.WithPreload())
+	if err != nil {
+		return nil, err
+	}
+
+	if dumpster.IsUnpublished() {
+		return nil, apperrors.NotFound("dumpster not found")
+	}
+
+	if !dumpster.IsAvailable {
+		return nil, apperrors.BadRequest("dumpster is not available")
+	}
+
+	if dumpster.UnavailableUntil != nil && req.StartDate.Before(*dumpster.UnavailableUntil) {
+		return nil, apperrors.BadRequest("dumpster is not available until " + dumpster.UnavailableUntil.Format(time.RFC3339))
+	}
+
+	if err := checkNotBlocked(ctx, s.blockRepo, dumpster, userUUID); err != nil {
+		return nil, err
+	}
+
+	days := req.EndDate.Sub(req.StartDate).Hours() / 24
+	if days <= 0 {
+		return nil, apperrors.BadRequest("end date must be after start date")
+	}
+
+	if s.isHeld(ctx, dumpsterUUID, userUUID, req.StartDate, req.EndDate) {
+		return nil, apperrors.AlreadyExists("dumpster is on hold for the requested dates")
+	}
+
+	subtotal, err := s.pricing.Quote(ctx, dumpster, req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	taxResult, err := s.taxCalc.Calculate(ctx, dumpsterTaxLocation(dumpster), subtotal)
+	if err != nil {
+		s.logger.Error("failed to calculate tax", zap.String("dumpsterId", dumpsterID), zap.Error(err))
+		return nil, apperrors.Internal("failed to calculate tax", err)
+	}
+
+	booking := model.NewBookingFromDTO(userUUID, dumpsterUUID, req)
+	booking.Subtotal = subtotal
+	booking.TaxRate = taxResult.Rate
+	booking.Tax = taxResult.Amount
+	booking.TotalPrice = subtotal + taxResult.Amount
+
+	if err := s.bookingRepo.Create(ctx, booking); err != nil {
+		if apperrors.Is(err, apperrors.ErrorTypeNotFound) || apperrors.Is(err, apperrors.ErrorTypeAlreadyExists) {
+			return nil, err
+		}
+		s.logger.Error("failed to create booking", zap.String("dumpsterId", dumpsterID), zap.String("userId", userID), zap.Error(err))
+		return nil, err
+	}
+
+	if req.HoldToken != "" {
+		if _, err := s.holds.Consume(ctx, dumpsterUUID, userUUID, req.HoldToken); err != nil {
+			s.logger.Warn("failed to consume booking hold", zap.String("dumpsterId", dumpsterID), zap.String("userId", userID), zap.Error(err))
+		}
+	}
+
+	response := booking.ToResponse()
+	return &response, nil
+}
+
+// Hold reserves the dates for the user for HoldTTL so they can finish
+// booking without someone else taking them. Holding dates the user already
+// holds is allowed and simply adds another hold.
+func (s *dumpsterService) Hold(
+	ctx context.Context,
+	userID, dumpsterID string,
+	req dto.HoldDumpsterRequest) (*dto.DumpsterHoldResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	dumpsterUUID, err := uuid.Parse(dumpsterID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid dumpster ID")
+	}
+
+	if req.StartDate.IsZero() || req.EndDate.IsZero() {
+		return nil, apperrors.BadRequest("start and end dates are required")
+	}
+
+	if !req.EndDate.After(req.StartDate) {
+		return nil, apperrors.BadRequest("end date must be after start date")
+	}
+
+	dumpster, err := s.dumpsterRepo.GetByID(ctx, dumpsterUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if dumpster.IsUnpublished() {
+		return nil, apperrors.NotFound("dumpster not found")
+	}
+
+	if !dumpster.IsAvailable {
+		return nil, apperrors.BadRequest("dumpster is not available")
+	}
+
+	if dumpster.UnavailableUntil != nil && req.StartDate.Before(*dumpster.UnavailableUntil) {
+		return nil, apperrors.BadRequest("dumpster is not available until " + dumpster.UnavailableUntil.Format(time.RFC3339))
+	}
+
+	if err := checkNotBlocked(ctx, s.blockRepo, dumpster, userUUID); err != nil {
+		return nil, err
+	}
+
+	overlaps, err := s.bookingRepo.HasOverlap(ctx, dumpsterUUID, req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	if overlaps {
+		return nil, apperrors.AlreadyExists("dumpster is already booked for the requested dates")
+	}
+
+	token, err := newShareSecret()
+	if err != nil {
+		return nil, apperrors.Internal("failed to generate hold token", err)
+	}
+
+	hold := &cache.BookingHold{
+		Token:      token,
+		DumpsterID: dumpsterUUID,
+		UserID:     userUUID,
+		Start:      req.StartDate,
+		End:        req.EndDate,
+		ExpiresAt:  time.Now().Add(s.cfg.HoldTTL),
+	}
+
+	placed, err := s.holds.Place(ctx, hold)
+	if err != nil {
+		s.logger.Error("failed to place booking hold", zap.String("dumpsterId", dumpsterID), zap.String("userId", userID), zap.Error(err))
+		return nil, apperrors.Internal("failed to place hold", err)
+	}
+
+	if !placed {
+		return nil, apperrors.AlreadyExists("dumpster is on hold for the requested dates")
+	}
+
+	return &dto.DumpsterHoldResponse{
+		Token:      hold.Token,
+		DumpsterID: dumpsterUUID.String(),
+		StartDate:  hold.Start,
+		EndDate:    hold.End,
+		ExpiresAt:  hold.ExpiresAt,
+	}, nil
+}
+
+func (s *dumpsterService) isHeld(ctx context.Context, dumpsterID, userID uuid.UUID, start, end time.Time) bool {
+	held, err := s.holds.HasConflict(ctx, dumpsterID, userID, start, end)
+	if err != nil {
+		s.logger.Warn("failed to check booking holds", zap.String("dumpsterId", dumpsterID.String()), zap.Error(err))
+		return false
+	}
+	return held
+}
+
+func (s *dumpsterService) Snooze(
+	ctx context.Context,
+	ownerID, id string,
+	req dto.SnoozeDumpsterRequest) (*dto.DumpsterResponse, error) {
+	if !req.Until.After(time.Now()) {
+		return nil, apperrors.BadRequest("snooze date must be in the future")
+	}
+
+	return s.setUnavailableUntil(ctx, ownerID, id, &req.Until)
+}
+
+func (s *dumpsterService) Unsnooze(ctx context.Context, ownerID, id string) (*dto.DumpsterResponse, error) {
+	return s.setUnavailableUntil(ctx, ownerID, id, nil)
+}
+
+func (s *dumpsterService) setUnavailableUntil(
+	ctx context.Context,
+	ownerID, id string,
+	until *time.Time) (*dto.DumpsterResponse, error) {
+	dumpsterID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid dumpster ID")
+	}
+
+	ownerUUID, err := uuid.Parse(ownerID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid owner ID")
+	}
+
+	dumpster, err := s.dumpsterRepo.GetByID(ctx, dumpsterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ownership.Check(dumpster.OwnerID, ownerUUID, "dumpster", "update"); err != nil {
+		return nil, err
+	}
+
+	dumpster.UnavailableUntil = until
+
+	if err := s.dumpsterRepo.Update(ctx, dumpster); err != nil {
+		s.logger.Error("failed to update dumpster snooze", zap.String("dumpsterId", id), zap.Error(err))
+		return nil, err
+	}
+	s.invalidateCached(ctx, dumpster.ID)
+
+	response := dumpster.ToResponse()
+	return &response, nil
+}
+
+// CreateShareLink signs an expiring token that resolves to the listing
+// whether or not it is published. Tokens are signed with the dumpster's
+// share secret, created on first use.
+func (s *dumpsterService) CreateShareLink(
+	ctx context.Context,
+	ownerID, id string,
+	ttl time.Duration) (*dto.ShareLinkResponse, error) {
+	dumpster, err := s.getOwnedDumpster(ctx, ownerID, id, "share")
+	if err != nil {
+		return nil, err
+	}
+
+	if ttl <= 0 || ttl > MaxShareLinkTTL {
+		return nil, apperrors.BadRequest("share link lifetime must be positive and at most 30 days")
+	}
+
+	candidate, err := newShareSecret()
+	if err != nil {
+		return nil, apperrors.Internal("failed to generate share secret", err)
+	}
+
+	secret, err := s.dumpsterRepo.EnsureShareSecret(ctx, dumpster.ID, candidate)
+	if err != nil {
+		s.logger.Error("failed to ensure share secret", zap.String("dumpsterId", id), zap.Error(err))
+		return nil, err
+	}
+
+	expiresAt := time.Now().Add(ttl).Truncate(time.Second)
+	token := signShareToken(dumpster.ID, expiresAt, secret)
+
+	return &dto.ShareLinkResponse{
+		Token:     token,
+		Path:      "/api/v1/dumpsters/shared/" + token,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// RevokeShareLinks rotates the dumpster's share secret, invalidating every
+// share link issued so far.
+func (s *dumpsterService) RevokeShareLinks(ctx context.Context, ownerID, id string) error {
+	dumpster, err := s.getOwnedDumpster(ctx, ownerID, id, "share")
+	if err != nil {
+		return err
+	}
+
+	secret, err := newShareSecret()
+	if err != nil {
+		return apperrors.Internal("failed to generate share secret", err)
+	}
+
+	return s.dumpsterRepo.SetShareSecret(ctx, dumpster.ID, secret)
+}
+
+// GetShared resolves a share token to its listing. Malformed, expired and
+// revoked tokens all look the same to the caller.
+func (s *dumpsterService) GetShared(ctx context.Context, token string) (*dto.DumpsterResponse, error) {
+	invalid := apperrors.NotFound("share link is invalid or has expired")
+
+	dumpsterID, expiresAt, ok := parseShareToken(token)
+	if !ok || time.Now().After(expiresAt) {
+		return nil, invalid
+	}
+
+	dumpster, err := s.dumpsterRepo.GetByID(ctx, dumpsterID)
+	if err != nil {
+		if apperrors.Is(err, apperrors.ErrorTypeNotFound) {
+			return nil, invalid
+		}
+		return nil, err
+	}
+
+	if dumpster.ShareSecret == nil || !hmac.Equal([]byte(token), []byte(signShareToken(dumpsterID, expiresAt, *dumpster.ShareSecret))) {
+		return nil, invalid
+	}
+
+	response := dumpster.ToResponse()
+	return &response, nil
+}
+
+func (s *dumpsterService) ListFeatured(ctx context.Context) ([]dto.DumpsterResponse, error) {
+	dumpsters, err := s.dumpsterRepo.ListFeatured(ctx, s.cfg.FeaturedLimit)
+	if err != nil {
+		s.logger.Error("failed to list featured dumpsters", zap.Error(err))
+		return nil, err
+	}
+
+	responses := make([]dto.DumpsterResponse, len(dumpsters))
+	for i, dumpster := range dumpsters {
+		responses[i] = dumpster.ToResponse()
+	}
+
+	return responses, nil
+}
+
+func (s *dumpsterService) SetFeatured(ctx context.Context, id string, req dto.SetFeaturedRequest) (*dto.DumpsterResponse, error) {
+	dumpsterID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid dumpster ID")
+	}
+
+	if err := s.dumpsterRepo.SetFeatured(ctx, dumpsterID, req.Featured); err != nil {
+		return nil, err
+	}
+	s.invalidateCached(ctx, dumpsterID)
+
+	dumpster, err := s.dumpsterRepo.GetByID(ctx, dumpsterID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("dumpster featured flag changed", zap.String("dumpsterId", id), zap.Bool("featured", req.Featured))
+
+	response := dumpster.ToResponse()
+	return &response, nil
+}
+
+// SuggestPrice proposes the interquartile range of daily prices charged by
+// nearby listings of the same size, with the median as the suggestion.
+func (s *dumpsterService) SuggestPrice(
+	ctx context.Context,
+	lat, lng float64,
+	size string) (*dto.PriceSuggestionResponse, error) {
+	if lat < -90 || lat > 90 || lng < -180 || lng > 180 {
+		return nil, apperrors.BadRequest("invalid coordinates")
+	}
+
+	dumpsterSize := model.DumpsterSize(size)
+	if !dumpsterSize.IsValid() {
+		return nil, apperrors.BadRequest("size must be one of small, medium, large, extraLarge")
+	}
+
+	stats, err := s.dumpsterRepo.GetNearbyPriceStats(ctx, lat, lng, priceSuggestionRadiusKm, dumpsterSize)
+	if err != nil {
+		s.logger.Error("failed to get nearby price stats", zap.Error(err))
+		return nil, err
+	}
+
+	response := &dto.PriceSuggestionResponse{
+		Size:        size,
+		RadiusKm:    priceSuggestionRadiusKm,
+		Comparables: stats.Count,
+		Currency:    money.Currency(),
+	}
+
+	if stats.Count < priceSuggestionMinComparables {
+		return response, nil
+	}
+
+	response.SufficientData = true
+	response.Low = &stats.Low
+	response.Suggested = &stats.Median
+	response.High = &stats.High
+
+	return response, nil
+}
+
+// GetDistance reports the straight-line distance from the given point to the
+// dumpster, plus driving distance and ETA when the routing provider has a
+// route. Routing failures are logged and never fail the request.
+func (s *dumpsterService) GetDistance(
+	ctx context.Context,
+	id string,
+	lat, lng float64) (*dto.DumpsterDistanceResponse, error) {
+	dumpsterID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid dumpster ID")
+	}
+
+	if lat < -90 || lat > 90 || lng < -180 || lng > 180 {
+		return nil, apperrors.BadRequest("invalid coordinates")
+	}
+
+	dumpster, err := s.dumpsterRepo.GetByID(ctx, dumpsterID, repository.WithPreload())
+	if err != nil {
+		return nil, err
+	}
+
+	from := geo.Coordinates{Latitude: lat, Longitude: lng}
+	to := geo.Coordinates{Latitude: dumpster.Latitude, Longitude: dumpster.Longitude}
+
+	response := &dto.DumpsterDistanceResponse{
+		DumpsterID: dumpster.ID.String(),
+		DistanceKm: math.Round(geo.Distance(from, to)*100) / 100,
+	}
+
+	route, err := s.routes.Route(ctx, from, to)
+	switch {
+	case err == nil:
+		drivingKm := math.Round(route.DistanceKm*100) / 100
+		etaSeconds := int64(route.Duration.Seconds())
+		response.DrivingDistanceKm = &drivingKm
+		response.EtaSeconds = &etaSeconds
+	case !errors.Is(err, geo.ErrNoRoute):
+		s.logger.Warn("failed to estimate route", zap.String("dumpsterId", id), zap.Error(err))
+	}
+
+	return response, nil
+}
+
+// ValidateAddress tidies up the address and asks the geocoder for its
+// coordinates. An address the geocoder doesn't recognize is reported with
+// Found unset rather than as an error.
+func (s *dumpsterService) ValidateAddress(
+	ctx context.Context,
+	req dto.ValidateAddressRequest) (*dto.AddressValidationResponse, error) {
+	address := dto.NormalizedAddress{
+		Address: strings.Join(strings.Fields(req.Address), " "),
+		City:    strings.Join(strings.Fields(req.City), " "),
+		State:   strings.ToUpper(strings.TrimSpace(req.State)),
+		ZipCode: strings.TrimSpace(req.ZipCode),
+	}
+
+	response := &dto.AddressValidationResponse{Address: address}
+
+	coords, err := s.geocoder.Geocode(ctx, geo.Address{
+		Street:  address.Address,
+		City:    address.City,
+		State:   address.State,
+		ZipCode: address.ZipCode,
+	})
+	switch {
+	case err == nil:
+		response.Found = true
+		response.Latitude = &coords.Latitude
+		response.Longitude = &coords.Longitude
+	case !errors.Is(err, geo.ErrNotFound):
+		s.logger.Error("failed to geocode address", zap.Error(err))
+		return nil, apperrors.Internal("failed to validate address", err)
+	}
+
+	return response, nil
+}
+
+func (s *dumpsterService) GetDensity(
+	ctx context.Context,
+	req dto.DumpsterDensityRequest) (*dto.DumpsterDensityResponse, error) {
+	if req.MinLat >= req.MaxLat || req.MinLng >= req.MaxLng {
+		return nil, apperrors.BadRequest("bounds must have min values lower than max values")
+	}
+
+	if req.GridSize <= 0 {
+		req.GridSize = defaultDensityGridSize
+	}
+
+	if req.GridSize > maxDensityGridSize {
+		return nil, apperrors.BadRequest(fmt.Sprintf("grid size must not exceed %d", maxDensityGridSize))
+	}
+
+	cells, err := s.dumpsterRepo.GetDensity(ctx, req)
+	if err != nil {
+		s.logger.Error("failed to get dumpster density", zap.Error(err))
+		return nil, err
+	}
+
+	cellHeight := (req.MaxLat - req.MinLat) / float64(req.GridSize)
+	cellWidth := (req.MaxLng - req.MinLng) / float64(req.GridSize)
+
+	response := &dto.DumpsterDensityResponse{
+		MinLat:     req.MinLat,
+		MinLng:     req.MinLng,
+		MaxLat:     req.MaxLat,
+		MaxLng:     req.MaxLng,
+		GridSize:   req.GridSize,
+		CellHeight: cellHeight,
+		CellWidth:  cellWidth,
+		Cells:      make([]dto.DensityCell, len(cells)),
+	}
+
+	for i, cell := range cells {
+		cell.CenterLat = req.MinLat + (float64(cell.Row)-0.5)*cellHeight
+		cell.CenterLng = req.MinLng + (float64(cell.Col)-0.5)*cellWidth
+		response.Cells[i] = cell
+		response.Total += cell.Count
+	}
+
+	return response, nil
+}
+
+// GetFrequentlyPairedWith lists other published listings used or booked by
+// the same people as this one, those sharing the most users first.
+func (s *dumpsterService) GetFrequentlyPairedWith(
+	ctx context.Context,
+	id string,
+	limit int) ([]dto.PairedDumpsterResponse, error) {
+	dumpsterID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid dumpster ID")
+	}
+
+	if limit < 0 || limit > maxPairedLimit {
+		return nil, apperrors.BadRequest(fmt.Sprintf("limit must be between 1 and %d", maxPairedLimit))
+	}
+	if limit == 0 {
+		limit = defaultPairedLimit
+	}
+
+	if _, err := s.dumpsterRepo.GetByID(ctx, dumpsterID); err != nil {
+		return nil, err
+	}
+
+	counts, err := s.dumpsterRepo.CountPairedWith(ctx, dumpsterID, pairedSourceUsers, limit)
+	if err != nil {
+		s.logger.Error("failed to count paired dumpsters", zap.String("dumpsterId", id), zap.Error(err))
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(counts))
+	for _, count := range counts {
+		if pairedID, err := uuid.Parse(count.DumpsterID); err == nil {
+			ids = append(ids, pairedID)
+		}
+	}
+
+	dumpsters, err := s.dumpsterRepo.GetByIDs(ctx, ids, repository.WithPreload())
+	if err != nil {
+		s.logger.Error("failed to load paired dumpsters", zap.String("dumpsterId", id), zap.Error(err))
+		return nil, err
+	}
+
+	byID := make(map[string]*model.Dumpster, len(dumpsters))
+	for _, dumpster := range dumpsters {
+		byID[dumpster.ID.String()] = dumpster
+	}
+
+	responses := make([]dto.PairedDumpsterResponse, 0, len(counts))
+	for _, count := range counts {
+		dumpster, ok := byID[count.DumpsterID]
+		if !ok {
+			continue
+		}
+		responses = append(responses, dto.PairedDumpsterResponse{
+			Dumpster:    dumpster.ToResponse(),
+			SharedUsers: count.SharedUsers,
+		})
+	}
+
+	return responses, nil
+}
+
+// GetRegionCounts breaks listings down by state and by city so admins can
+// spot coverage gaps. Every state is returned; cities are capped at the
+// request limit.
+func (s *dumpsterService) GetRegionCounts(
+	ctx context.Context,
+	req dto.DumpsterRegionCountsRequest) (*dto.DumpsterRegionCountsResponse, error) {
+	if req.Limit < 0 || req.Limit > maxRegionCityLimit {
+		return nil, apperrors.BadRequest(fmt.Sprintf("limit must be between 1 and %d", maxRegionCityLimit))
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultRegionCityLimit
+	}
+
+	states, err := s.dumpsterRepo.CountByState(ctx, req)
+	if err != nil {
+		s.logger.Error("failed to count dumpsters by state", zap.Error(err))
+		return nil, err
+	}
+
+	cities, err := s.dumpsterRepo.CountByCity(ctx, req, limit+1)
+	if err != nil {
+		s.logger.Error("failed to count dumpsters by city", zap.Error(err))
+		return nil, err
+	}
+
+	response := &dto.DumpsterRegionCountsResponse{
+		States: states,
+		Cities: cities,
+	}
+
+	if len(cities) > limit {
+		response.Cities = cities[:limit]
+		response.CitiesTruncated = true
+	}
+
+	for _, state := range states {
+		response.Total += state.Count
+	}
+
+	if response.States == nil {
+		response.States = []dto.StateCount{}
+	}
+	if response.Cities == nil {
+		response.Cities = []dto.CityCount{}
+	}
+
+	return response, nil
+}
+
+func (s *dumpsterService) GetPriceStats(
+	ctx context.Context,
+	req dto.DumpsterPriceStatsRequest) (*dto.DumpsterPriceStatsResponse, error) {
+	req.City = strings.TrimSpace(req.City)
+	req.State = strings.TrimSpace(req.State)
+	req.ZipCode = strings.TrimSpace(req.ZipCode)
+
+	stats, err := s.dumpsterRepo.GetPriceStats(ctx, req)
+	if err != nil {
+		s.logger.Error("failed to get dumpster price stats", zap.Error(err))
+		return nil, err
+	}
+
+	stats.City = req.City
+	stats.State = req.State
+	stats.ZipCode = req.ZipCode
+	stats.Currency = money.Currency()
+
+	return stats, nil
+}
+
+// GetTimeline merges the dumpster's creation, price changes, bookings, usages
+// and reviews into one feed ordered newest first by time and then ID. Each
+// source is queried for up to limit records past the cursor, so the merged
+// page is always complete.
+func (s *dumpsterService) GetTimeline(
+	ctx context.Context,
+	ownerID, id string,
+	isAdmin bool,
+	req dto.DumpsterTimelineRequest) (*dto.DumpsterTimelineResponse, error) {
+	dumpsterID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid dumpster ID")
+	}
+
+	ownerUUID, err := uuid.Parse(ownerID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid owner ID")
+	}
+
+	dumpster, err := s.dumpsterRepo.GetByID(ctx, dumpsterID, repository.WithPreload())
+	if err != nil {
+		return nil, err
+	}
+
+	if !isAdmin {
+		if err := s.ownership.Check(dumpster.OwnerID, ownerUUID, "dumpster", "view the timeline of"); err != nil {
+			return nil, err
+		}
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultTimelineLimit
+	}
+	limit = min(limit, maxTimelineLimit)
+
+	before := repository.Keyset{CreatedAt: time.Now()}
+	if req.Before != nil {
+		before.CreatedAt = *req.Before
+	}
+	if req.BeforeID != "" {
+		if req.Before == nil {
+			return nil, apperrors.BadRequest("beforeId requires before")
+		}
+		if before.ID, err = uuid.Parse(req.BeforeID); err != nil {
+			return nil, apperrors.BadRequest("invalid beforeId")
+		}
+	}
+
+	var events []dto.TimelineEvent
+
+	if keysetLess(dumpster.CreatedAt, dumpster.ID, before) {
+		events = append(events, dto.TimelineEvent{
+			ID:         dumpster.ID.String(),
+			Type:       "created",
+			OccurredAt: dumpster.CreatedAt,
+			Created: &dto.TimelineCreated{
+				Title:       dumpster.Title,
+				PricePerDay: dumpster.PricePerDay,
+			},
+		})
+	}
+
+	priceChanges, err := s.priceRepo.GetByDumpsterIDBefore(ctx, dumpsterID, before, limit)
+	if err != nil {
+		return nil, err
+	}
+	for _, change := range priceChanges {
+		events = append(events, dto.TimelineEvent{
+			ID:         change.ID.String(),
+			Type:       "price_changed",
+			OccurredAt: change.CreatedAt,
+			PriceChange: &dto.TimelinePriceChange{
+				OldPrice: change.OldPrice,
+				NewPrice: change.NewPrice,
+			},
+		})
+	}
+
+	bookings, err := s.bookingRepo.GetByDumpsterIDBefore(ctx, dumpsterID, before, limit)
+	if err != nil {
+		return nil, err
+	}
+	for _, booking := range bookings {
+		events = append(events, dto.TimelineEvent{
+			ID:         booking.ID.String(),
+			Type:       "booking",
+			OccurredAt: booking.CreatedAt,
+			Booking: &dto.TimelineBooking{
+				ID:         booking.ID.String(),
+				UserID:     booking.UserID.String(),
+				Status:     string(booking.Status),
+				StartDate:  booking.StartDate,
+				EndDate:    booking.EndDate,
+				TotalPrice: booking.TotalPrice,
+			},
+		})
+	}
+
+	usages, err := s.usageRepo.GetByDumpsterIDBefore(ctx, dumpsterID, before, limit)
+	if err != nil {
+		return nil, err
+	}
+	for _, usage := range usages {
+		events = append(events, dto.TimelineEvent{
+			ID:         usage.ID.String(),
+			Type:       "usage",
+			OccurredAt: usage.CreatedAt,
+			Usage: &dto.TimelineUsage{
+				ID:        usage.ID.String(),
+				UserID:    usage.UserID.String(),
+				Status:    string(usage.Status),
+				StartTime: usage.StartTime,
+				EndTime:   usage.EndTime,
+				TotalCost: usage.TotalCost,
+			},
+		})
+	}
+
+	reviews, err := s.reviewRepo.GetByDumpsterIDBefore(ctx, dumpsterID, before, limit)
+	if err != nil {
+		return nil, err
+	}
+	for _, review := range reviews {
+		events = append(events, dto.TimelineEvent{
+			ID:         review.ID.String(),
+			Type:       "review",
+			OccurredAt: review.CreatedAt,
+			Review: &dto.TimelineReview{
+				ID:     review.ID.String(),
+				UserID: review.UserID.String(),
+				Rating: review.Rating,
+			},
+		})
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if !events[i].OccurredAt.Equal(events[j].OccurredAt) {
+			return events[i].OccurredAt.After(events[j].OccurredAt)
+		}
+		return events[i].ID > events[j].ID
+	})
+
+	response := &dto.DumpsterTimelineResponse{Events: events}
+	if len(events) > limit {
+		response.Events = events[:limit]
+		last := response.Events[limit-1]
+		response.NextBefore = &last.OccurredAt
+		response.NextBeforeID = last.ID
+	}
+
+	if response.Events == nil {
+		response.Events = []dto.TimelineEvent{}
+	}
+
+	return response, nil
+}
+
+func keysetLess(createdAt time.Time, id uuid.UUID, k repository.Keyset) bool {
+	if !createdAt.Equal(k.CreatedAt) {
+		return createdAt.Before(k.CreatedAt)
+	}
+	return id.String() < k.ID.String()
+}
+
+func validateTags(tags []string) error {
+	if len(tags) > model.MaxDumpsterTags {
+		return apperrors.BadRequest(fmt.Sprintf("at most %d tags are allowed", model.MaxDumpsterTags))
+	}
+
+	for _, tag := range tags {
+		if len(tag) > model.MaxDumpsterTagLen {
+			return apperrors.BadRequest(fmt.Sprintf("tag %q exceeds %d characters", tag, model.MaxDumpsterTagLen))
+		}
+	}
+
+	return nil
+}
+
+func dumpsterTaxLocation(dumpster *model.Dumpster) tax.Location {
+	return tax.Location{
+		State:   dumpster.State,
+		ZipCode: dumpster.ZipCode,
+	}
+}
+
+func patchRequired[T any](field dto.Optional[T], name string, dst *T) error {
+	if !field.Set {
+		return nil
+	}
+	if field.Null {
+		return apperrors.BadRequest(name + " cannot be null")
+	}
+	*dst = field.Value
+	return nil
+}
+
+func patchNullable[T any](field dto.Optional[T], dst *T) {
+	if field.Set {
+		*dst = field.Value
+	}
+}
+
+func validateDumpster(dumpster *model.Dumpster) error {
+	for _, field := range []struct{ name, value string }{
+		{"title", dumpster.Title},
+		{"location", dumpster.Location},
+		{"address", dumpster.Address},
+		{"city", dumpster.City},
+		{"state", dumpster.State},
+		{"zipCode", dumpster.ZipCode},
+	} {
+		if strings.TrimSpace(field.value) == "" {
+			return apperrors.BadRequest(field.name + " is required")
+		}
+	}
+
+	if dumpster.PricePerDay <= 0 {
+		return apperrors.BadRequest("pricePerDay must be greater than 0")
+	}
+
+	switch dumpster.Size {
+	case model.DumpsterSizeSmall, model.DumpsterSizeMedium, model.DumpsterSizeLarge, model.DumpsterSizeExtraLarge:
+	default:
+		return apperrors.BadRequest("size must be one of small, medium, large, extraLarge")
+	}
+
+	return nil
+}
+
+func (s *dumpsterService) parseLocation(location string) []float64 {
+	parts := strings.Split(location, ",")
+	if len(parts) != 2 {
+		return nil
+	}
+
+	var lat, lng float64
+	if _, err := fmt.Sscanf(strings.TrimSpace(parts[0]), "%f", &lat); err != nil {
+		return nil
+	}
+	if _, err := fmt.Sscanf(strings.TrimSpace(parts[1]), "%f", &lng); err != nil {
+		return nil
+	}
+
+	return []float64{lat, lng}
+}
+
+// Flag reports a listing for moderation. Once the listing collects
+// FlagThreshold open flags it is unpublished pending admin review and the
+// owner is notified.
+func (s *dumpsterService) Flag(
+	ctx context.Context,
+	reporterID, id string,
+	req dto.FlagDumpsterRequest) error {
+	reporterUUID, err := uuid.Parse(reporterID)
+	if err != nil {
+		return apperrors.BadRequest("invalid user ID")
+	}
+
+	dumpsterID, err := uuid.Parse(id)
+	if err != nil {
+		return apperrors.BadRequest("invalid dumpster ID")
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return apperrors.BadRequest("reason is required")
+	}
+	if len(reason) > maxFlagReasonLen {
+		return apperrors.BadRequest(fmt.Sprintf("reason must be at most %d characters", maxFlagReasonLen))
+	}
+
+	dumpster, err := s.dumpsterRepo.GetByID(ctx, dumpsterID, repository.WithPreload())
+	if err != nil {
+		return err
+	}
+
+	if dumpster.OwnerID == reporterUUID {
+		return apperrors.BadRequest("you cannot flag your own dumpster")
+	}
+
+	if err := s.flagRepo.Create(ctx, model.NewDumpsterFlag(dumpsterID, reporterUUID, reason)); err != nil {
+		return err
+	}
+
+	open, err := s.flagRepo.CountOpen(ctx, dumpsterID)
+	if err != nil {
+		s.logger.Error("failed to count dumpster flags", zap.String("dumpsterId", id), zap.Error(err))
+		return err
+	}
+
+	if open < int64(s.cfg.FlagThreshold) {
+		return nil
+	}
+
+	changed, err := s.dumpsterRepo.SetUnpublished(ctx, dumpsterID, true)
+	if err != nil {
+		s.logger.Error("failed to unpublish flagged dumpster", zap.String("dumpsterId", id), zap.Error(err))
+		return err
+	}
+	if changed {
+		s.invalidateCached(ctx, dumpsterID)
+	}
+
+	if changed {
+		s.logger.Info("dumpster unpublished after flags", zap.String("dumpsterId", id), zap.Int64("openFlags", open))
+		s.notifyOwner(ctx, dumpster, model.NotificationTypeDumpsterUnpublished,
+			"Listing unpublished",
+			fmt.Sprintf("%s was reported by several users and is hidden until an admin reviews it.", dumpster.Title))
+	}
+
+	return nil
+}
+
+func (s *dumpsterService) ListFlags(
+	ctx context.Context,
+	req dto.DumpsterFlagListRequest) (*dto.DumpsterFlagListResponse, error) {
+	var dumpsterUUID *uuid.UUID
+	if req.DumpsterID != "" {
+		parsed, err := uuid.Parse(req.DumpsterID)
+		if err != nil {
+			return nil, apperrors.BadRequest("invalid dumpster ID")
+		}
+		dumpsterUUID = &parsed
+	}
+
+	switch model.DumpsterFlagStatus(req.Status) {
+	case "", model.DumpsterFlagStatusOpen, model.DumpsterFlagStatusDismissed, model.DumpsterFlagStatusUpheld:
+	default:
+		return nil, apperrors.BadRequest("status must be one of open, dismissed, upheld")
+	}
+
+	flags, total, err := s.flagRepo.List(ctx, dumpsterUUID, req)
+	if err != nil {
+		s.logger.Error("failed to list dumpster flags", zap.Error(err))
+		return nil, err
+	}
+
+	responses := make([]dto.DumpsterFlagResponse, len(flags))
+	for i, flag := range flags {
+		responses[i] = flag.ToResponse()
+	}
+
+	return dto.NewPaginatedResponse(responses, total, req.Page, req.Limit), nil
+}
+
+// ResolveFlags closes every open flag on the listing. Dismissing them
+// republishes the listing; upholding keeps it unpublished.
+func (s *dumpsterService) ResolveFlags(
+	ctx context.Context,
+	adminID, id string,
+	req dto.ResolveDumpsterFlagsRequest) (*dto.DumpsterResponse, error) {
+	adminUUID, err := uuid.Parse(adminID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	dumpsterID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid dumpster ID")
+	}
+
+	var status model.DumpsterFlagStatus
+	switch req.Action {
+	case "dismiss":
+		status = model.DumpsterFlagStatusDismissed
+	case "uphold":
+		status = model.DumpsterFlagStatusUpheld
+	default:
+		return nil, apperrors.BadRequest("action must be one of dismiss, uphold")
+	}
+
+	dumpster, err := s.dumpsterRepo.GetByID(ctx, dumpsterID, repository.WithPreload())
+	if err != nil {
+		return nil, err
+	}
+
+	resolved, err := s.flagRepo.ResolveOpen(ctx, dumpsterID, adminUUID, status)
+	if err != nil {
+		s.logger.Error("failed to resolve dumpster flags", zap.String("dumpsterId", id), zap.Error(err))
+		return nil, err
+	}
+	if resolved == 0 {
+		return nil, apperrors.NotFound("no open flags for this dumpster")
+	}
+
+	changed, err := s.dumpsterRepo.SetUnpublished(ctx, dumpsterID, status == model.DumpsterFlagStatusUpheld)
+	if err != nil {
+		s.logger.Error("failed to update dumpster publication", zap.String("dumpsterId", id), zap.Error(err))
+		return nil, err
+	}
+	if changed {
+		s.invalidateCached(ctx, dumpsterID)
+	}
+
+	if changed && status == model.DumpsterFlagStatusDismissed {
+		s.notifyOwner(ctx, dumpster, model.NotificationTypeDumpsterRepublished,
+			"Listing restored",
+			fmt.Sprintf("%s was reviewed and is visible again.", dumpster.Title))
+	} else if changed {
+		s.notifyOwner(ctx, dumpster, model.NotificationTypeDumpsterUnpublished,
+			"Listing unpublished",
+			fmt.Sprintf("%s was reviewed and remains hidden.", dumpster.Title))
+	}
+
+	updated, err := s.dumpsterRepo.GetByID(ctx, dumpsterID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := updated.ToResponse()
+	return &response, nil
+}
+
+func (s *dumpsterService) notifyOwner(
+	ctx context.Context,
+	dumpster *model.Dumpster,
+	notificationType model.NotificationType,
+	title, message string) {
+	notification := model.NewNotification(dumpster.OwnerID, notificationType, title, message, &dumpster.ID)
+	if err := s.notifier.Notify(ctx, notification); err != nil {
+		s.logger.Warn("failed to notify dumpster owner",
+			zap.String("ownerId", dumpster.OwnerID.String()),
+			zap.String("dumpsterId", dumpster.ID.String()),
+			zap.Error(err))
+	}
+}
+
+func (s *dumpsterService) buildDumpsterListResponse(
+	dumpsters []*model.Dumpster,
+	total int64,
+	page, limit int) *dto.DumpsterListResponse {
+	responses := make([]dto.DumpsterResponse, len(dumpsters))
+	for i, dumpster := range dumpsters {
+		responses[i] = dumpster.ToResponse()
+	}
+
+	return dto.NewPaginatedResponse(responses, total, page, limit)
+}
+
+func sameListingAddress(a, b *model.Dumpster) bool {
+	address := normalizeListingText(a.Address)
+	if address == "" || address != normalizeListingText(b.Address) {
+		return false
+	}
+	return strings.EqualFold(strings.TrimSpace(a.ZipCode), strings.TrimSpace(b.ZipCode))
+}
+
+func titleSimilarity(a, b string) float64 {
+	wordsA := strings.Fields(normalizeListingText(a))
+	wordsB := strings.Fields(normalizeListingText(b))
+	if len(wordsA) == 0 || len(wordsB) == 0 {
+		return 0
+	}
+
+	setA := make(map[string]struct{}, len(wordsA))
+	for _, w := range wordsA {
+		setA[w] = struct{}{}
+	}
+
+	union := len(setA)
+	shared := 0
+	seen := make(map[string]struct{}, len(wordsB))
+	for _, w := range wordsB {
+		if _, ok := seen[w]; ok {
+			continue
+		}
+		seen[w] = struct{}{}
+
+		if _, ok := setA[w]; ok {
+			shared++
+		} else {
+			union++
+		}
+	}
+
+	return float64(shared) / float64(union)
+}
+
+func normalizeListingText(s string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
+
+func newShareSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+func signShareToken(dumpsterID uuid.UUID, expiresAt time.Time, secret string) string {
+	payload := make([]byte, 0, 24)
+	payload = append(payload, dumpsterID[:]...)
+	payload = binary.BigEndian.AppendUint64(payload, uint64(expiresAt.Unix()))
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func parseShareToken(token string) (uuid.UUID, time.Time, bool) {
+	encoded, _, ok := strings.Cut(token, ".")
+	if !ok {
+		return uuid.Nil, time.Time{}, false
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil || len(payload) != 24 {
+		return uuid.Nil, time.Time{}, false
+	}
+
+	dumpsterID, err := uuid.FromBytes(payload[:16])
+	if err != nil {
+		return uuid.Nil, time.Time{}, false
+	}
+
+	return dumpsterID, time.Unix(int64(binary.BigEndian.Uint64(payload[16:])), 0), true
 }
@@ -0,0 +1,265 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+	"waste-space/internal/dto"
+	"waste-space/internal/model"
+	"waste-space/internal/storage/cache"
+	"waste-space/internal/storage/repository"
+	apperrors "waste-space/pkg/errors"
+	"waste-space/pkg/objectstore"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var imageExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+}
+
+type DumpsterImageService interface {
+	List(ctx context.Context, dumpsterID string) ([]dto.DumpsterImageResponse, error)
+	CreateUploads(ctx context.Context, ownerID, dumpsterID string, req dto.CreateImageUploadsRequest) (*dto.ImageUploadsResponse, error)
+	Confirm(ctx context.Context, ownerID, dumpsterID string, req dto.ConfirmImageUploadsRequest) ([]dto.DumpsterImageResponse, error)
+	Delete(ctx context.Context, ownerID, dumpsterID, imageID string) error
+}
+
+type DumpsterImageServiceConfig struct {
+	UploadTTL time.Duration
+}
+
+type dumpsterImageService struct {
+	imageRepo    repository.DumpsterImageRepository
+	dumpsterRepo repository.DumpsterRepository
+	byID         cache.DumpsterCache
+	store        objectstore.Store
+	ownership    *OwnershipGuard
+	cfg          DumpsterImageServiceConfig
+	logger       *zap.Logger
+}
+
+func NewDumpsterImageService(
+	imageRepo repository.DumpsterImageRepository,
+	dumpsterRepo repository.DumpsterRepository,
+	byID cache.DumpsterCache,
+	store objectstore.Store,
+	ownership *OwnershipGuard,
+	cfg DumpsterImageServiceConfig,
+	logger *zap.Logger) DumpsterImageService {
+	return &dumpsterImageService{
+		imageRepo:    imageRepo,
+		dumpsterRepo: dumpsterRepo,
+		byID:         byID,
+		store:        store,
+		ownership:    ownership,
+		cfg:          cfg,
+		logger:       logger,
+	}
+}
+
+func (s *dumpsterImageService) List(ctx context.Context, dumpsterID string) ([]dto.DumpsterImageResponse, error) {
+	dumpsterUUID, err := uuid.Parse(dumpsterID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid dumpster ID")
+	}
+
+	if _, err := s.dumpsterRepo.GetByID(ctx, dumpsterUUID, repository.WithPreload()); err != nil {
+		return nil, err
+	}
+
+	images, err := s.imageRepo.GetByDumpsterID(ctx, dumpsterUUID)
+	if err != nil {
+		s.logger.Error("failed to list dumpster images", zap.String("dumpsterId", dumpsterID), zap.Error(err))
+		return nil, err
+	}
+
+	return imageResponses(images), nil
+}
+
+// CreateUploads presigns one direct upload per requested image. Nothing is
+// recorded until the client confirms the keys after uploading.
+func (s *dumpsterImageService) CreateUploads(
+	ctx context.Context,
+	ownerID, dumpsterID string,
+	req dto.CreateImageUploadsRequest) (*dto.ImageUploadsResponse, error) {
+	dumpster, err := s.getOwnedDumpster(ctx, ownerID, dumpsterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Images) == 0 {
+		return nil, apperrors.BadRequest("at least one image is required")
+	}
+
+	existing, err := s.imageRepo.CountByDumpster(ctx, dumpster.ID)
+	if err != nil {
+		return nil, err
+	}
+	if int(existing)+len(req.Images) > model.MaxDumpsterImages {
+		return nil, apperrors.BadRequest(fmt.Sprintf("a dumpster can have at most %d images", model.MaxDumpsterImages))
+	}
+
+	uploads := make([]dto.ImageUploadResponse, len(req.Images))
+	for i, image := range req.Images {
+		ext, ok := imageExtensions[image.ContentType]
+		if !ok {
+			return nil, apperrors.BadRequest("contentType must be one of image/jpeg, image/png, image/webp")
+		}
+
+		key := imageKeyPrefix(dumpster.ID) + uuid.NewString() + ext
+		upload, err := s.store.PresignUpload(ctx, key, image.ContentType, s.cfg.UploadTTL)
+		if err != nil {
+			s.logger.Error("failed to presign image upload", zap.String("dumpsterId", dumpsterID), zap.Error(err))
+			return nil, apperrors.Internal("failed to prepare image upload", err)
+		}
+
+		uploads[i] = dto.ImageUploadResponse{
+			Key:       key,
+			UploadURL: upload.URL,
+			Method:    upload.Method,
+			Headers:   upload.Headers,
+			ExpiresAt: upload.ExpiresAt,
+		}
+	}
+
+	return &dto.ImageUploadsResponse{Uploads: uploads}, nil
+}
+
+// Confirm records uploaded images on the dumpster. Keys must be ones issued
+// for this dumpster and must already exist in storage.
+func (s *dumpsterImageService) Confirm(
+	ctx context.Context,
+	ownerID, dumpsterID string,
+	req dto.ConfirmImageUploadsRequest) ([]dto.DumpsterImageResponse, error) {
+	dumpster, err := s.getOwnedDumpster(ctx, ownerID, dumpsterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Keys) == 0 {
+		return nil, apperrors.BadRequest("at least one key is required")
+	}
+
+	prefix := imageKeyPrefix(dumpster.ID)
+	seen := make(map[string]struct{}, len(req.Keys))
+	images := make([]*model.DumpsterImage, 0, len(req.Keys))
+	for _, key := range req.Keys {
+		if !isImageKey(prefix, key) {
+			return nil, apperrors.BadRequest(fmt.Sprintf("key %q was not issued for this dumpster", key))
+		}
+		if _, ok := seen[key]; ok {
+			return nil, apperrors.BadRequest(fmt.Sprintf("key %q is listed more than once", key))
+		}
+		seen[key] = struct{}{}
+
+		exists, err := s.store.Exists(ctx, key)
+		if err != nil {
+			s.logger.Error("failed to check uploaded image", zap.String("key", key), zap.Error(err))
+			return nil, apperrors.Internal("failed to verify image upload", err)
+		}
+		if !exists {
+			return nil, apperrors.BadRequest(fmt.Sprintf("key %q has not been uploaded", key))
+		}
+
+		images = append(images, &model.DumpsterImage{
+			ObjectKey: key,
+			URL:       s.store.URL(key),
+		})
+	}
+
+	if err := s.imageRepo.CreateBatch(ctx, dumpster.ID, images, model.MaxDumpsterImages); err != nil {
+		if apperrors.Is(err, apperrors.ErrorTypeInternal) {
+			s.logger.Error("failed to record dumpster images", zap.String("dumpsterId", dumpsterID), zap.Error(err))
+		}
+		return nil, err
+	}
+	invalidateDumpsterCache(ctx, s.byID, s.logger, dumpster.ID)
+
+	created := make([]model.DumpsterImage, len(images))
+	for i, image := range images {
+		created[i] = *image
+	}
+
+	return imageResponses(created), nil
+}
+
+// Delete removes the image from the listing. The stored object is left in
+// place; bucket lifecycle rules are expected to clean up orphans.
+func (s *dumpsterImageService) Delete(ctx context.Context, ownerID, dumpsterID, imageID string) error {
+	dumpster, err := s.getOwnedDumpster(ctx, ownerID, dumpsterID)
+	if err != nil {
+		return err
+	}
+
+	imageUUID, err := uuid.Parse(imageID)
+	if err != nil {
+		return apperrors.BadRequest("invalid image ID")
+	}
+
+	if err := s.imageRepo.Delete(ctx, dumpster.ID, imageUUID); err != nil {
+		return err
+	}
+	invalidateDumpsterCache(ctx, s.byID, s.logger, dumpster.ID)
+
+	return nil
+}
+
+func (s *dumpsterImageService) getOwnedDumpster(ctx context.Context, ownerID, dumpsterID string) (*model.Dumpster, error) {
+	ownerUUID, err := uuid.Parse(ownerID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid owner ID")
+	}
+
+	dumpsterUUID, err := uuid.Parse(dumpsterID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid dumpster ID")
+	}
+
+	dumpster, err := s.dumpsterRepo.GetByID(ctx, dumpsterUUID, repository.WithPreload())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ownership.Check(dumpster.OwnerID, ownerUUID, "dumpster", "manage images of"); err != nil {
+		return nil, err
+	}
+
+	return dumpster, nil
+}
+
+func imageKeyPrefix(dumpsterID uuid.UUID) string {
+	return "dumpsters/" + dumpsterID.String() + "/images/"
+}
+
+func isImageKey(prefix, key string) bool {
+	name, ok := strings.CutPrefix(key, prefix)
+	if !ok {
+		return false
+	}
+
+	ext := path.Ext(name)
+	if _, err := uuid.Parse(strings.TrimSuffix(name, ext)); err != nil {
+		return false
+	}
+
+	for _, allowed := range imageExtensions {
+		if ext == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+func imageResponses(images []model.DumpsterImage) []dto.DumpsterImageResponse {
+	responses := make([]dto.DumpsterImageResponse, len(images))
+	for i := range images {
+		responses[i] = images[i].ToResponse()
+	}
+	return responses
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+	"waste-space/internal/dto"
+	"waste-space/internal/model"
+	"waste-space/internal/storage/repository"
+
+	"github.com/google/uuid"
+)
+
+// keysetPage mimics the repositories' keyset query: rows past before in
+// newest-first (created_at, id) order, at most limit of them.
+func keysetPage[T any](rows []T, key func(T) (time.Time, uuid.UUID), before repository.Keyset, limit int) []T {
+	var page []T
+	for _, row := range rows {
+		if createdAt, id := key(row); keysetLess(createdAt, id, before) {
+			page = append(page, row)
+		}
+	}
+	slices.SortFunc(page, func(a, b T) int {
+		aAt, aID := key(a)
+		bAt, bID := key(b)
+		if c := bAt.Compare(aAt); c != 0 {
+			return c
+		}
+		return strings.Compare(bID.String(), aID.String())
+	})
+	return page[:min(limit, len(page))]
+}
+
+type fakeTimelineDumpsterRepo struct {
+	repository.DumpsterRepository
+	dumpster *model.Dumpster
+}
+
+func (r *fakeTimelineDumpsterRepo) GetByID(_ context.Context, _ uuid.UUID, _ ...repository.QueryOption) (*model.Dumpster, error) {
+	return r.dumpster, nil
+}
+
+type fakeTimelinePriceRepo struct {
+	repository.PriceChangeRepository
+	rows []*model.DumpsterPriceChange
+}
+
+func (r *fakeTimelinePriceRepo) GetByDumpsterIDBefore(
+	_ context.Context, _ uuid.UUID, before repository.Keyset, limit int) ([]*model.DumpsterPriceChange, error) {
+	return keysetPage(r.rows, func(c *model.DumpsterPriceChange) (time.Time, uuid.UUID) { return c.CreatedAt, c.ID }, before, limit), nil
+}
+
+type fakeTimelineBookingRepo struct {
+	repository.BookingRepository
+	rows []*model.Booking
+}
+
+func (r *fakeTimelineBookingRepo) GetByDumpsterIDBefore(
+	_ context.Context, _ uuid.UUID, before repository.Keyset, limit int) ([]*model.Booking, error) {
+	return keysetPage(r.rows, func(b *model.Booking) (time.Time, uuid.UUID) { return b.CreatedAt, b.ID }, before, limit), nil
+}
+
+type fakeTimelineUsageRepo struct {
+	repository.UsageRepository
+	rows []*model.DumpsterUsage
+}
+
+func (r *fakeTimelineUsageRepo) GetByDumpsterIDBefore(
+	_ context.Context, _ uuid.UUID, before repository.Keyset, limit int) ([]*model.DumpsterUsage, error) {
+	return keysetPage(r.rows, func(u *model.DumpsterUsage) (time.Time, uuid.UUID) { return u.CreatedAt, u.ID }, before, limit), nil
+}
+
+type fakeTimelineReviewRepo struct {
+	repository.ReviewRepository
+	rows []*model.Review
+}
+
+func (r *fakeTimelineReviewRepo) GetByDumpsterIDBefore(
+	_ context.Context, _ uuid.UUID, before repository.Keyset, limit int) ([]*model.Review, error) {
+	return keysetPage(r.rows, func(rv *model.Review) (time.Time, uuid.UUID) { return rv.CreatedAt, rv.ID }, before, limit), nil
+}
+
+func TestGetTimelinePagesThroughIdenticalTimestamps(t *testing.T) {
+	at := time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
+	owner := uuid.New()
+	dumpster := &model.Dumpster{ID: uuid.New(), OwnerID: owner, CreatedAt: at}
+
+	prices := &fakeTimelinePriceRepo{}
+	bookings := &fakeTimelineBookingRepo{}
+	usages := &fakeTimelineUsageRepo{}
+	reviews := &fakeTimelineReviewRepo{}
+	want := map[string]bool{dumpster.ID.String(): true}
+	for range 3 {
+		change := &model.DumpsterPriceChange{ID: uuid.New(), CreatedAt: at}
+		booking := &model.Booking{ID: uuid.New(), CreatedAt: at}
+		usage := &model.DumpsterUsage{ID: uuid.New(), CreatedAt: at}
+		review := &model.Review{ID: uuid.New(), CreatedAt: at}
+		prices.rows = append(prices.rows, change)
+		bookings.rows = append(bookings.rows, booking)
+		usages.rows = append(usages.rows, usage)
+		reviews.rows = append(reviews.rows, review)
+		for _, id := range []uuid.UUID{change.ID, booking.ID, usage.ID, review.ID} {
+			want[id.String()] = true
+		}
+	}
+
+	svc := &dumpsterService{
+		dumpsterRepo: &fakeTimelineDumpsterRepo{dumpster: dumpster},
+		priceRepo:    prices,
+		bookingRepo:  bookings,
+		usageRepo:    usages,
+		reviewRepo:   reviews,
+		ownership:    NewOwnershipGuard(OwnershipPolicyForbidden),
+	}
+
+	seen := make(map[string]int)
+	req := dto.DumpsterTimelineRequest{Limit: 3}
+	for page := 0; ; page++ {
+		if page > len(want) {
+			t.Fatalf("paging did not terminate after %d pages", page)
+		}
+
+		resp, err := svc.GetTimeline(context.Background(), owner.String(), dumpster.ID.String(), false, req)
+		if err != nil {
+			t.Fatalf("GetTimeline() page %d: %v", page, err)
+		}
+		for _, event := range resp.Events {
+			seen[event.ID]++
+		}
+
+		if resp.NextBefore == nil {
+			break
+		}
+		req.Before = resp.NextBefore
+		req.BeforeID = resp.NextBeforeID
+	}
+
+	if len(seen) != len(want) {
+		t.Errorf("saw %d distinct events, want %d", len(seen), len(want))
+	}
+	for id, count := range seen {
+		if !want[id] {
+			t.Errorf("unexpected event %s", id)
+		}
+		if count != 1 {
+			t.Errorf("event %s returned %d times", id, count)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+	"waste-space/internal/dto"
+	"waste-space/internal/model"
+	"waste-space/internal/storage/cache"
+	apperrors "waste-space/pkg/errors"
+
+	"go.uber.org/zap"
+)
+
+const defaultMaintenanceRetryAfter = 300
+
+type MaintenanceService interface {
+	GetState(ctx context.Context) (*dto.MaintenanceResponse, error)
+	SetState(ctx context.Context, req dto.MaintenanceRequest) (*dto.MaintenanceResponse, error)
+	CurrentState(ctx context.Context) model.MaintenanceState
+}
+
+type maintenanceService struct {
+	maintenanceCache cache.MaintenanceCache
+	refreshInterval  time.Duration
+	logger           *zap.Logger
+
+	mu        sync.RWMutex
+	state     model.MaintenanceState
+	refreshed time.Time
+}
+
+func NewMaintenanceService(
+	maintenanceCache cache.MaintenanceCache,
+	refreshInterval time.Duration,
+	logger *zap.Logger) MaintenanceService {
+	return &maintenanceService{
+		maintenanceCache: maintenanceCache,
+		refreshInterval:  refreshInterval,
+		logger:           logger,
+		state:            model.MaintenanceState{Mode: model.MaintenanceModeOff},
+	}
+}
+
+func (s *maintenanceService) GetState(ctx context.Context) (*dto.MaintenanceResponse, error) {
+	state, err := s.maintenanceCache.GetState(ctx)
+	if err != nil {
+		s.logger.Error("failed to get maintenance state", zap.Error(err))
+		return nil, apperrors.Internal("failed to get maintenance state", err)
+	}
+
+	s.remember(*state)
+
+	response := state.ToResponse()
+	return &response, nil
+}
+
+func (s *maintenanceService) SetState(
+	ctx context.Context,
+	req dto.MaintenanceRequest) (*dto.MaintenanceResponse, error) {
+	mode := model.MaintenanceMode(req.Mode)
+	switch mode {
+	case model.MaintenanceModeOff, model.MaintenanceModeReadOnly, model.MaintenanceModeFull:
+	default:
+		return nil, apperrors.BadRequest("invalid maintenance mode")
+	}
+
+	state := &model.MaintenanceState{
+		Mode:              mode,
+		RetryAfterSeconds: req.RetryAfterSeconds,
+		Message:           req.Message,
+	}
+	if state.RetryAfterSeconds <= 0 {
+		state.RetryAfterSeconds = defaultMaintenanceRetryAfter
+	}
+
+	if err := s.maintenanceCache.SetState(ctx, state); err != nil {
+		s.logger.Error("failed to set maintenance state", zap.String("mode", req.Mode), zap.Error(err))
+		return nil, apperrors.Internal("failed to set maintenance state", err)
+	}
+
+	s.remember(*state)
+	s.logger.Info("maintenance mode changed", zap.String("mode", req.Mode))
+
+	response := state.ToResponse()
+	return &response, nil
+}
+
+// CurrentState returns the locally cached state, refreshed from Redis at most
+// once per refresh interval.
+func (s *maintenanceService) CurrentState(ctx context.Context) model.MaintenanceState {
+	s.mu.RLock()
+	state, refreshed := s.state, s.refreshed
+	s.mu.RUnlock()
+
+	if time.Since(refreshed) < s.refreshInterval {
+		return state
+	}
+
+	fresh, err := s.maintenanceCache.GetState(ctx)
+	if err != nil {
+		s.logger.Warn("failed to refresh maintenance state, using last known", zap.Error(err))
+		s.remember(state)
+		return state
+	}
+
+	s.remember(*fresh)
+	return *fresh
+}
+
+func (s *maintenanceService) remember(state model.MaintenanceState) {
+	s.mu.Lock()
+	s.state = state
+	s.refreshed = time.Now()
+	s.mu.Unlock()
+}
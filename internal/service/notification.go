@@ -0,0 +1,273 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"waste-space/internal/dto"
+	"waste-space/internal/model"
+	"waste-space/internal/storage/repository"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type NotificationService interface {
+	Notify(ctx context.Context, notification *model.Notification) error
+	List(ctx context.Context, userID string, req dto.NotificationListRequest) (*dto.NotificationListResponse, error)
+	MarkAsRead(ctx context.Context, userID, id string) error
+	MarkRead(ctx context.Context, userID string, ids []string) (*dto.MarkNotificationsReadResponse, error)
+	MarkAllRead(ctx context.Context, userID string) (*dto.MarkNotificationsReadResponse, error)
+	CountUnread(ctx context.Context, userID string) (int64, error)
+	GetPreferences(ctx context.Context, userID string) (*dto.NotificationPreferencesResponse, error)
+	UpdatePreferences(ctx context.Context, userID string, req dto.UpdateNotificationPreferencesRequest) (*dto.NotificationPreferencesResponse, error)
+}
+
+const maxMarkReadIDs = 100
+
+type notificationService struct {
+	notificationRepo repository.NotificationRepository
+	preferenceRepo   repository.NotificationPreferenceRepository
+	logger           *zap.Logger
+}
+
+func NewNotificationService(
+	notificationRepo repository.NotificationRepository,
+	preferenceRepo repository.NotificationPreferenceRepository,
+	logger *zap.Logger) NotificationService {
+	return &notificationService{
+		notificationRepo: notificationRepo,
+		preferenceRepo:   preferenceRepo,
+		logger:           logger,
+	}
+}
+
+// Notify delivers the notification on the channels the recipient has enabled
+// for its type. In-app is currently the only channel, so a notification whose
+// type has in-app turned off is dropped.
+func (s *notificationService) Notify(ctx context.Context, notification *model.Notification) error {
+	preference, err := s.preferenceFor(ctx, notification.UserID, notification.Type)
+	if err != nil {
+		return err
+	}
+
+	if !preference.InApp {
+		return nil
+	}
+
+	if err := s.notificationRepo.Create(ctx, notification); err != nil {
+		s.logger.Error("failed to create notification",
+			zap.String("userId", notification.UserID.String()),
+			zap.String("type", string(notification.Type)),
+			zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *notificationService) List(
+	ctx context.Context,
+	userID string,
+	req dto.NotificationListRequest) (*dto.NotificationListResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	notifications, total, err := s.notificationRepo.GetByUserID(ctx, userUUID, req)
+	if err != nil {
+		s.logger.Error("failed to list notifications", zap.String("userId", userID), zap.Error(err))
+		return nil, err
+	}
+
+	responses := make([]dto.NotificationResponse, len(notifications))
+	for i, notification := range notifications {
+		responses[i] = notification.ToResponse()
+	}
+
+	return dto.NewPaginatedResponse(responses, total, req.Page, req.Limit), nil
+}
+
+func (s *notificationService) MarkAsRead(ctx context.Context, userID, id string) error {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return apperrors.BadRequest("invalid user ID")
+	}
+
+	notificationUUID, err := uuid.Parse(id)
+	if err != nil {
+		return apperrors.BadRequest("invalid notification ID")
+	}
+
+	if err := s.notificationRepo.MarkAsRead(ctx, userUUID, notificationUUID, time.Now()); err != nil {
+		s.logger.Error("failed to mark notification as read", zap.String("notificationId", id), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// MarkRead marks the listed notifications read. IDs that are unknown, belong
+// to someone else or are already read are not counted.
+func (s *notificationService) MarkRead(
+	ctx context.Context,
+	userID string,
+	ids []string) (*dto.MarkNotificationsReadResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	if len(ids) == 0 {
+		return nil, apperrors.BadRequest("at least one notification ID is required")
+	}
+	if len(ids) > maxMarkReadIDs {
+		return nil, apperrors.BadRequest(fmt.Sprintf("at most %d notification IDs are allowed", maxMarkReadIDs))
+	}
+
+	notificationIDs := make([]uuid.UUID, len(ids))
+	for i, id := range ids {
+		notificationIDs[i], err = uuid.Parse(id)
+		if err != nil {
+			return nil, apperrors.BadRequest("invalid notification ID: " + id)
+		}
+	}
+
+	marked, err := s.notificationRepo.MarkManyAsRead(ctx, userUUID, notificationIDs, time.Now())
+	if err != nil {
+		s.logger.Error("failed to mark notifications as read", zap.String("userId", userID), zap.Error(err))
+		return nil, err
+	}
+
+	return &dto.MarkNotificationsReadResponse{Marked: marked}, nil
+}
+
+func (s *notificationService) MarkAllRead(ctx context.Context, userID string) (*dto.MarkNotificationsReadResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	marked, err := s.notificationRepo.MarkAllAsRead(ctx, userUUID, time.Now())
+	if err != nil {
+		s.logger.Error("failed to mark all notifications as read", zap.String("userId", userID), zap.Error(err))
+		return nil, err
+	}
+
+	return &dto.MarkNotificationsReadResponse{Marked: marked}, nil
+}
+
+func (s *notificationService) CountUnread(ctx context.Context, userID string) (int64, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return 0, apperrors.BadRequest("invalid user ID")
+	}
+
+	count, err := s.notificationRepo.CountUnread(ctx, userUUID)
+	if err != nil {
+		s.logger.Error("failed to count unread notifications", zap.String("userId", userID), zap.Error(err))
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (s *notificationService) preferenceFor(
+	ctx context.Context,
+	userID uuid.UUID,
+	notificationType model.NotificationType) (*model.NotificationPreference, error) {
+	preference, err := s.preferenceRepo.Get(ctx, userID, notificationType)
+	if err == nil {
+		return preference, nil
+	}
+
+	if apperrors.Is(err, apperrors.ErrorTypeNotFound) {
+		return model.DefaultNotificationPreference(userID, notificationType), nil
+	}
+
+	s.logger.Error("failed to get notification preference",
+		zap.String("userId", userID.String()),
+		zap.String("type", string(notificationType)),
+		zap.Error(err))
+	return nil, err
+}
+
+func (s *notificationService) GetPreferences(
+	ctx context.Context,
+	userID string) (*dto.NotificationPreferencesResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	return s.buildPreferencesResponse(ctx, userUUID)
+}
+
+func (s *notificationService) UpdatePreferences(
+	ctx context.Context,
+	userID string,
+	req dto.UpdateNotificationPreferencesRequest) (*dto.NotificationPreferencesResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	if len(req.Preferences) == 0 {
+		return nil, apperrors.BadRequest("at least one preference is required")
+	}
+
+	seen := make(map[model.NotificationType]bool, len(req.Preferences))
+	preferences := make([]*model.NotificationPreference, 0, len(req.Preferences))
+	for _, p := range req.Preferences {
+		notificationType := model.NotificationType(p.Type)
+		if !notificationType.IsValid() {
+			return nil, apperrors.BadRequest("unknown notification type: " + p.Type)
+		}
+		if seen[notificationType] {
+			return nil, apperrors.BadRequest("duplicate notification type: " + p.Type)
+		}
+		seen[notificationType] = true
+
+		preferences = append(preferences, &model.NotificationPreference{
+			UserID: userUUID,
+			Type:   notificationType,
+			InApp:  p.InApp,
+			Email:  p.Email,
+		})
+	}
+
+	if err := s.preferenceRepo.Upsert(ctx, preferences); err != nil {
+		s.logger.Error("failed to update notification preferences", zap.String("userId", userID), zap.Error(err))
+		return nil, err
+	}
+
+	return s.buildPreferencesResponse(ctx, userUUID)
+}
+
+func (s *notificationService) buildPreferencesResponse(
+	ctx context.Context,
+	userID uuid.UUID) (*dto.NotificationPreferencesResponse, error) {
+	stored, err := s.preferenceRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to get notification preferences", zap.String("userId", userID.String()), zap.Error(err))
+		return nil, err
+	}
+
+	byType := make(map[model.NotificationType]*model.NotificationPreference, len(stored))
+	for _, p := range stored {
+		byType[p.Type] = p
+	}
+
+	responses := make([]dto.NotificationPreferenceResponse, len(model.NotificationTypes))
+	for i, notificationType := range model.NotificationTypes {
+		preference, ok := byType[notificationType]
+		if !ok {
+			preference = model.DefaultNotificationPreference(userID, notificationType)
+		}
+		responses[i] = preference.ToResponse()
+	}
+
+	return &dto.NotificationPreferencesResponse{Preferences: responses}, nil
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"waste-space/internal/dto"
+	"waste-space/internal/model"
+	"waste-space/internal/storage/repository"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type OwnerBlockService interface {
+	Block(ctx context.Context, ownerID string, req dto.CreateOwnerBlockRequest) (*dto.OwnerBlockResponse, error)
+	Unblock(ctx context.Context, ownerID, blockedUserID string) error
+	List(ctx context.Context, ownerID string) ([]dto.OwnerBlockResponse, error)
+}
+
+type ownerBlockService struct {
+	blockRepo repository.OwnerBlockRepository
+	userRepo  repository.UserRepository
+	logger    *zap.Logger
+}
+
+func NewOwnerBlockService(
+	blockRepo repository.OwnerBlockRepository,
+	userRepo repository.UserRepository,
+	logger *zap.Logger) OwnerBlockService {
+	return &ownerBlockService{
+		blockRepo: blockRepo,
+		userRepo:  userRepo,
+		logger:    logger,
+	}
+}
+
+func (s *ownerBlockService) Block(
+	ctx context.Context,
+	ownerID string,
+	req dto.CreateOwnerBlockRequest) (*dto.OwnerBlockResponse, error) {
+	ownerUUID, err := uuid.Parse(ownerID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	blockedUUID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	if blockedUUID == ownerUUID {
+		return nil, apperrors.BadRequest("you can't block yourself")
+	}
+
+	if _, err := s.userRepo.GetByID(ctx, blockedUUID); err != nil {
+		return nil, err
+	}
+
+	block := model.NewOwnerBlock(ownerUUID, blockedUUID)
+	if err := s.blockRepo.Create(ctx, block); err != nil {
+		s.logger.Error("failed to block user", zap.String("ownerId", ownerID), zap.String("blockedUserId", req.UserID), zap.Error(err))
+		return nil, err
+	}
+
+	response := block.ToResponse()
+	return &response, nil
+}
+
+func (s *ownerBlockService) Unblock(ctx context.Context, ownerID, blockedUserID string) error {
+	ownerUUID, err := uuid.Parse(ownerID)
+	if err != nil {
+		return apperrors.BadRequest("invalid user ID")
+	}
+
+	blockedUUID, err := uuid.Parse(blockedUserID)
+	if err != nil {
+		return apperrors.BadRequest("invalid user ID")
+	}
+
+	return s.blockRepo.Delete(ctx, ownerUUID, blockedUUID)
+}
+
+func (s *ownerBlockService) List(ctx context.Context, ownerID string) ([]dto.OwnerBlockResponse, error) {
+	ownerUUID, err := uuid.Parse(ownerID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	blocks, err := s.blockRepo.ListByOwner(ctx, ownerUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dto.OwnerBlockResponse, len(blocks))
+	for i, block := range blocks {
+		responses[i] = block.ToResponse()
+	}
+	return responses, nil
+}
+
+func checkNotBlocked(ctx context.Context, blockRepo repository.OwnerBlockRepository, dumpster *model.Dumpster, userID uuid.UUID) error {
+	blocked, err := blockRepo.IsBlocked(ctx, dumpster.OwnerID, userID)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return apperrors.Forbidden("dumpster is not available for booking")
+	}
+	return nil
+}
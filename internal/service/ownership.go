@@ -0,0 +1,61 @@
+package service
+
+import (
+	"fmt"
+	"slices"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// OwnershipPolicy decides what a caller sees when acting on a resource they
+// don't own.
+type OwnershipPolicy string
+
+const (
+	// OwnershipPolicyForbidden returns 403, which confirms the resource exists.
+	OwnershipPolicyForbidden OwnershipPolicy = "forbidden"
+	// OwnershipPolicyNotFound returns 404 so non-owners can't enumerate IDs.
+	OwnershipPolicyNotFound OwnershipPolicy = "not_found"
+)
+
+func (p OwnershipPolicy) IsValid() bool {
+	return p == OwnershipPolicyForbidden || p == OwnershipPolicyNotFound
+}
+
+type OwnershipGuard struct {
+	policy OwnershipPolicy
+}
+
+func NewOwnershipGuard(policy OwnershipPolicy) *OwnershipGuard {
+	return &OwnershipGuard{policy: policy}
+}
+
+// Check returns nil when actorID owns the resource, and otherwise the error
+// dictated by the policy. resource is the noun used in messages ("dumpster"),
+// action the verb ("update").
+func (g *OwnershipGuard) Check(ownerID, actorID uuid.UUID, resource, action string) error {
+	if ownerID == actorID {
+		return nil
+	}
+
+	return g.deny(resource, action)
+}
+
+// CheckAny is Check for resources with several parties allowed to act on
+// them, such as a booking's booker and the dumpster's owner.
+func (g *OwnershipGuard) CheckAny(actorID uuid.UUID, resource, action string, ownerIDs ...uuid.UUID) error {
+	if slices.Contains(ownerIDs, actorID) {
+		return nil
+	}
+
+	return g.deny(resource, action)
+}
+
+func (g *OwnershipGuard) deny(resource, action string) error {
+	if g.policy == OwnershipPolicyNotFound {
+		return apperrors.NotFound(resource + " not found")
+	}
+
+	return apperrors.Forbidden(fmt.Sprintf("you don't have permission to %s this %s", action, resource))
+}
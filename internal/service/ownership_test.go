@@ -0,0 +1,62 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+func TestOwnershipGuardCheck(t *testing.T) {
+	owner := uuid.New()
+	other := uuid.New()
+
+	tests := []struct {
+		name     string
+		policy   OwnershipPolicy
+		actor    uuid.UUID
+		wantType apperrors.ErrorType
+		wantMsg  string
+	}{
+		{name: "forbidden policy, owner", policy: OwnershipPolicyForbidden, actor: owner},
+		{
+			name:     "forbidden policy, non-owner",
+			policy:   OwnershipPolicyForbidden,
+			actor:    other,
+			wantType: apperrors.ErrorTypeForbidden,
+			wantMsg:  "you don't have permission to update this dumpster",
+		},
+		{name: "not_found policy, owner", policy: OwnershipPolicyNotFound, actor: owner},
+		{
+			name:     "not_found policy, non-owner",
+			policy:   OwnershipPolicyNotFound,
+			actor:    other,
+			wantType: apperrors.ErrorTypeNotFound,
+			wantMsg:  "dumpster not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewOwnershipGuard(tt.policy).Check(owner, tt.actor, "dumpster", "update")
+			if tt.wantType == "" {
+				if err != nil {
+					t.Fatalf("Check() = %v, want nil", err)
+				}
+				return
+			}
+
+			var appErr *apperrors.AppError
+			if !errors.As(err, &appErr) {
+				t.Fatalf("Check() = %v, want an AppError", err)
+			}
+			if appErr.Type != tt.wantType {
+				t.Errorf("Check() type = %s, want %s", appErr.Type, tt.wantType)
+			}
+			if appErr.Message != tt.wantMsg {
+				t.Errorf("Check() message = %q, want %q", appErr.Message, tt.wantMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+	"waste-space/internal/dto"
+	"waste-space/internal/model"
+	"waste-space/internal/storage/repository"
+	apperrors "waste-space/pkg/errors"
+	"waste-space/pkg/payment"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const maxIdempotencyKeyLen = 255
+
+type PaymentService interface {
+	PayBooking(ctx context.Context, userID, bookingID, idempotencyKey string) (*dto.PaymentResponse, error)
+}
+
+type paymentService struct {
+	paymentRepo repository.PaymentRepository
+	bookingRepo repository.BookingRepository
+	processor   payment.Processor
+	ownership   *OwnershipGuard
+	logger      *zap.Logger
+}
+
+func NewPaymentService(
+	paymentRepo repository.PaymentRepository,
+	bookingRepo repository.BookingRepository,
+	processor payment.Processor,
+	ownership *OwnershipGuard,
+	logger *zap.Logger) PaymentService {
+	return &paymentService{
+		paymentRepo: paymentRepo,
+		bookingRepo: bookingRepo,
+		processor:   processor,
+		ownership:   ownership,
+		logger:      logger,
+	}
+}
+
+// PayBooking charges the booker for the booking total and, on success,
+// marks the booking paid and confirmed. Replaying an idempotency key returns
+// the original attempt, and paying an already paid booking returns its
+// payment, so a retried request never charges twice.
+func (s *paymentService) PayBooking(
+	ctx context.Context,
+	userID, bookingID, idempotencyKey string) (*dto.PaymentResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	bookingUUID, err := uuid.Parse(bookingID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid booking ID")
+	}
+
+	if len(idempotencyKey) > maxIdempotencyKeyLen {
+		return nil, apperrors.BadRequest("idempotency key is too long")
+	}
+
+	booking, err := s.bookingRepo.GetByID(ctx, bookingUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ownership.Check(booking.UserID, userUUID, "booking", "pay for"); err != nil {
+		return nil, err
+	}
+
+	if idempotencyKey != "" {
+		existing, err := s.paymentRepo.GetByIdempotencyKey(ctx, idempotencyKey)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			if existing.BookingID == nil || *existing.BookingID != bookingUUID {
+				return nil, apperrors.BadRequest("idempotency key was already used for another payment")
+			}
+			response := existing.ToResponse()
+			return &response, nil
+		}
+	} else {
+		idempotencyKey = uuid.NewString()
+	}
+
+	if booking.PaidAt != nil {
+		paid, err := s.paymentRepo.GetPaidByBookingID(ctx, bookingUUID)
+		if err != nil {
+			return nil, err
+		}
+		if paid != nil {
+			response := paid.ToResponse()
+			return &response, nil
+		}
+		return nil, apperrors.AlreadyExists("booking is already paid")
+	}
+
+	if booking.Status != model.BookingStatusPending && booking.Status != model.BookingStatusConfirmed {
+		return nil, apperrors.BadRequest("booking can no longer be paid")
+	}
+
+	attempt := model.NewBookingPayment(booking, idempotencyKey)
+	if err := s.paymentRepo.Create(ctx, attempt); err != nil {
+		return nil, err
+	}
+
+	result, err := s.processor.Charge(ctx, payment.ChargeRequest{
+		Amount:         attempt.Amount,
+		Currency:       attempt.Currency,
+		IdempotencyKey: idempotencyKey,
+		Description:    "Booking " + booking.ID.String(),
+	})
+	if err != nil {
+		if markErr := s.paymentRepo.MarkFailed(ctx, attempt.ID, err.Error()); markErr != nil {
+			s.logger.Error("failed to record failed payment", zap.String("paymentId", attempt.ID.String()), zap.Error(markErr))
+		}
+		if errors.Is(err, payment.ErrDeclined) {
+			return nil, apperrors.BadRequest("payment was declined")
+		}
+		s.logger.Error("failed to charge booking", zap.String("bookingId", bookingID), zap.Error(err))
+		return nil, apperrors.Internal("failed to process payment", err)
+	}
+
+	bookingUpdated, err := s.paymentRepo.CompleteBookingPayment(ctx, attempt, result.Reference, time.Now())
+	if err != nil {
+		s.logger.Error("failed to record successful payment",
+			zap.String("paymentId", attempt.ID.String()),
+			zap.String("reference", result.Reference),
+			zap.Error(err))
+		return nil, err
+	}
+
+	if !bookingUpdated {
+		s.logger.Error("booking changed state while being paid; refund required",
+			zap.String("bookingId", bookingID),
+			zap.String("paymentId", attempt.ID.String()))
+	}
+
+	response := attempt.ToResponse()
+	return &response, nil
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"waste-space/internal/dto"
+	"waste-space/internal/model"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// Capabilities reported by GetPermissions. Each mirrors a check the API
+// enforces, so the UI can hide what would be refused anyway.
+const (
+	capabilityCreateListings     = "create_listings"
+	capabilityBookDumpsters      = "book_dumpsters"
+	capabilityStartUsages        = "start_usages"
+	capabilityWriteReviews       = "write_reviews"
+	capabilityManageAPIKeys      = "manage_api_keys"
+	capabilityModerateReviews    = "moderate_reviews"
+	capabilityModerateListings   = "moderate_listings"
+	capabilityFeatureListings    = "feature_listings"
+	capabilityMarkUsagesPaid     = "mark_usages_paid"
+	capabilityManageMaintenance  = "manage_maintenance"
+	capabilityViewListingHistory = "view_any_listing_history"
+)
+
+var (
+	userCapabilities = []string{
+		capabilityCreateListings,
+		capabilityBookDumpsters,
+		capabilityStartUsages,
+		capabilityWriteReviews,
+	}
+	adminCapabilities = []string{
+		capabilityModerateReviews,
+		capabilityModerateListings,
+		capabilityFeatureListings,
+		capabilityMarkUsagesPaid,
+		capabilityManageMaintenance,
+		capabilityViewListingHistory,
+	}
+)
+
+// GetPermissions derives what the caller may do from their role and how they
+// authenticated. Inactive accounts get no capabilities, and API key callers
+// can't manage API keys.
+func (s *userService) GetPermissions(
+	ctx context.Context,
+	userID, role string,
+	apiKeyAuth bool) (*dto.PermissionsResponse, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	user, err := s.userRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.PermissionsResponse{
+		UserID:          userID,
+		Role:            role,
+		IsAdmin:         role == string(model.UserRoleAdmin),
+		IsActive:        user.IsActive,
+		IsEmailVerified: user.IsEmailVerified,
+		IsPhoneVerified: user.IsPhoneVerified,
+		APIKeyAuth:      apiKeyAuth,
+		Capabilities:    []string{},
+	}
+
+	if !user.IsActive {
+		return response, nil
+	}
+
+	response.Capabilities = append(response.Capabilities, userCapabilities...)
+	if !apiKeyAuth {
+		response.Capabilities = append(response.Capabilities, capabilityManageAPIKeys)
+	}
+	if response.IsAdmin {
+		response.Capabilities = append(response.Capabilities, adminCapabilities...)
+	}
+
+	return response, nil
+}
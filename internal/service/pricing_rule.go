@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"time"
+	"waste-space/internal/dto"
+	"waste-space/internal/model"
+	"waste-space/internal/storage/repository"
+	apperrors "waste-space/pkg/errors"
+	"waste-space/pkg/money"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	maxPricingRuleLabelLen = 100
+	maxPricingMultiplier   = 100
+)
+
+type PricingRuleService interface {
+	List(ctx context.Context, dumpsterID string) ([]dto.PricingRuleResponse, error)
+	Create(ctx context.Context, ownerID, dumpsterID string, req dto.PricingRuleRequest) (*dto.PricingRuleResponse, error)
+	Update(ctx context.Context, ownerID, dumpsterID, ruleID string, req dto.PricingRuleRequest) (*dto.PricingRuleResponse, error)
+	Delete(ctx context.Context, ownerID, dumpsterID, ruleID string) error
+	Quote(ctx context.Context, dumpster *model.Dumpster, start, end time.Time) (money.Amount, error)
+}
+
+type pricingRuleService struct {
+	ruleRepo     repository.PricingRuleRepository
+	dumpsterRepo repository.DumpsterRepository
+	ownership    *OwnershipGuard
+	logger       *zap.Logger
+}
+
+func NewPricingRuleService(
+	ruleRepo repository.PricingRuleRepository,
+	dumpsterRepo repository.DumpsterRepository,
+	ownership *OwnershipGuard,
+	logger *zap.Logger) PricingRuleService {
+	return &pricingRuleService{
+		ruleRepo:     ruleRepo,
+		dumpsterRepo: dumpsterRepo,
+		ownership:    ownership,
+		logger:       logger,
+	}
+}
+
+func (s *pricingRuleService) List(ctx context.Context, dumpsterID string) ([]dto.PricingRuleResponse, error) {
+	dumpsterUUID, err := uuid.Parse(dumpsterID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid dumpster ID")
+	}
+
+	if _, err := s.dumpsterRepo.GetByID(ctx, dumpsterUUID, repository.WithPreload()); err != nil {
+		return nil, err
+	}
+
+	rules, err := s.ruleRepo.GetByDumpsterID(ctx, dumpsterUUID)
+	if err != nil {
+		s.logger.Error("failed to list pricing rules", zap.String("dumpsterId", dumpsterID), zap.Error(err))
+		return nil, err
+	}
+
+	responses := make([]dto.PricingRuleResponse, len(rules))
+	for i := range rules {
+		responses[i] = rules[i].ToResponse()
+	}
+
+	return responses, nil
+}
+
+func (s *pricingRuleService) Create(
+	ctx context.Context,
+	ownerID, dumpsterID string,
+	req dto.PricingRuleRequest) (*dto.PricingRuleResponse, error) {
+	dumpster, err := s.getOwnedDumpster(ctx, ownerID, dumpsterID)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := &model.PricingRule{DumpsterID: dumpster.ID}
+	if err := applyPricingRuleRequest(rule, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.ruleRepo.Create(ctx, rule); err != nil {
+		s.logger.Error("failed to create pricing rule", zap.String("dumpsterId", dumpsterID), zap.Error(err))
+		return nil, err
+	}
+
+	response := rule.ToResponse()
+	return &response, nil
+}
+
+func (s *pricingRuleService) Update(
+	ctx context.Context,
+	ownerID, dumpsterID, ruleID string,
+	req dto.PricingRuleRequest) (*dto.PricingRuleResponse, error) {
+	dumpster, err := s.getOwnedDumpster(ctx, ownerID, dumpsterID)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleUUID, err := uuid.Parse(ruleID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid pricing rule ID")
+	}
+
+	rule, err := s.ruleRepo.GetByID(ctx, dumpster.ID, ruleUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applyPricingRuleRequest(rule, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.ruleRepo.Update(ctx, rule); err != nil {
+		s.logger.Error("failed to update pricing rule", zap.String("ruleId", ruleID), zap.Error(err))
+		return nil, err
+	}
+
+	response := rule.ToResponse()
+	return &response, nil
+}
+
+func (s *pricingRuleService) Delete(ctx context.Context, ownerID, dumpsterID, ruleID string) error {
+	dumpster, err := s.getOwnedDumpster(ctx, ownerID, dumpsterID)
+	if err != nil {
+		return err
+	}
+
+	ruleUUID, err := uuid.Parse(ruleID)
+	if err != nil {
+		return apperrors.BadRequest("invalid pricing rule ID")
+	}
+
+	return s.ruleRepo.Delete(ctx, dumpster.ID, ruleUUID)
+}
+
+// Quote prices [start, end) for the dumpster, applying its pricing rules
+// day by day with PricePerDay as the fallback.
+func (s *pricingRuleService) Quote(
+	ctx context.Context,
+	dumpster *model.Dumpster,
+	start, end time.Time) (money.Amount, error) {
+	rules, err := s.ruleRepo.GetByDumpsterID(ctx, dumpster.ID)
+	if err != nil {
+		s.logger.Error("failed to load pricing rules", zap.String("dumpsterId", dumpster.ID.String()), zap.Error(err))
+		return 0, err
+	}
+
+	return model.PriceForPeriod(dumpster.PricePerDay, rules, start, end), nil
+}
+
+func (s *pricingRuleService) getOwnedDumpster(ctx context.Context, ownerID, dumpsterID string) (*model.Dumpster, error) {
+	ownerUUID, err := uuid.Parse(ownerID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid owner ID")
+	}
+
+	dumpsterUUID, err := uuid.Parse(dumpsterID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid dumpster ID")
+	}
+
+	dumpster, err := s.dumpsterRepo.GetByID(ctx, dumpsterUUID, repository.WithPreload())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ownership.Check(dumpster.OwnerID, ownerUUID, "dumpster", "manage pricing of"); err != nil {
+		return nil, err
+	}
+
+	return dumpster, nil
+}
+
+func applyPricingRuleRequest(rule *model.PricingRule, req dto.PricingRuleRequest) error {
+	if len(req.Label) > maxPricingRuleLabelLen {
+		return apperrors.BadRequest("label must be at most 100 characters")
+	}
+
+	hasRange := req.StartDate != "" || req.EndDate != ""
+	if (req.DayOfWeek != nil) == hasRange {
+		return apperrors.BadRequest("set either dayOfWeek or startDate and endDate")
+	}
+
+	if (req.Multiplier != nil) == (req.PriceOverride != nil) {
+		return apperrors.BadRequest("set either multiplier or priceOverride")
+	}
+
+	if req.Multiplier != nil && (*req.Multiplier <= 0 || *req.Multiplier > maxPricingMultiplier) {
+		return apperrors.BadRequest("multiplier must be greater than 0 and at most 100")
+	}
+
+	if req.PriceOverride != nil && *req.PriceOverride <= 0 {
+		return apperrors.BadRequest("priceOverride must be greater than 0")
+	}
+
+	rule.DayOfWeek, rule.StartDate, rule.EndDate = nil, nil, nil
+
+	if req.DayOfWeek != nil {
+		if *req.DayOfWeek < 0 || *req.DayOfWeek > 6 {
+			return apperrors.BadRequest("dayOfWeek must be between 0 (Sunday) and 6 (Saturday)")
+		}
+		day := time.Weekday(*req.DayOfWeek)
+		rule.DayOfWeek = &day
+	} else {
+		start, err := time.Parse(time.DateOnly, req.StartDate)
+		if err != nil {
+			return apperrors.BadRequest("startDate must be formatted as YYYY-MM-DD")
+		}
+		end, err := time.Parse(time.DateOnly, req.EndDate)
+		if err != nil {
+			return apperrors.BadRequest("endDate must be formatted as YYYY-MM-DD")
+		}
+		if end.Before(start) {
+			return apperrors.BadRequest("endDate must not be before startDate")
+		}
+		rule.StartDate = &start
+		rule.EndDate = &end
+	}
+
+	rule.Label = req.Label
+	rule.Multiplier = req.Multiplier
+	rule.PriceOverride = req.PriceOverride
+
+	return nil
+}
@@ -2,9 +2,17 @@ package service
 
 import (
 	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
 	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
 	"waste-space/internal/dto"
 	"waste-space/internal/model"
+	"waste-space/internal/storage/cache"
 	"waste-space/internal/storage/repository"
 	apperrors "waste-space/pkg/errors"
 
@@ -15,26 +23,60 @@ import (
 type ReviewService interface {
 	Create(ctx context.Context, userID, dumpsterID string, req dto.CreateReviewRequest) (*dto.ReviewResponse, error)
 	GetByID(ctx context.Context, id string) (*dto.ReviewResponse, error)
-	Update(ctx context.Context, userID, id string, req dto.UpdateReviewRequest) (*dto.ReviewResponse, error)
+	GetThread(ctx context.Context, id string) (*dto.ReviewThreadResponse, error)
+	GetMine(ctx context.Context, userID, dumpsterID string) (*dto.ReviewResponse, error)
+	Update(ctx context.Context, userID, id string, isAdmin bool, req dto.UpdateReviewRequest) (*dto.ReviewResponse, error)
 	Delete(ctx context.Context, userID, id string) error
 	GetByDumpsterID(ctx context.Context, dumpsterID string, req dto.ReviewListRequest) (*dto.ReviewListResponse, error)
+	GetSentimentSummary(ctx context.Context, dumpsterID string) (*dto.ReviewSentimentResponse, error)
+	GetSummariesForDumpsters(ctx context.Context, ids []string) (map[string]dto.ReviewSummary, error)
 	GetByUserID(ctx context.Context, userID string, req dto.ReviewListRequest) (*dto.ReviewListResponse, error)
+	Vote(ctx context.Context, userID, id string, req dto.ReviewVoteRequest) (*dto.ReviewResponse, error)
+	RemoveVote(ctx context.Context, userID, id string) (*dto.ReviewResponse, error)
+	ExportReceived(ctx context.Context, ownerID string, w io.Writer) error
+	GetUnanswered(ctx context.Context, ownerID string, req dto.ReviewListRequest) (*dto.ReviewListResponse, error)
+	ListForModeration(ctx context.Context, req dto.AdminReviewListRequest) (*dto.ReviewListResponse, error)
+	Remove(ctx context.Context, id string) error
+}
+
+const maxReviewSummaryBatch = 50
+
+var receivedReviewCSVHeader = []string{"dumpster", "rating", "comment", "reviewer", "date"}
+
+type ReviewServiceConfig struct {
+	// EditWindow is how long after creation authors may edit a review; zero
+	// means no limit.
+	EditWindow          time.Duration
+	LowRatingThreshold  int
+	LowRatingMinComment int
 }
 
 type reviewService struct {
-	reviewRepo   repository.ReviewRepository
-	dumpsterRepo repository.DumpsterRepository
-	logger       *zap.Logger
+	reviewRepo     repository.ReviewRepository
+	reviewVoteRepo repository.ReviewVoteRepository
+	dumpsterRepo   repository.DumpsterRepository
+	byID           cache.DumpsterCache
+	ownership      *OwnershipGuard
+	cfg            ReviewServiceConfig
+	logger         *zap.Logger
 }
 
 func NewReviewService(
 	reviewRepo repository.ReviewRepository,
+	reviewVoteRepo repository.ReviewVoteRepository,
 	dumpsterRepo repository.DumpsterRepository,
+	byID cache.DumpsterCache,
+	ownership *OwnershipGuard,
+	cfg ReviewServiceConfig,
 	logger *zap.Logger) ReviewService {
 	return &reviewService{
-		reviewRepo:   reviewRepo,
-		dumpsterRepo: dumpsterRepo,
-		logger:       logger,
+		reviewRepo:     reviewRepo,
+		reviewVoteRepo: reviewVoteRepo,
+		dumpsterRepo:   dumpsterRepo,
+		byID:           byID,
+		ownership:      ownership,
+		cfg:            cfg,
+		logger:         logger,
 	}
 }
 
@@ -52,7 +94,7 @@ func (s *reviewService) Create(
 		return nil, apperrors.BadRequest("invalid dumpster ID")
 	}
 
-	if _, err := s.dumpsterRepo.GetByID(ctx, dumpsterUUID); err != nil {
+	if _, err := s.dumpsterRepo.GetByID(ctx, dumpsterUUID, repository.WithPreload()); err != nil {
 		return nil, err
 	}
 
@@ -67,6 +109,10 @@ func (s *reviewService) Create(
 
 	review := model.NewReviewFromDTO(userUUID, dumpsterUUID, req)
 
+	if err := s.checkComment(review); err != nil {
+		return nil, err
+	}
+
 	if err := s.reviewRepo.Create(ctx, review); err != nil {
 		s.logger.Error("failed to create review", zap.String("userId", userID), zap.String("dumpsterId", dumpsterID), zap.Error(err))
 		return nil, err
@@ -77,7 +123,7 @@ func (s *reviewService) Create(
 		return nil, err
 	}
 
-	response := review.ToResponse()
+	response := s.toResponse(review)
 	return &response, nil
 }
 
@@ -92,13 +138,78 @@ func (s *reviewService) GetByID(ctx context.Context, id string) (*dto.ReviewResp
 		return nil, err
 	}
 
-	response := review.ToResponse()
+	response := s.toResponse(review)
 	return &response, nil
 }
 
+// GetThread returns the review with vote totals recounted from the votes.
+func (s *reviewService) GetThread(ctx context.Context, id string) (*dto.ReviewThreadResponse, error) {
+	reviewID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid review ID")
+	}
+
+	review, err := s.reviewRepo.GetByID(ctx, reviewID)
+	if err != nil {
+		s.logger.Error("failed to get review thread", zap.String("reviewId", id), zap.Error(err))
+		return nil, err
+	}
+
+	helpful, notHelpful, err := s.reviewVoteRepo.GetCounts(ctx, review.ID)
+	if err != nil {
+		s.logger.Error("failed to count review votes", zap.String("reviewId", id), zap.Error(err))
+		return nil, err
+	}
+
+	response := &dto.ReviewThreadResponse{
+		Review: s.toResponse(review),
+		Votes: dto.ReviewVoteSummary{
+			Helpful:    helpful,
+			NotHelpful: notHelpful,
+			Total:      helpful + notHelpful,
+		},
+	}
+
+	if review.OwnerReply != nil && review.OwnerRepliedAt != nil {
+		response.Reply = &dto.ReviewReply{
+			Text:      *review.OwnerReply,
+			RepliedAt: *review.OwnerRepliedAt,
+		}
+	}
+
+	return response, nil
+}
+
+func (s *reviewService) GetMine(ctx context.Context, userID, dumpsterID string) (*dto.ReviewResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	dumpsterUUID, err := uuid.Parse(dumpsterID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid dumpster ID")
+	}
+
+	review, err := s.reviewRepo.GetByUserAndDumpster(ctx, userUUID, dumpsterUUID)
+	if err != nil {
+		s.logger.Error("failed to get own review", zap.String("userId", userID), zap.String("dumpsterId", dumpsterID), zap.Error(err))
+		return nil, err
+	}
+	if review == nil {
+		return nil, apperrors.NotFound("you have not reviewed this dumpster")
+	}
+
+	response := s.toResponse(review)
+	return &response, nil
+}
+
+// Update applies the author's changes. Once the edit window has closed only
+// admins may still edit their own reviews.
 func (s *reviewService) Update(
 	ctx context.Context,
 	userID, id string,
+	isAdmin bool,
 	req dto.UpdateReviewRequest) (*dto.ReviewResponse, error) {
 	reviewID, err := uuid.Parse(id)
 	if err != nil {
@@ -115,12 +226,20 @@ func (s *reviewService) Update(
 		return nil, err
 	}
 
-	if review.UserID != userUUID {
-		return nil, apperrors.Forbidden("you don't have permission to update this review")
+	if err := s.ownership.Check(review.UserID, userUUID, "review", "update"); err != nil {
+		return nil, err
+	}
+
+	if !isAdmin && !review.IsEditable(s.cfg.EditWindow, time.Now()) {
+		return nil, apperrors.Forbidden("the edit window for this review has closed")
 	}
 
 	s.applyReviewUpdates(review, req)
 
+	if err := s.checkComment(review); err != nil {
+		return nil, err
+	}
+
 	if err := s.reviewRepo.Update(ctx, review); err != nil {
 		s.logger.Error("failed to update review", zap.String("reviewId", id), zap.Error(err))
 		return nil, err
@@ -131,7 +250,7 @@ func (s *reviewService) Update(
 		return nil, err
 	}
 
-	response := review.ToResponse()
+	response := s.toResponse(review)
 	return &response, nil
 }
 
@@ -151,14 +270,32 @@ func (s *reviewService) Delete(ctx context.Context, userID, id string) error {
 		return err
 	}
 
-	if review.UserID != userUUID {
-		return apperrors.Forbidden("you don't have permission to delete this review")
+	if err := s.ownership.Check(review.UserID, userUUID, "review", "delete"); err != nil {
+		return err
+	}
+
+	return s.deleteReview(ctx, review, model.ReviewDeletedByAuthor)
+}
+
+func (s *reviewService) Remove(ctx context.Context, id string) error {
+	reviewID, err := uuid.Parse(id)
+	if err != nil {
+		return apperrors.BadRequest("invalid review ID")
+	}
+
+	review, err := s.reviewRepo.GetByID(ctx, reviewID)
+	if err != nil {
+		return err
 	}
 
+	return s.deleteReview(ctx, review, model.ReviewRemovedByModerator)
+}
+
+func (s *reviewService) deleteReview(ctx context.Context, review *model.Review, reason model.ReviewDeletionReason) error {
 	dumpsterID := review.DumpsterID
 
-	if err := s.reviewRepo.Delete(ctx, reviewID); err != nil {
-		s.logger.Error("failed to delete review", zap.String("reviewId", id), zap.Error(err))
+	if err := s.reviewRepo.Delete(ctx, review.ID, reason); err != nil {
+		s.logger.Error("failed to delete review", zap.String("reviewId", review.ID.String()), zap.Error(err))
 		return err
 	}
 
@@ -170,6 +307,36 @@ func (s *reviewService) Delete(ctx context.Context, userID, id string) error {
 	return nil
 }
 
+func (s *reviewService) ListForModeration(
+	ctx context.Context,
+	req dto.AdminReviewListRequest) (*dto.ReviewListResponse, error) {
+	var dumpsterUUID, userUUID *uuid.UUID
+
+	if req.DumpsterID != "" {
+		parsed, err := uuid.Parse(req.DumpsterID)
+		if err != nil {
+			return nil, apperrors.BadRequest("invalid dumpster ID")
+		}
+		dumpsterUUID = &parsed
+	}
+
+	if req.UserID != "" {
+		parsed, err := uuid.Parse(req.UserID)
+		if err != nil {
+			return nil, apperrors.BadRequest("invalid user ID")
+		}
+		userUUID = &parsed
+	}
+
+	reviews, total, err := s.reviewRepo.ListForModeration(ctx, dumpsterUUID, userUUID, req)
+	if err != nil {
+		s.logger.Error("failed to list reviews for moderation", zap.Error(err))
+		return nil, err
+	}
+
+	return s.buildReviewListResponse(reviews, total, req.Page, req.Limit), nil
+}
+
 func (s *reviewService) GetByDumpsterID(
 	ctx context.Context,
 	dumpsterID string,
@@ -188,6 +355,88 @@ func (s *reviewService) GetByDumpsterID(
 	return s.buildReviewListResponse(reviews, total, req.Page, req.Limit), nil
 }
 
+// GetSummariesForDumpsters returns the average rating and review count of up
+// to maxReviewSummaryBatch dumpsters, keyed by dumpster ID. IDs that match no
+// dumpster are left out.
+func (s *reviewService) GetSummariesForDumpsters(ctx context.Context, ids []string) (map[string]dto.ReviewSummary, error) {
+	dumpsterIDs := make([]uuid.UUID, 0, len(ids))
+	seen := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			continue
+		}
+
+		dumpsterID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, apperrors.BadRequest("invalid dumpster ID " + id)
+		}
+		if !seen[dumpsterID] {
+			seen[dumpsterID] = true
+			dumpsterIDs = append(dumpsterIDs, dumpsterID)
+		}
+	}
+
+	if len(dumpsterIDs) == 0 {
+		return nil, apperrors.BadRequest("ids is required")
+	}
+
+	if len(dumpsterIDs) > maxReviewSummaryBatch {
+		return nil, apperrors.BadRequest(fmt.Sprintf("at most %d dumpsters can be summarized at once", maxReviewSummaryBatch))
+	}
+
+	summaries, err := s.reviewRepo.GetSummaries(ctx, dumpsterIDs)
+	if err != nil {
+		s.logger.Error("failed to summarize reviews", zap.Error(err))
+		return nil, err
+	}
+
+	responses := make(map[string]dto.ReviewSummary, len(summaries))
+	for id, summary := range summaries {
+		summary.Average = math.Round(summary.Average*100) / 100
+		responses[id.String()] = summary
+	}
+
+	return responses, nil
+}
+
+// GetSentimentSummary splits the dumpster's reviews into positive (4-5),
+// neutral (3) and negative (1-2) with each bucket's share of the total.
+func (s *reviewService) GetSentimentSummary(ctx context.Context, dumpsterID string) (*dto.ReviewSentimentResponse, error) {
+	dumpsterUUID, err := uuid.Parse(dumpsterID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid dumpster ID")
+	}
+
+	if _, err := s.dumpsterRepo.GetByID(ctx, dumpsterUUID, repository.WithPreload()); err != nil {
+		return nil, err
+	}
+
+	counts, err := s.reviewRepo.GetSentimentCounts(ctx, dumpsterUUID)
+	if err != nil {
+		s.logger.Error("failed to get review sentiment", zap.String("dumpsterId", dumpsterID), zap.Error(err))
+		return nil, err
+	}
+
+	total := counts.Positive + counts.Neutral + counts.Negative
+
+	return &dto.ReviewSentimentResponse{
+		DumpsterID: dumpsterID,
+		Total:      total,
+		Positive:   sentimentBucket(counts.Positive, total),
+		Neutral:    sentimentBucket(counts.Neutral, total),
+		Negative:   sentimentBucket(counts.Negative, total),
+	}, nil
+}
+
+func sentimentBucket(count, total int64) dto.SentimentBucket {
+	bucket := dto.SentimentBucket{Count: count}
+	if total > 0 {
+		bucket.Percentage = math.Round(float64(count)/float64(total)*1000) / 10
+	}
+	return bucket
+}
+
 func (s *reviewService) GetByUserID(
 	ctx context.Context,
 	userID string,
@@ -206,6 +455,111 @@ func (s *reviewService) GetByUserID(
 	return s.buildReviewListResponse(reviews, total, req.Page, req.Limit), nil
 }
 
+// GetUnanswered is the owner's reply queue: reviews on their dumpsters
+// without an owner reply, oldest first.
+func (s *reviewService) GetUnanswered(
+	ctx context.Context,
+	ownerID string,
+	req dto.ReviewListRequest) (*dto.ReviewListResponse, error) {
+	ownerUUID, err := uuid.Parse(ownerID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	reviews, total, err := s.reviewRepo.GetUnansweredByOwner(ctx, ownerUUID, req)
+	if err != nil {
+		s.logger.Error("failed to get unanswered reviews", zap.String("ownerId", ownerID), zap.Error(err))
+		return nil, err
+	}
+
+	return s.buildReviewListResponse(reviews, total, req.Page, req.Limit), nil
+}
+
+func (s *reviewService) Vote(
+	ctx context.Context,
+	userID, id string,
+	req dto.ReviewVoteRequest) (*dto.ReviewResponse, error) {
+	if req.Helpful == nil {
+		return nil, apperrors.BadRequest("helpful is required")
+	}
+
+	review, userUUID, err := s.getReviewForVote(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	vote := model.NewReviewVote(userUUID, review.ID, *req.Helpful)
+	if err := s.reviewVoteRepo.Upsert(ctx, vote); err != nil {
+		s.logger.Error("failed to save review vote", zap.String("reviewId", id), zap.String("userId", userID), zap.Error(err))
+		return nil, err
+	}
+
+	if err := s.updateVoteCounts(ctx, review); err != nil {
+		return nil, err
+	}
+
+	response := s.toResponse(review)
+	return &response, nil
+}
+
+func (s *reviewService) RemoveVote(ctx context.Context, userID, id string) (*dto.ReviewResponse, error) {
+	review, userUUID, err := s.getReviewForVote(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.reviewVoteRepo.Delete(ctx, review.ID, userUUID); err != nil {
+		return nil, err
+	}
+
+	if err := s.updateVoteCounts(ctx, review); err != nil {
+		return nil, err
+	}
+
+	response := s.toResponse(review)
+	return &response, nil
+}
+
+func (s *reviewService) getReviewForVote(ctx context.Context, userID, id string) (*model.Review, uuid.UUID, error) {
+	reviewID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, uuid.Nil, apperrors.BadRequest("invalid review ID")
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, uuid.Nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	review, err := s.reviewRepo.GetByID(ctx, reviewID)
+	if err != nil {
+		return nil, uuid.Nil, err
+	}
+
+	if review.UserID == userUUID {
+		return nil, uuid.Nil, apperrors.BadRequest("you cannot vote on your own review")
+	}
+
+	return review, userUUID, nil
+}
+
+func (s *reviewService) updateVoteCounts(ctx context.Context, review *model.Review) error {
+	helpful, notHelpful, err := s.reviewVoteRepo.GetCounts(ctx, review.ID)
+	if err != nil {
+		s.logger.Error("failed to count review votes", zap.String("reviewId", review.ID.String()), zap.Error(err))
+		return err
+	}
+
+	if err := s.reviewRepo.UpdateVoteCounts(ctx, review.ID, helpful, notHelpful); err != nil {
+		s.logger.Error("failed to save review vote counts", zap.String("reviewId", review.ID.String()), zap.Error(err))
+		return err
+	}
+
+	review.HelpfulCount = helpful
+	review.NotHelpfulCount = notHelpful
+	return nil
+}
+
 func (s *reviewService) applyReviewUpdates(review *model.Review, req dto.UpdateReviewRequest) {
 	if req.Rating != nil {
 		review.Rating = *req.Rating
@@ -215,6 +569,22 @@ func (s *reviewService) applyReviewUpdates(review *model.Review, req dto.UpdateR
 	}
 }
 
+func (s *reviewService) checkComment(review *model.Review) error {
+	if s.cfg.LowRatingThreshold == 0 || review.Rating > s.cfg.LowRatingThreshold {
+		return nil
+	}
+
+	minLength := max(s.cfg.LowRatingMinComment, 1)
+	length := utf8.RuneCountInString(strings.TrimSpace(review.Comment))
+	if length >= minLength {
+		return nil
+	}
+
+	return apperrors.Validation(fmt.Sprintf(
+		"ratings of %d or below need a comment of at least %d characters; got %d",
+		s.cfg.LowRatingThreshold, minLength, length))
+}
+
 func (s *reviewService) updateDumpsterRating(ctx context.Context, dumpsterID uuid.UUID) error {
 	avgRating, err := s.reviewRepo.GetAverageRating(ctx, dumpsterID)
 	if err != nil {
@@ -228,7 +598,7 @@ func (s *reviewService) updateDumpsterRating(ctx context.Context, dumpsterID uui
 		return err
 	}
 
-	dumpster, err := s.dumpsterRepo.GetByID(ctx, dumpsterID)
+	dumpster, err := s.dumpsterRepo.GetByID(ctx, dumpsterID, repository.WithPreload())
 	if err != nil {
 		s.logger.Error("failed to get dumpster for rating update", zap.String("dumpsterId", dumpsterID.String()), zap.Error(err))
 		return err
@@ -241,29 +611,62 @@ func (s *reviewService) updateDumpsterRating(ctx context.Context, dumpsterID uui
 		s.logger.Error("failed to save updated dumpster rating", zap.String("dumpsterId", dumpsterID.String()), zap.Error(err))
 		return err
 	}
+	invalidateDumpsterCache(ctx, s.byID, s.logger, dumpsterID)
 
 	return nil
 }
 
+func (s *reviewService) toResponse(review *model.Review) dto.ReviewResponse {
+	response := review.ToResponse()
+	response.Editable = !review.DeletedAt.Valid && review.IsEditable(s.cfg.EditWindow, time.Now())
+	return response
+}
+
 func (s *reviewService) buildReviewListResponse(
 	reviews []*model.Review,
 	total int64,
 	page, limit int) *dto.ReviewListResponse {
-	page = max(page, 1)
-	limit = max(limit, 1)
-
 	responses := make([]dto.ReviewResponse, len(reviews))
 	for i, review := range reviews {
-		responses[i] = review.ToResponse()
+		responses[i] = s.toResponse(review)
+	}
+
+	return dto.NewPaginatedResponse(responses, total, page, limit)
+}
+
+// ExportReceived streams every review on the owner's dumpsters to w as CSV.
+func (s *reviewService) ExportReceived(ctx context.Context, ownerID string, w io.Writer) error {
+	ownerUUID, err := uuid.Parse(ownerID)
+	if err != nil {
+		return apperrors.BadRequest("invalid owner ID")
 	}
 
-	totalPages := int(math.Ceil(float64(total) / float64(limit)))
+	writer := csv.NewWriter(w)
+	if err := writer.Write(receivedReviewCSVHeader); err != nil {
+		return err
+	}
 
-	return &dto.ReviewListResponse{
-		Reviews:    responses,
-		Total:      total,
-		Page:       page,
-		Limit:      limit,
-		TotalPages: totalPages,
+	err = s.reviewRepo.StreamReceivedByOwner(ctx, ownerUUID, func(row dto.ReceivedReviewRow) error {
+		return writer.Write([]string{
+			csvSafe(row.DumpsterTitle),
+			strconv.Itoa(row.Rating),
+			csvSafe(row.Comment),
+			csvSafe(strings.TrimSpace(row.ReviewerFirstName + " " + row.ReviewerLastName)),
+			row.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	})
+	if err != nil {
+		s.logger.Error("failed to export received reviews", zap.String("ownerId", ownerID), zap.Error(err))
+		return err
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func csvSafe(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
 	}
+	return value
 }
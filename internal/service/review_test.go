@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"waste-space/internal/dto"
+	"waste-space/internal/model"
+	"waste-space/internal/storage/repository"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// errReviewSaved stops Update right after the edit-window check passes, so
+// the test needs no fakes for the rating recalculation that follows.
+var errReviewSaved = errors.New("review saved")
+
+type fakeReviewRepo struct {
+	repository.ReviewRepository
+	review *model.Review
+}
+
+func (r *fakeReviewRepo) GetByID(_ context.Context, _ uuid.UUID) (*model.Review, error) {
+	return r.review, nil
+}
+
+func (r *fakeReviewRepo) Update(_ context.Context, _ *model.Review) error {
+	return errReviewSaved
+}
+
+func TestReviewUpdateEditWindow(t *testing.T) {
+	const window = time.Hour
+	author := uuid.New()
+	rating := 4
+
+	tests := []struct {
+		name    string
+		age     time.Duration
+		isAdmin bool
+		window  time.Duration
+		wantErr apperrors.ErrorType
+	}{
+		{name: "just inside the window", age: window - time.Minute, window: window},
+		{name: "just outside the window", age: window + time.Second, window: window, wantErr: apperrors.ErrorTypeForbidden},
+		{name: "admin outside the window", age: window + time.Second, window: window, isAdmin: true},
+		{name: "zero window never closes", age: 365 * 24 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			review := &model.Review{
+				ID:        uuid.New(),
+				UserID:    author,
+				Rating:    5,
+				CreatedAt: time.Now().Add(-tt.age),
+			}
+			svc := NewReviewService(&fakeReviewRepo{review: review}, nil, nil, nil,
+				NewOwnershipGuard(OwnershipPolicyForbidden),
+				ReviewServiceConfig{EditWindow: tt.window}, zap.NewNop())
+
+			_, err := svc.Update(context.Background(), author.String(), review.ID.String(), tt.isAdmin,
+				dto.UpdateReviewRequest{Rating: &rating})
+
+			if tt.wantErr == "" {
+				if !errors.Is(err, errReviewSaved) {
+					t.Fatalf("Update() = %v, want the review to be saved", err)
+				}
+				return
+			}
+			if !apperrors.Is(err, tt.wantErr) {
+				t.Fatalf("Update() = %v, want %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestReviewCheckComment(t *testing.T) {
+	svc := &reviewService{cfg: ReviewServiceConfig{LowRatingThreshold: 2, LowRatingMinComment: 5}}
+
+	tests := []struct {
+		name    string
+		rating  int
+		comment string
+		wantErr bool
+	}{
+		{name: "above threshold without comment", rating: 3, comment: ""},
+		{name: "at threshold without comment", rating: 2, comment: "", wantErr: true},
+		{name: "below threshold without comment", rating: 1, comment: "", wantErr: true},
+		{name: "at threshold, comment at minimum", rating: 2, comment: "dirty"},
+		{name: "at threshold, comment below minimum", rating: 2, comment: "bad!", wantErr: true},
+		{name: "surrounding whitespace doesn't count", rating: 2, comment: "  bad!  ", wantErr: true},
+		{name: "length counts characters, not bytes", rating: 2, comment: "złość"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := svc.checkComment(&model.Review{Rating: tt.rating, Comment: tt.comment})
+			if tt.wantErr {
+				if !apperrors.Is(err, apperrors.ErrorTypeValidation) {
+					t.Fatalf("checkComment() = %v, want a validation error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("checkComment() = %v, want nil", err)
+			}
+		})
+	}
+}
+
+func TestReviewCheckCommentDefaults(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     ReviewServiceConfig
+		comment string
+		wantErr bool
+	}{
+		{name: "zero threshold disables the rule", cfg: ReviewServiceConfig{LowRatingMinComment: 5}},
+		{name: "zero minimum still needs a comment", cfg: ReviewServiceConfig{LowRatingThreshold: 2}, wantErr: true},
+		{name: "zero minimum accepts one character", cfg: ReviewServiceConfig{LowRatingThreshold: 2}, comment: "x"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &reviewService{cfg: tt.cfg}
+			err := svc.checkComment(&model.Review{Rating: 1, Comment: tt.comment})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("checkComment() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
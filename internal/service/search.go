@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+	"waste-space/internal/dto"
+	"waste-space/internal/storage/repository"
+	apperrors "waste-space/pkg/errors"
+
+	"go.uber.org/zap"
+)
+
+const (
+	minGlobalSearchLength    = 2
+	defaultGlobalSearchLimit = 5
+	maxGlobalSearchLimit     = 20
+)
+
+type SearchService interface {
+	Global(ctx context.Context, query string, limit int) (*dto.GlobalSearchResponse, error)
+}
+
+type searchService struct {
+	dumpsterRepo repository.DumpsterRepository
+	reviewRepo   repository.ReviewRepository
+	logger       *zap.Logger
+}
+
+func NewSearchService(
+	dumpsterRepo repository.DumpsterRepository,
+	reviewRepo repository.ReviewRepository,
+	logger *zap.Logger) SearchService {
+	return &searchService{
+		dumpsterRepo: dumpsterRepo,
+		reviewRepo:   reviewRepo,
+		logger:       logger,
+	}
+}
+
+// Global searches listings and review text at once. Each kind is capped at
+// limit; listings come first, best rated, then reviews, newest first. Only
+// what the public can browse is searched: published listings of active
+// owners, and live reviews of those listings.
+func (s *searchService) Global(ctx context.Context, query string, limit int) (*dto.GlobalSearchResponse, error) {
+	query = strings.TrimSpace(query)
+	if utf8.RuneCountInString(query) < minGlobalSearchLength {
+		return nil, apperrors.BadRequest(fmt.Sprintf("query must be at least %d characters", minGlobalSearchLength))
+	}
+
+	if limit < 0 || limit > maxGlobalSearchLimit {
+		return nil, apperrors.BadRequest(fmt.Sprintf("limit must be between 1 and %d", maxGlobalSearchLimit))
+	}
+	if limit == 0 {
+		limit = defaultGlobalSearchLimit
+	}
+
+	dumpsters, err := s.dumpsterRepo.SearchText(ctx, query, limit, repository.WithPreload())
+	if err != nil {
+		s.logger.Error("failed to search dumpsters", zap.Error(err))
+		return nil, err
+	}
+
+	reviews, err := s.reviewRepo.SearchText(ctx, query, limit)
+	if err != nil {
+		s.logger.Error("failed to search reviews", zap.Error(err))
+		return nil, err
+	}
+
+	results := make([]dto.SearchHit, 0, len(dumpsters)+len(reviews))
+	for _, dumpster := range dumpsters {
+		response := dumpster.ToResponse()
+		results = append(results, dto.SearchHit{Type: dto.SearchHitTypeDumpster, Dumpster: &response})
+	}
+	for _, review := range reviews {
+		hit := dto.SearchHit{Type: dto.SearchHitTypeReview}
+		reviewResponse := review.ToResponse()
+		hit.Review = &reviewResponse
+		if review.Dumpster != nil {
+			dumpsterResponse := review.Dumpster.ToResponse()
+			hit.Dumpster = &dumpsterResponse
+		}
+		results = append(results, hit)
+	}
+
+	return &dto.GlobalSearchResponse{
+		Query:         query,
+		Results:       results,
+		DumpsterCount: len(dumpsters),
+		ReviewCount:   len(reviews),
+	}, nil
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"waste-space/internal/dto"
+	"waste-space/internal/model"
+	apperrors "waste-space/pkg/errors"
+)
+
+const sizeGuideSearchPath = "/api/v1/dumpsters/search"
+
+type sizeGuideEntry struct {
+	size      model.DumpsterSize
+	rationale string
+}
+
+var defaultSizeGuide = map[string]sizeGuideEntry{
+	"garage-cleanout": {model.DumpsterSizeSmall,
+		"Household clutter from a single garage is bulky but light and rarely fills more than a small container."},
+	"yard-cleanup": {model.DumpsterSizeSmall,
+		"Branches, leaves and soil from a typical yard fit a small container; dirt is heavy, so don't overfill."},
+	"bathroom-remodel": {model.DumpsterSizeSmall,
+		"One bathroom's tile, fixtures and drywall fit a small container."},
+	"kitchen-remodel": {model.DumpsterSizeMedium,
+		"Cabinets, countertops and appliances from one kitchen need a medium container."},
+	"flooring-replacement": {model.DumpsterSizeMedium,
+		"Carpet, padding or hardwood from a few rooms fits a medium container."},
+	"estate-cleanout": {model.DumpsterSizeLarge,
+		"Furniture and contents of a whole home take up volume quickly; a large container avoids a second haul."},
+	"roof-tear-off": {model.DumpsterSizeLarge,
+		"Shingles are heavy; a large container holds the tear-off of an average roof within weight limits."},
+	"new-construction": {model.DumpsterSizeExtraLarge,
+		"Framing offcuts, packaging and drywall from a build add up to an extra-large container."},
+	"demolition": {model.DumpsterSizeExtraLarge,
+		"Tearing down a structure, or gutting most of a house, produces more debris than any smaller size holds."},
+}
+
+var sizeRationales = map[model.DumpsterSize]string{
+	model.DumpsterSizeSmall:      "A small container suits light cleanouts and single-room jobs.",
+	model.DumpsterSizeMedium:     "A medium container suits a single-room remodel.",
+	model.DumpsterSizeLarge:      "A large container suits whole-home cleanouts and heavy roofing debris.",
+	model.DumpsterSizeExtraLarge: "An extra-large container suits construction and demolition.",
+}
+
+// NormalizeSizeGuideProject turns a project name such as "Roof tear off"
+// into the guide's key, "roof-tear-off".
+func NormalizeSizeGuideProject(project string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(project), func(r rune) bool {
+		return r == ' ' || r == '-' || r == '_'
+	}), "-")
+}
+
+// GetSizeGuide returns the recommended size for project, or the whole guide
+// when project is empty, each with a link to a search for that size.
+func (s *dumpsterService) GetSizeGuide(_ context.Context, project string) (*dto.SizeGuideResponse, error) {
+	guide := make(map[string]sizeGuideEntry, len(defaultSizeGuide)+len(s.cfg.SizeGuide))
+	for key, entry := range defaultSizeGuide {
+		guide[key] = entry
+	}
+	for key, size := range s.cfg.SizeGuide {
+		entry := sizeGuideEntry{size: model.DumpsterSize(size), rationale: sizeRationales[model.DumpsterSize(size)]}
+		if existing, ok := guide[key]; ok && existing.size == entry.size {
+			entry.rationale = existing.rationale
+		}
+		guide[key] = entry
+	}
+
+	keys := make([]string, 0, len(guide))
+	if project != "" {
+		key := NormalizeSizeGuideProject(project)
+		if _, ok := guide[key]; !ok {
+			return nil, apperrors.NotFound(fmt.Sprintf("no size guidance for project %q", project))
+		}
+		keys = append(keys, key)
+	} else {
+		for key := range guide {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+	}
+
+	response := &dto.SizeGuideResponse{Entries: make([]dto.SizeGuideEntry, len(keys))}
+	for i, key := range keys {
+		entry := guide[key]
+		response.Entries[i] = dto.SizeGuideEntry{
+			Project:   key,
+			Size:      string(entry.size),
+			Rationale: entry.rationale,
+			SearchURL: sizeGuideSearchPath + "?" + url.Values{"size": {string(entry.size)}, "isAvailable": {"true"}}.Encode(),
+		}
+	}
+
+	return response, nil
+}
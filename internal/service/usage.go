@@ -2,40 +2,90 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"math"
+	"strings"
+	"time"
 	"waste-space/internal/dto"
 	"waste-space/internal/model"
+	"waste-space/internal/storage/cache"
 	"waste-space/internal/storage/repository"
 	apperrors "waste-space/pkg/errors"
+	"waste-space/pkg/impact"
+	"waste-space/pkg/money"
+	"waste-space/pkg/tax"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
+const minutesPerDay = 24.0 * 60.0
+
 type UsageService interface {
 	StartUsage(ctx context.Context, userID, dumpsterID string, req dto.StartUsageRequest) (*dto.UsageResponse, error)
+	CreateDraft(ctx context.Context, userID, dumpsterID string, req dto.CreateUsageDraftRequest) (*dto.UsageResponse, error)
+	UpdateDraft(ctx context.Context, userID, id string, req dto.UpdateUsageDraftRequest) (*dto.UsageResponse, error)
+	Activate(ctx context.Context, userID, id string, req dto.ActivateUsageRequest) (*dto.UsageResponse, error)
 	EndUsage(ctx context.Context, userID, id string, req dto.EndUsageRequest) (*dto.UsageResponse, error)
 	GetByID(ctx context.Context, id string) (*dto.UsageResponse, error)
 	GetByDumpsterID(ctx context.Context, dumpsterID string, req dto.UsageListRequest) (*dto.UsageListResponse, error)
 	GetByUserID(ctx context.Context, userID string, req dto.UsageListRequest) (*dto.UsageListResponse, error)
+	GetMyUsagesForDumpster(ctx context.Context, userID, dumpsterID string, req dto.UsageListRequest) (*dto.UsageListResponse, error)
 	GetStats(ctx context.Context, dumpsterID, userID *string) (*dto.UsageStatsResponse, error)
 	List(ctx context.Context, req dto.UsageListRequest) (*dto.UsageListResponse, error)
-	Delete(ctx context.Context, id string) error
+	Delete(ctx context.Context, userID, id string) error
+	GetReceipt(ctx context.Context, userID, id string) (*dto.UsageReceiptResponse, error)
+	GetRunningCost(ctx context.Context, userID, id string) (*dto.UsageRunningCostResponse, error)
+	GetTrends(ctx context.Context, userID string, isAdmin bool, req dto.UsageTrendsRequest) (*dto.UsageTrendsResponse, error)
+	GetOccupancy(ctx context.Context, userID, dumpsterID string, isAdmin bool, req dto.OccupancyRequest) (*dto.OccupancyResponse, error)
+	GetOutstandingBalance(ctx context.Context, userID string) (*dto.BalanceResponse, error)
+	GetSpendingReport(ctx context.Context, userID string, from, to *time.Time) (*dto.SpendingReportResponse, error)
+	GetImpact(ctx context.Context, userID string) (*dto.ImpactResponse, error)
+	MarkPaid(ctx context.Context, id string) (*dto.UsageResponse, error)
 }
 
+const (
+	defaultTrendGranularity = "day"
+	defaultTrendWindow      = 30 * 24 * time.Hour
+	maxTrendBuckets         = 366
+	defaultSpendingMonths   = 12
+	maxSpendingMonths       = 60
+)
+
 type usageService struct {
 	usageRepo    repository.UsageRepository
 	dumpsterRepo repository.DumpsterRepository
+	blockRepo    repository.OwnerBlockRepository
+	byID         cache.DumpsterCache
+	pricing      PricingRuleService
+	taxCalc      tax.Calculator
+	impact       impact.Estimator
+	ownership    *OwnershipGuard
+	alerts       AvailabilityAlertService
 	logger       *zap.Logger
 }
 
 func NewUsageService(
 	usageRepo repository.UsageRepository,
 	dumpsterRepo repository.DumpsterRepository,
+	blockRepo repository.OwnerBlockRepository,
+	byID cache.DumpsterCache,
+	pricing PricingRuleService,
+	taxCalc tax.Calculator,
+	impactEstimator impact.Estimator,
+	ownership *OwnershipGuard,
+	alerts AvailabilityAlertService,
 	logger *zap.Logger) UsageService {
 	return &usageService{
 		usageRepo:    usageRepo,
 		dumpsterRepo: dumpsterRepo,
+		blockRepo:    blockRepo,
+		byID:         byID,
+		pricing:      pricing,
+		taxCalc:      taxCalc,
+		impact:       impactEstimator,
+		ownership:    ownership,
+		alerts:       alerts,
 		logger:       logger,
 	}
 }
@@ -54,28 +104,191 @@ func (s *usageService) StartUsage(
 		return nil, apperrors.BadRequest("invalid dumpster ID")
 	}
 
-	dumpster, err := s.dumpsterRepo.GetByID(ctx, dumpsterUUID)
+	dumpster, err := s.dumpsterRepo.GetByID(ctx, dumpsterUUID, repository.WithPreload())
 	if err != nil {
 		return nil, err
 	}
 
-	if !dumpster.IsAvailable {
-		return nil, apperrors.BadRequest("dumpster is not available")
+	if err := s.checkCanStart(ctx, userUUID, dumpster); err != nil {
+		return nil, err
 	}
 
-	activeUsage, err := s.usageRepo.GetActiveUsageByUserAndDumpster(ctx, userUUID, dumpsterUUID)
-	if err != nil {
-		s.logger.Error("failed to check active usage", zap.String("userId", userID), zap.String("dumpsterId", dumpsterID), zap.Error(err))
+	usage := model.NewDumpsterUsageFromDTO(userUUID, dumpsterUUID, req)
+
+	create := s.usageRepo.Create
+	if dumpster.ExclusiveUse {
+		create = s.usageRepo.CreateExclusive
+	}
+
+	if err := create(ctx, usage); err != nil {
+		if apperrors.Is(err, apperrors.ErrorTypeAlreadyExists) || apperrors.Is(err, apperrors.ErrorTypeNotFound) {
+			return nil, err
+		}
+		s.logger.Error("failed to create usage", zap.String("userId", userID), zap.String("dumpsterId", dumpsterID), zap.Error(err))
 		return nil, err
 	}
+
+	response := usage.ToResponse()
+	return &response, nil
+}
+
+func (s *usageService) checkCanStart(ctx context.Context, userID uuid.UUID, dumpster *model.Dumpster) error {
+	if !dumpster.IsAvailable || dumpster.IsSnoozed() || dumpster.IsUnpublished() {
+		return apperrors.BadRequest("dumpster is not available")
+	}
+
+	if err := checkNotBlocked(ctx, s.blockRepo, dumpster, userID); err != nil {
+		return err
+	}
+
+	activeUsage, err := s.usageRepo.GetActiveUsageByUserAndDumpster(ctx, userID, dumpster.ID)
+	if err != nil {
+		s.logger.Error("failed to check active usage", zap.String("userId", userID.String()), zap.String("dumpsterId", dumpster.ID.String()), zap.Error(err))
+		return err
+	}
 	if activeUsage != nil {
-		return nil, apperrors.BadRequest("you already have an active usage session for this dumpster")
+		return apperrors.BadRequest("you already have an active usage session for this dumpster")
 	}
 
-	usage := model.NewDumpsterUsageFromDTO(userUUID, dumpsterUUID, req)
+	return nil
+}
+
+// CreateDraft prepares a usage session the user can edit before starting
+// it. Drafts skip the availability and active-session checks; those run
+// when the draft is activated.
+func (s *usageService) CreateDraft(
+	ctx context.Context,
+	userID, dumpsterID string,
+	req dto.CreateUsageDraftRequest) (*dto.UsageResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	dumpsterUUID, err := uuid.Parse(dumpsterID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid dumpster ID")
+	}
+
+	dumpster, err := s.dumpsterRepo.GetByID(ctx, dumpsterUUID, repository.WithPreload())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkNotBlocked(ctx, s.blockRepo, dumpster, userUUID); err != nil {
+		return nil, err
+	}
+
+	usage := model.NewDraftUsageFromDTO(userUUID, dumpsterUUID, req)
 
 	if err := s.usageRepo.Create(ctx, usage); err != nil {
-		s.logger.Error("failed to create usage", zap.String("userId", userID), zap.String("dumpsterId", dumpsterID), zap.Error(err))
+		if apperrors.Is(err, apperrors.ErrorTypeNotFound) {
+			return nil, err
+		}
+		s.logger.Error("failed to create draft usage", zap.String("userId", userID), zap.String("dumpsterId", dumpsterID), zap.Error(err))
+		return nil, err
+	}
+
+	response := usage.ToResponse()
+	return &response, nil
+}
+
+func (s *usageService) UpdateDraft(
+	ctx context.Context,
+	userID, id string,
+	req dto.UpdateUsageDraftRequest) (*dto.UsageResponse, error) {
+	usageID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid usage ID")
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	usage, err := s.usageRepo.GetByID(ctx, usageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ownership.Check(usage.UserID, userUUID, "usage session", "edit"); err != nil {
+		return nil, err
+	}
+
+	if usage.Status != model.UsageStatusDraft {
+		return nil, apperrors.BadRequest("only draft usage sessions can be edited")
+	}
+
+	if req.StartTime != nil {
+		usage.StartTime = *req.StartTime
+	}
+	if req.Notes != nil {
+		usage.Notes = *req.Notes
+	}
+
+	if err := s.usageRepo.UpdateDraft(ctx, usage); err != nil {
+		if apperrors.Is(err, apperrors.ErrorTypeBadRequest) {
+			return nil, err
+		}
+		s.logger.Error("failed to update draft usage", zap.String("usageId", id), zap.Error(err))
+		return nil, err
+	}
+
+	response := usage.ToResponse()
+	return &response, nil
+}
+
+// Activate starts a draft session, running the same guards as StartUsage
+// against the dumpster as it is now.
+func (s *usageService) Activate(
+	ctx context.Context,
+	userID, id string,
+	req dto.ActivateUsageRequest) (*dto.UsageResponse, error) {
+	usageID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid usage ID")
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	usage, err := s.usageRepo.GetByID(ctx, usageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ownership.Check(usage.UserID, userUUID, "usage session", "activate"); err != nil {
+		return nil, err
+	}
+
+	if !usage.Status.CanTransitionTo(model.UsageStatusActive) {
+		return nil, apperrors.BadRequest("only draft usage sessions can be activated")
+	}
+
+	dumpster, err := s.dumpsterRepo.GetByID(ctx, usage.DumpsterID, repository.WithPreload())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkCanStart(ctx, userUUID, dumpster); err != nil {
+		return nil, err
+	}
+
+	usage.StartTime = time.Now()
+	if req.StartTime != nil {
+		usage.StartTime = *req.StartTime
+	}
+
+	if err := s.usageRepo.Activate(ctx, usage, dumpster.ExclusiveUse); err != nil {
+		if apperrors.Is(err, apperrors.ErrorTypeAlreadyExists) ||
+			apperrors.Is(err, apperrors.ErrorTypeNotFound) ||
+			apperrors.Is(err, apperrors.ErrorTypeBadRequest) {
+			return nil, err
+		}
+		s.logger.Error("failed to activate usage", zap.String("usageId", id), zap.Error(err))
 		return nil, err
 	}
 
@@ -102,11 +315,11 @@ func (s *usageService) EndUsage(
 		return nil, err
 	}
 
-	if usage.UserID != userUUID {
-		return nil, apperrors.Forbidden("you don't have permission to end this usage session")
+	if err := s.ownership.Check(usage.UserID, userUUID, "usage session", "end"); err != nil {
+		return nil, err
 	}
 
-	if usage.Status != model.UsageStatusActive {
+	if !usage.Status.CanTransitionTo(model.UsageStatusCompleted) {
 		return nil, apperrors.BadRequest("usage session is not active")
 	}
 
@@ -118,13 +331,17 @@ func (s *usageService) EndUsage(
 	duration := int(req.EndTime.Sub(usage.StartTime).Minutes())
 	usage.DurationMinutes = &duration
 
-	dumpster, err := s.dumpsterRepo.GetByID(ctx, usage.DumpsterID)
+	dumpster, err := s.dumpsterRepo.GetByID(ctx, usage.DumpsterID, repository.WithPreload())
 	if err != nil {
 		s.logger.Error("failed to get dumpster for cost calculation", zap.String("dumpsterId", usage.DumpsterID.String()), zap.Error(err))
 		return nil, err
 	}
 
-	totalCost := s.calculateCost(dumpster.PricePerDay, duration)
+	billedEnd := usage.StartTime.Add(time.Duration(duration) * time.Minute)
+	totalCost, err := s.pricing.Quote(ctx, dumpster, usage.StartTime, billedEnd)
+	if err != nil {
+		return nil, err
+	}
 	usage.TotalCost = &totalCost
 	usage.Status = model.UsageStatusCompleted
 
@@ -132,12 +349,18 @@ func (s *usageService) EndUsage(
 		usage.Notes = req.Notes
 	}
 
-	if err := s.usageRepo.Update(ctx, usage); err != nil {
+	free, err := s.usageRepo.Complete(ctx, usage)
+	if err != nil {
 		s.logger.Error("failed to update usage", zap.String("usageId", id), zap.Error(err))
 		return nil, err
 	}
 
-	response := usage.ToResponse()
+	if free {
+		invalidateDumpsterCache(ctx, s.byID, s.logger, dumpster.ID)
+		s.alerts.NotifyAvailable(ctx, dumpster)
+	}
+
+	response := s.toResponse(usage, dumpster)
 	return &response, nil
 }
 
@@ -152,7 +375,7 @@ func (s *usageService) GetByID(ctx context.Context, id string) (*dto.UsageRespon
 		return nil, err
 	}
 
-	response := usage.ToResponse()
+	response := s.toResponse(usage, usage.Dumpster)
 	return &response, nil
 }
 
@@ -174,6 +397,40 @@ func (s *usageService) GetByDumpsterID(
 	return s.buildUsageListResponse(usages, total, req.Page, req.Limit), nil
 }
 
+// GetMyUsagesForDumpster lists only the caller's own sessions on the
+// dumpster, so renters can see their history without seeing anyone else's.
+func (s *usageService) GetMyUsagesForDumpster(
+	ctx context.Context,
+	userID, dumpsterID string,
+	req dto.UsageListRequest) (*dto.UsageListResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	dumpsterUUID, err := uuid.Parse(dumpsterID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid dumpster ID")
+	}
+
+	switch model.UsageStatus(req.Status) {
+	case "", model.UsageStatusDraft, model.UsageStatusActive, model.UsageStatusCompleted, model.UsageStatusCancelled:
+	default:
+		return nil, apperrors.BadRequest("status must be one of draft, active, completed, cancelled")
+	}
+
+	usages, total, err := s.usageRepo.GetByUserAndDumpster(ctx, userUUID, dumpsterUUID, req)
+	if err != nil {
+		s.logger.Error("failed to get own usages for dumpster",
+			zap.String("userId", userID),
+			zap.String("dumpsterId", dumpsterID),
+			zap.Error(err))
+		return nil, err
+	}
+
+	return s.buildUsageListResponse(usages, total, req.Page, req.Limit), nil
+}
+
 func (s *usageService) GetByUserID(
 	ctx context.Context,
 	userID string,
@@ -235,12 +492,26 @@ func (s *usageService) List(
 	return s.buildUsageListResponse(usages, total, req.Page, req.Limit), nil
 }
 
-func (s *usageService) Delete(ctx context.Context, id string) error {
+func (s *usageService) Delete(ctx context.Context, userID, id string) error {
 	usageID, err := uuid.Parse(id)
 	if err != nil {
 		return apperrors.BadRequest("invalid usage ID")
 	}
 
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return apperrors.BadRequest("invalid user ID")
+	}
+
+	usage, err := s.usageRepo.GetByID(ctx, usageID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.ownership.Check(usage.UserID, userUUID, "usage session", "delete"); err != nil {
+		return err
+	}
+
 	if err := s.usageRepo.Delete(ctx, usageID); err != nil {
 		s.logger.Error("failed to delete usage", zap.String("usageId", id), zap.Error(err))
 		return err
@@ -249,30 +520,585 @@ func (s *usageService) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-func (s *usageService) calculateCost(pricePerDay float64, durationMinutes int) float64 {
-	minutesPerDay := 24.0 * 60.0
-	return (pricePerDay / minutesPerDay) * float64(durationMinutes)
+func (s *usageService) GetReceipt(ctx context.Context, userID, id string) (*dto.UsageReceiptResponse, error) {
+	usageID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid usage ID")
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	usage, err := s.usageRepo.GetByID(ctx, usageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ownership.Check(usage.UserID, userUUID, "receipt", "view"); err != nil {
+		return nil, err
+	}
+
+	if usage.Status != model.UsageStatusCompleted || usage.EndTime == nil || usage.DurationMinutes == nil || usage.TotalCost == nil {
+		return nil, apperrors.BadRequest("receipts are only available for completed usages")
+	}
+
+	dumpster, err := s.dumpsterRepo.GetByID(ctx, usage.DumpsterID, repository.WithPreload(repository.RelationOwner))
+	if err != nil {
+		s.logger.Error("failed to get dumpster for receipt", zap.String("dumpsterId", usage.DumpsterID.String()), zap.Error(err))
+		return nil, err
+	}
+
+	subtotal := *usage.TotalCost
+	days := float64(*usage.DurationMinutes) / minutesPerDay
+
+	unitPrice := dumpster.PricePerDay
+	if days > 0 && subtotal != dumpster.PricePerDay.Mul(days) {
+		unitPrice = subtotal.Mul(1 / days)
+	}
+
+	taxResult, err := s.taxCalc.Calculate(ctx, dumpsterTaxLocation(dumpster), subtotal)
+	if err != nil {
+		s.logger.Error("failed to calculate tax for receipt", zap.String("usageId", id), zap.Error(err))
+		return nil, apperrors.Internal("failed to calculate tax", err)
+	}
+
+	receipt := &dto.UsageReceiptResponse{
+		ReceiptNumber: "RCT-" + strings.ToUpper(usage.ID.String()[:8]),
+		UsageID:       usage.ID.String(),
+		IssuedAt:      usage.UpdatedAt,
+		Dumpster: dto.ReceiptDumpster{
+			ID:      dumpster.ID.String(),
+			Title:   dumpster.Title,
+			Address: dumpster.Address,
+			City:    dumpster.City,
+			State:   dumpster.State,
+			ZipCode: dumpster.ZipCode,
+			Size:    string(dumpster.Size),
+		},
+		Owner:           receiptParty(dumpster.Owner),
+		Customer:        receiptParty(usage.User),
+		StartTime:       usage.StartTime,
+		EndTime:         *usage.EndTime,
+		DurationMinutes: *usage.DurationMinutes,
+		LineItems: []dto.ReceiptLineItem{
+			{
+				Description: fmt.Sprintf("Dumpster rental (%s)", dumpster.Size),
+				Quantity:    math.Round(days*100) / 100,
+				Unit:        "day",
+				UnitPrice:   unitPrice,
+				Amount:      subtotal,
+			},
+		},
+		Subtotal: subtotal,
+		TaxRate:  taxResult.Rate,
+		Tax:      taxResult.Amount,
+		Currency: money.Currency(),
+		Impact:   s.usageImpact(dumpster),
+	}
+
+	if taxResult.Amount > 0 {
+		receipt.LineItems = append(receipt.LineItems, dto.ReceiptLineItem{
+			Description: fmt.Sprintf("Sales tax (%s)", dumpster.State),
+			Quantity:    1,
+			Unit:        "flat",
+			UnitPrice:   taxResult.Amount,
+			Amount:      taxResult.Amount,
+		})
+	}
+
+	receipt.Total = receipt.Subtotal + receipt.Fees + receipt.Tax
+
+	return receipt, nil
+}
+
+// GetRunningCost prices an active usage from its start up to now, billing
+// whole minutes the way EndUsage does. Completed usages report their final
+// cost. The renter and the dumpster's owner may both view it.
+func (s *usageService) GetRunningCost(ctx context.Context, userID, id string) (*dto.UsageRunningCostResponse, error) {
+	usageID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid usage ID")
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	usage, err := s.usageRepo.GetByID(ctx, usageID)
+	if err != nil {
+		return nil, err
+	}
+
+	dumpster, err := s.dumpsterRepo.GetByID(ctx, usage.DumpsterID, repository.WithPreload())
+	if err != nil {
+		s.logger.Error("failed to get dumpster for running cost", zap.String("dumpsterId", usage.DumpsterID.String()), zap.Error(err))
+		return nil, err
+	}
+
+	if usage.UserID != userUUID {
+		if err := s.ownership.Check(dumpster.OwnerID, userUUID, "usage session", "view"); err != nil {
+			return nil, err
+		}
+	}
+
+	response := &dto.UsageRunningCostResponse{
+		UsageID:   usage.ID.String(),
+		Status:    string(usage.Status),
+		StartTime: usage.StartTime,
+		Currency:  money.Currency(),
+	}
+
+	switch usage.Status {
+	case model.UsageStatusCompleted:
+		if usage.EndTime == nil || usage.DurationMinutes == nil || usage.TotalCost == nil {
+			return nil, apperrors.New(apperrors.ErrorTypeInternal, "completed usage is missing its final cost")
+		}
+		response.AsOf = *usage.EndTime
+		response.DurationMinutes = *usage.DurationMinutes
+		response.Cost = *usage.TotalCost
+		response.Final = true
+	case model.UsageStatusActive:
+		now := time.Now()
+		duration := max(int(now.Sub(usage.StartTime).Minutes()), 0)
+		billedEnd := usage.StartTime.Add(time.Duration(duration) * time.Minute)
+		cost, err := s.pricing.Quote(ctx, dumpster, usage.StartTime, billedEnd)
+		if err != nil {
+			return nil, err
+		}
+		response.AsOf = now
+		response.DurationMinutes = duration
+		response.Cost = cost
+	case model.UsageStatusDraft:
+		return nil, apperrors.BadRequest("usage session has not been activated")
+	default:
+		return nil, apperrors.BadRequest("usage session was cancelled")
+	}
+
+	response.CostFormatted = money.Format(response.Cost)
+	return response, nil
+}
+
+// GetTrends returns a continuous series of usage counts and completed-usage
+// revenue for one dumpster or all of an owner's dumpsters. Without either
+// filter the caller's own dumpsters are used. Only admins may look at
+// dumpsters they don't own.
+func (s *usageService) GetTrends(
+	ctx context.Context,
+	userID string,
+	isAdmin bool,
+	req dto.UsageTrendsRequest) (*dto.UsageTrendsResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	granularity := req.Granularity
+	if granularity == "" {
+		granularity = defaultTrendGranularity
+	}
+	if granularity != "day" && granularity != "week" && granularity != "month" {
+		return nil, apperrors.BadRequest("granularity must be one of day, week, month")
+	}
+
+	if req.DumpsterID != "" && req.OwnerID != "" {
+		return nil, apperrors.BadRequest("specify either dumpsterId or ownerId, not both")
+	}
+
+	var dumpsterUUID, ownerUUID *uuid.UUID
+	switch {
+	case req.DumpsterID != "":
+		parsed, err := uuid.Parse(req.DumpsterID)
+		if err != nil {
+			return nil, apperrors.BadRequest("invalid dumpster ID")
+		}
+
+		dumpster, err := s.dumpsterRepo.GetByID(ctx, parsed, repository.WithPreload())
+		if err != nil {
+			return nil, err
+		}
+
+		if !isAdmin {
+			if err := s.ownership.Check(dumpster.OwnerID, userUUID, "dumpster", "view usage trends of"); err != nil {
+				return nil, err
+			}
+		}
+		dumpsterUUID = &parsed
+	case req.OwnerID != "":
+		parsed, err := uuid.Parse(req.OwnerID)
+		if err != nil {
+			return nil, apperrors.BadRequest("invalid owner ID")
+		}
+
+		if !isAdmin {
+			if err := s.ownership.Check(parsed, userUUID, "owner", "view usage trends of"); err != nil {
+				return nil, err
+			}
+		}
+		ownerUUID = &parsed
+	default:
+		ownerUUID = &userUUID
+	}
+
+	to := time.Now().UTC()
+	if req.To != nil {
+		to = req.To.UTC()
+	}
+
+	from := to.Add(-defaultTrendWindow)
+	if req.From != nil {
+		from = req.From.UTC()
+	}
+
+	if !from.Before(to) {
+		return nil, apperrors.BadRequest("from must be before to")
+	}
+
+	from = truncateToBucket(from, granularity)
+	if bucketCount(from, to, granularity) > maxTrendBuckets {
+		return nil, apperrors.BadRequest(fmt.Sprintf("range spans more than %d %s buckets", maxTrendBuckets, granularity))
+	}
+
+	rows, err := s.usageRepo.GetTrends(ctx, dumpsterUUID, ownerUUID, granularity, from, to)
+	if err != nil {
+		s.logger.Error("failed to get usage trends", zap.String("userId", userID), zap.Error(err))
+		return nil, err
+	}
+
+	byStart := make(map[time.Time]dto.UsageTrendBucket, len(rows))
+	for _, row := range rows {
+		byStart[row.Start.UTC()] = row
+	}
+
+	response := &dto.UsageTrendsResponse{
+		Granularity: granularity,
+		From:        from,
+		To:          to,
+		Currency:    money.Currency(),
+	}
+
+	for start := from; start.Before(to); start = nextBucket(start, granularity) {
+		bucket := byStart[start]
+		bucket.Start = start
+		response.Buckets = append(response.Buckets, bucket)
+	}
+
+	return response, nil
+}
+
+// GetSpendingReport totals the user's completed usages by the month each
+// ended in and by dumpster size. The range defaults to the last 12 months,
+// and from is widened to the start of its month.
+func (s *usageService) GetSpendingReport(
+	ctx context.Context,
+	userID string,
+	from, to *time.Time) (*dto.SpendingReportResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	end := time.Now().UTC()
+	if to != nil {
+		end = to.UTC()
+	}
+
+	start := truncateToBucket(end, "month").AddDate(0, 1-defaultSpendingMonths, 0)
+	if from != nil {
+		start = truncateToBucket(from.UTC(), "month")
+	}
+
+	if !start.Before(end) {
+		return nil, apperrors.BadRequest("from must be before to")
+	}
+
+	if bucketCount(start, end, "month") > maxSpendingMonths {
+		return nil, apperrors.BadRequest(fmt.Sprintf("range spans more than %d months", maxSpendingMonths))
+	}
+
+	months, err := s.usageRepo.GetSpendingByMonth(ctx, userUUID, start, end)
+	if err != nil {
+		s.logger.Error("failed to get spending by month", zap.String("userId", userID), zap.Error(err))
+		return nil, err
+	}
+
+	sizes, err := s.usageRepo.GetSpendingBySize(ctx, userUUID, start, end)
+	if err != nil {
+		s.logger.Error("failed to get spending by size", zap.String("userId", userID), zap.Error(err))
+		return nil, err
+	}
+
+	response := &dto.SpendingReportResponse{
+		From:     start,
+		To:       end,
+		Currency: money.Currency(),
+	}
+
+	byMonth := make(map[time.Time]dto.SpendingByMonth, len(months))
+	for _, month := range months {
+		byMonth[month.Month.UTC()] = month
+		response.Total += month.Total
+	}
+
+	for month := start; month.Before(end); month = nextBucket(month, "month") {
+		bucket := byMonth[month]
+		bucket.Month = month
+		response.ByMonth = append(response.ByMonth, bucket)
+	}
+
+	bySize := make(map[string]dto.SpendingBySize, len(sizes))
+	for _, size := range sizes {
+		bySize[size.Size] = size
+	}
+
+	for _, size := range []model.DumpsterSize{
+		model.DumpsterSizeSmall,
+		model.DumpsterSizeMedium,
+		model.DumpsterSizeLarge,
+		model.DumpsterSizeExtraLarge,
+	} {
+		bucket := bySize[string(size)]
+		bucket.Size = string(size)
+		response.BySize = append(response.BySize, bucket)
+	}
+
+	return response, nil
+}
+
+// GetOccupancy reports what fraction of [from, to) the dumpster spent in
+// completed usages. The range defaults to the 30 days before now. Only the
+// dumpster's owner and admins may see it.
+func (s *usageService) GetOccupancy(
+	ctx context.Context,
+	userID, dumpsterID string,
+	isAdmin bool,
+	req dto.OccupancyRequest) (*dto.OccupancyResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	dumpsterUUID, err := uuid.Parse(dumpsterID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid dumpster ID")
+	}
+
+	to := time.Now().UTC()
+	if req.To != nil {
+		to = req.To.UTC()
+	}
+
+	from := to.Add(-defaultTrendWindow)
+	if req.From != nil {
+		from = req.From.UTC()
+	}
+
+	if !from.Before(to) {
+		return nil, apperrors.BadRequest("from must be before to")
+	}
+
+	dumpster, err := s.dumpsterRepo.GetByID(ctx, dumpsterUUID, repository.WithPreload())
+	if err != nil {
+		return nil, err
+	}
+
+	if !isAdmin {
+		if err := s.ownership.Check(dumpster.OwnerID, userUUID, "dumpster", "view occupancy of"); err != nil {
+			return nil, err
+		}
+	}
+
+	used, err := s.usageRepo.GetUsedMinutes(ctx, dumpsterUUID, from, to)
+	if err != nil {
+		s.logger.Error("failed to get used minutes", zap.String("dumpsterId", dumpsterID), zap.Error(err))
+		return nil, err
+	}
+
+	period := int64(to.Sub(from).Minutes())
+	response := &dto.OccupancyResponse{
+		DumpsterID:    dumpsterID,
+		From:          from,
+		To:            to,
+		UsedMinutes:   used,
+		PeriodMinutes: period,
+	}
+
+	if period > 0 {
+		response.Percentage = min(math.Round(float64(used)/float64(period)*10000)/100, 100)
+	}
+
+	return response, nil
+}
+
+func truncateToBucket(t time.Time, granularity string) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch granularity {
+	case "week":
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset)
+	case "month":
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return day
+	}
+}
+
+func nextBucket(start time.Time, granularity string) time.Time {
+	switch granularity {
+	case "week":
+		return start.AddDate(0, 0, 7)
+	case "month":
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+func bucketCount(from, to time.Time, granularity string) int {
+	days := int(to.Sub(from).Hours()/24) + 1
+
+	switch granularity {
+	case "week":
+		return days/7 + 1
+	case "month":
+		return (to.Year()-from.Year())*12 + int(to.Month()-from.Month()) + 1
+	default:
+		return days
+	}
+}
+
+func receiptParty(user *model.User) *dto.ReceiptParty {
+	if user == nil {
+		return nil
+	}
+
+	return &dto.ReceiptParty{
+		ID:          user.ID.String(),
+		Name:        strings.TrimSpace(user.FirstName + " " + user.LastName),
+		Email:       user.Email,
+		PhoneNumber: user.PhoneNumber,
+	}
 }
 
 func (s *usageService) buildUsageListResponse(
 	usages []*model.DumpsterUsage,
 	total int64,
 	page, limit int) *dto.UsageListResponse {
-	page = max(page, 1)
-	limit = max(limit, 1)
-
 	responses := make([]dto.UsageResponse, len(usages))
 	for i, usage := range usages {
-		responses[i] = usage.ToResponse()
+		responses[i] = s.toResponse(usage, usage.Dumpster)
+	}
+
+	return dto.NewPaginatedResponse(responses, total, page, limit)
+}
+
+func (s *usageService) toResponse(usage *model.DumpsterUsage, dumpster *model.Dumpster) dto.UsageResponse {
+	response := usage.ToResponse()
+	if usage.Status == model.UsageStatusCompleted && dumpster != nil {
+		response.Impact = s.usageImpact(dumpster)
 	}
+	return response
+}
+
+func (s *usageService) usageImpact(dumpster *model.Dumpster) *dto.UsageImpact {
+	estimate, ok := s.impact.Estimate(string(dumpster.Size), dumpster.Weight)
+	return &dto.UsageImpact{
+		WasteDivertedKg: roundKg(estimate.WasteDivertedKg),
+		CO2eAvoidedKg:   roundKg(estimate.CO2eAvoidedKg),
+		Estimated:       ok,
+	}
+}
 
-	totalPages := int(math.Ceil(float64(total) / float64(limit)))
+func roundKg(kg float64) float64 {
+	return math.Round(kg*10) / 10
+}
+
+func (s *usageService) GetImpact(ctx context.Context, userID string) (*dto.ImpactResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
 
-	return &dto.UsageListResponse{
-		Usages:     responses,
-		Total:      total,
-		Page:       page,
-		Limit:      limit,
-		TotalPages: totalPages,
+	groups, err := s.usageRepo.GetCompletedByListingWeight(ctx, userUUID)
+	if err != nil {
+		s.logger.Error("failed to get completed usages for impact", zap.String("userId", userID), zap.Error(err))
+		return nil, err
 	}
+
+	response := &dto.ImpactResponse{}
+	var total impact.Estimate
+	for _, group := range groups {
+		response.CompletedUsages += group.Usages
+
+		estimate, ok := s.impact.Estimate(group.Size, group.Weight)
+		if !ok {
+			continue
+		}
+		response.EstimatedUsages += group.Usages
+
+		scaled := estimate.Times(group.Usages)
+		total.WasteDivertedKg += scaled.WasteDivertedKg
+		total.CO2eAvoidedKg += scaled.CO2eAvoidedKg
+	}
+
+	response.WasteDivertedKg = roundKg(total.WasteDivertedKg)
+	response.CO2eAvoidedKg = roundKg(total.CO2eAvoidedKg)
+	return response, nil
+}
+
+func (s *usageService) GetOutstandingBalance(ctx context.Context, userID string) (*dto.BalanceResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	count, balance, err := s.usageRepo.GetOutstandingBalance(ctx, userUUID)
+	if err != nil {
+		s.logger.Error("failed to get outstanding balance", zap.String("userId", userID), zap.Error(err))
+		return nil, err
+	}
+
+	return &dto.BalanceResponse{
+		Balance:      balance,
+		Currency:     money.Currency(),
+		UnpaidUsages: count,
+	}, nil
+}
+
+// MarkPaid records payment for a completed usage. Paying twice is rejected
+// so a duplicate payment callback is visible rather than silently accepted.
+func (s *usageService) MarkPaid(ctx context.Context, id string) (*dto.UsageResponse, error) {
+	usageID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid usage ID")
+	}
+
+	usage, err := s.usageRepo.GetByID(ctx, usageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if usage.Status != model.UsageStatusCompleted {
+		return nil, apperrors.BadRequest("only completed usage sessions can be paid")
+	}
+
+	paid, err := s.usageRepo.MarkPaid(ctx, usageID, time.Now())
+	if err != nil {
+		s.logger.Error("failed to mark usage paid", zap.String("usageId", id), zap.Error(err))
+		return nil, err
+	}
+	if !paid {
+		return nil, apperrors.AlreadyExists("usage session is already paid")
+	}
+
+	updated, err := s.usageRepo.GetByID(ctx, usageID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := s.toResponse(updated, updated.Dumpster)
+	return &response, nil
 }
@@ -2,6 +2,10 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
 	"time"
 	"waste-space/internal/dto"
 	"waste-space/internal/model"
@@ -9,6 +13,8 @@ import (
 	"waste-space/internal/storage/repository"
 	"waste-space/pkg/auth"
 	apperrors "waste-space/pkg/errors"
+	"waste-space/pkg/geo"
+	"waste-space/pkg/money"
 
 	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
@@ -16,39 +22,100 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
-const refreshTokenTTL = 7 * 24 * time.Hour
+const (
+	maxPublicProfileIDs   = 50
+	maxProximityRadius    = 100.0
+	engagementActiveLimit = 100
+)
 
 type UserService interface {
 	Register(ctx context.Context, req dto.CreateUserRequest) (*dto.UserResponse, error)
-	Login(ctx context.Context, req dto.LoginRequest) (*dto.LoginResponse, error)
+	Login(ctx context.Context, req dto.LoginRequest, client dto.ClientInfo) (*dto.LoginResponse, error)
 	RefreshToken(ctx context.Context, req dto.RefreshTokenRequest) (*dto.RefreshTokenResponse, error)
 	Logout(ctx context.Context, userID string, accessToken string) error
+	IntrospectToken(ctx context.Context, token string) (*dto.IntrospectResponse, error)
+	GetPermissions(ctx context.Context, userID, role string, apiKeyAuth bool) (*dto.PermissionsResponse, error)
+	ListSessions(ctx context.Context, userID, currentSessionID string) ([]dto.SessionResponse, error)
+	RevokeSession(ctx context.Context, userID, sessionID string) error
 	GetMe(ctx context.Context, userID string) (*dto.UserResponse, error)
 	GetByID(ctx context.Context, userID string) (*dto.UserResponse, error)
+	GetPublicProfiles(ctx context.Context, ids []string) (map[string]dto.PublicUserResponse, error)
 	UpdateMe(ctx context.Context, userID string, req dto.UpdateUserRequest) (*dto.UserResponse, error)
 	UpdateEmail(ctx context.Context, userID string, req dto.UpdateEmailRequest) (*dto.UserResponse, error)
 	UpdatePhone(ctx context.Context, userID string, req dto.UpdatePhoneRequest) (*dto.UserResponse, error)
 	UpdatePassword(ctx context.Context, userID string, req dto.UpdatePasswordRequest) error
-	DeleteMe(ctx context.Context, userID string) error
+	DeleteMe(ctx context.Context, userID, accessToken string) error
+	CountNearby(ctx context.Context, requesterID string, req dto.UserProximityRequest) (*dto.UserProximityResponse, error)
+	GetEngagement(ctx context.Context, userID string) (*dto.UserEngagementResponse, error)
+	GetOverview(ctx context.Context, userID string) (*dto.UserOverviewResponse, error)
+	CreateAPIKey(ctx context.Context, userID string, req dto.CreateAPIKeyRequest) (*dto.CreatedAPIKeyResponse, error)
+	ListAPIKeys(ctx context.Context, userID string) ([]dto.APIKeyResponse, error)
+	RevokeAPIKey(ctx context.Context, userID, id string) error
+	AuthenticateAPIKey(ctx context.Context, key string) (*auth.Claims, error)
+}
+
+type UserServiceConfig struct {
+	// RememberMeRefreshTTL is the refresh token lifetime for logins with
+	// rememberMe set; other logins use the token service default.
+	RememberMeRefreshTTL time.Duration
+	// LockoutThreshold failed logins for one email within LockoutWindow lock
+	// that email out for LockoutCooldown. Zero disables lockout.
+	LockoutThreshold int
+	LockoutWindow    time.Duration
+	LockoutCooldown  time.Duration
+	// UniqueVerifiedPhones rejects registrations and phone changes to a
+	// number another account has verified. The database refuses a second
+	// verification of the same number either way.
+	UniqueVerifiedPhones bool
 }
 
 type userService struct {
-	userRepo     repository.UserRepository
-	tokenService auth.TokenService
-	tokenCache   cache.TokenCache
-	logger       *zap.Logger
+	userRepo      repository.UserRepository
+	dumpsterRepo  repository.DumpsterRepository
+	usageRepo     repository.UsageRepository
+	apiKeyRepo    repository.APIKeyRepository
+	geocoder      geo.Geocoder
+	tokenService  auth.TokenService
+	tokenCache    cache.TokenCache
+	loginAttempts cache.LoginAttemptCache
+	bookings      BookingService
+	usages        UsageService
+	notifications NotificationService
+	dashboard     DashboardService
+	cfg           UserServiceConfig
+	logger        *zap.Logger
 }
 
 func NewUserService(
 	userRepo repository.UserRepository,
+	dumpsterRepo repository.DumpsterRepository,
+	usageRepo repository.UsageRepository,
+	apiKeyRepo repository.APIKeyRepository,
+	geocoder geo.Geocoder,
 	tokenService auth.TokenService,
 	tokenCache cache.TokenCache,
+	loginAttempts cache.LoginAttemptCache,
+	bookings BookingService,
+	usages UsageService,
+	notifications NotificationService,
+	dashboard DashboardService,
+	cfg UserServiceConfig,
 	logger *zap.Logger) UserService {
 	return &userService{
-		userRepo:     userRepo,
-		tokenService: tokenService,
-		tokenCache:   tokenCache,
-		logger:       logger,
+		userRepo:      userRepo,
+		dumpsterRepo:  dumpsterRepo,
+		usageRepo:     usageRepo,
+		apiKeyRepo:    apiKeyRepo,
+		geocoder:      geocoder,
+		tokenService:  tokenService,
+		tokenCache:    tokenCache,
+		loginAttempts: loginAttempts,
+		bookings:      bookings,
+		usages:        usages,
+		notifications: notifications,
+		dashboard:     dashboard,
+		cfg:           cfg,
+		logger:        logger,
 	}
 }
 
@@ -59,6 +126,12 @@ func (s *userService) Register(ctx context.Context, req dto.CreateUserRequest) (
 		return nil, err
 	}
 
+	if err := s.checkPhoneAvailable(ctx, user.PhoneNumber, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	s.geocodeUser(ctx, user)
+
 	if err := s.userRepo.Create(ctx, user); err != nil {
 		s.logger.Error("failed to create user", zap.String("email", req.Email), zap.Error(err))
 		return nil, err
@@ -68,10 +141,20 @@ func (s *userService) Register(ctx context.Context, req dto.CreateUserRequest) (
 	return &response, nil
 }
 
-func (s *userService) Login(ctx context.Context, req dto.LoginRequest) (*dto.LoginResponse, error) {
+// Login checks the credentials and opens a session. Failed attempts are
+// counted per email, for unknown addresses too, so a lockout looks the same
+// whether or not the account exists.
+func (s *userService) Login(
+	ctx context.Context,
+	req dto.LoginRequest,
+	client dto.ClientInfo) (*dto.LoginResponse, error) {
+	if err := s.checkLoginLock(ctx, req.Email); err != nil {
+		return nil, err
+	}
+
 	user, err := s.userRepo.GetByEmail(ctx, req.Email)
 	if err != nil {
-		return nil, apperrors.Unauthorized("invalid email or password")
+		return nil, s.loginFailed(ctx, req.Email)
 	}
 
 	if !user.IsActive {
@@ -79,18 +162,28 @@ func (s *userService) Login(ctx context.Context, req dto.LoginRequest) (*dto.Log
 	}
 
 	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.Password)); err != nil {
-		return nil, apperrors.Unauthorized("invalid email or password")
+		return nil, s.loginFailed(ctx, req.Email)
 	}
 
-	tokenPair, err := s.tokenService.GenerateTokenPair(user.ID, user.Email)
+	s.resetLoginFailures(ctx, req.Email)
+
+	sessionID := uuid.New()
+
+	var tokenPair *auth.TokenPair
+	if req.RememberMe {
+		tokenPair, err = s.tokenService.GenerateTokenPairWithRefreshTTL(user.ID, sessionID, user.Email, string(user.Role), s.cfg.RememberMeRefreshTTL)
+	} else {
+		tokenPair, err = s.tokenService.GenerateTokenPair(user.ID, sessionID, user.Email, string(user.Role))
+	}
 	if err != nil {
 		s.logger.Error("failed to generate tokens", zap.String("userId", user.ID.String()), zap.Error(err))
 		return nil, apperrors.Internal("failed to generate tokens", err)
 	}
 
-	if err := s.tokenCache.SetRefreshToken(ctx, user.ID, tokenPair.RefreshToken, refreshTokenTTL); err != nil {
-		s.logger.Error("failed to cache refresh token", zap.String("userId", user.ID.String()), zap.Error(err))
-		return nil, apperrors.Internal("failed to cache refresh token", err)
+	session := model.NewSession(sessionID, user.ID, tokenPair.RefreshToken, client, tokenPair.RefreshExpiresAt)
+	if err := s.tokenCache.SetSession(ctx, session, time.Until(tokenPair.RefreshExpiresAt)); err != nil {
+		s.logger.Error("failed to cache session", zap.String("userId", user.ID.String()), zap.Error(err))
+		return nil, apperrors.Internal("failed to cache session", err)
 	}
 
 	now := time.Now()
@@ -108,25 +201,70 @@ func (s *userService) Login(ctx context.Context, req dto.LoginRequest) (*dto.Log
 	}, nil
 }
 
+func (s *userService) checkLoginLock(ctx context.Context, email string) error {
+	if s.cfg.LockoutThreshold <= 0 {
+		return nil
+	}
+
+	remaining, err := s.loginAttempts.LockRemaining(ctx, email)
+	if err != nil {
+		s.logger.Warn("failed to check login lockout", zap.Error(err))
+		return nil
+	}
+	if remaining > 0 {
+		return loginLockedError(remaining)
+	}
+	return nil
+}
+
+func (s *userService) loginFailed(ctx context.Context, email string) error {
+	invalid := apperrors.Unauthorized("invalid email or password")
+	if s.cfg.LockoutThreshold <= 0 {
+		return invalid
+	}
+
+	locked, err := s.loginAttempts.RecordFailure(ctx, email, s.cfg.LockoutThreshold, s.cfg.LockoutWindow, s.cfg.LockoutCooldown)
+	if err != nil {
+		s.logger.Warn("failed to record failed login", zap.Error(err))
+		return invalid
+	}
+	if locked > 0 {
+		return loginLockedError(locked)
+	}
+	return invalid
+}
+
+func (s *userService) resetLoginFailures(ctx context.Context, email string) {
+	if s.cfg.LockoutThreshold <= 0 {
+		return
+	}
+
+	if err := s.loginAttempts.Reset(ctx, email); err != nil {
+		s.logger.Warn("failed to reset failed logins", zap.Error(err))
+	}
+}
+
+func loginLockedError(remaining time.Duration) error {
+	minutes := int(math.Ceil(remaining.Minutes()))
+	return apperrors.Locked(fmt.Sprintf("too many failed login attempts; try again in %d minute(s)", minutes))
+}
+
 func (s *userService) RefreshToken(ctx context.Context, req dto.RefreshTokenRequest) (*dto.RefreshTokenResponse, error) {
-	claims, err := s.tokenService.ValidateToken(req.RefreshToken)
+	claims, err := s.tokenService.ValidateRefreshToken(req.RefreshToken)
 	if err != nil {
-		claims, err = s.tokenService.ValidateToken(req.RefreshToken)
-		if err != nil {
-			return nil, apperrors.Unauthorized("invalid refresh token")
-		}
+		return nil, apperrors.Unauthorized("invalid refresh token")
 	}
 
-	cachedToken, err := s.tokenCache.GetRefreshToken(ctx, claims.UserID)
+	session, err := s.tokenCache.GetSession(ctx, claims.UserID, claims.SessionID)
 	if err != nil {
 		if err == redis.Nil {
 			return nil, apperrors.Unauthorized("refresh token expired or revoked")
 		}
-		s.logger.Error("failed to get cached token", zap.String("userId", claims.UserID.String()), zap.Error(err))
-		return nil, apperrors.Internal("failed to get cached token", err)
+		s.logger.Error("failed to get session", zap.String("userId", claims.UserID.String()), zap.Error(err))
+		return nil, apperrors.Internal("failed to get session", err)
 	}
 
-	if cachedToken != req.RefreshToken {
+	if session.RefreshToken != req.RefreshToken {
 		return nil, apperrors.Unauthorized("invalid refresh token")
 	}
 
@@ -135,23 +273,254 @@ func (s *userService) RefreshToken(ctx context.Context, req dto.RefreshTokenRequ
 		return nil, err
 	}
 
+	session.LastUsedAt = time.Now()
+	if err := s.tokenCache.SetSession(ctx, session, time.Until(session.ExpiresAt)); err != nil {
+		s.logger.Warn("failed to update session last use", zap.String("userId", claims.UserID.String()), zap.Error(err))
+	}
+
 	return &dto.RefreshTokenResponse{
 		AccessToken: accessToken,
 	}, nil
 }
 
+// Logout revokes the session the access token belongs to and blacklists the
+// token for the rest of its lifetime. Other sessions stay signed in.
 func (s *userService) Logout(ctx context.Context, userID string, accessToken string) error {
+	claims, err := s.tokenService.ValidateToken(accessToken)
+	if err != nil {
+		return apperrors.Unauthorized("invalid token")
+	}
+
+	if claims.UserID.String() != userID {
+		return apperrors.Unauthorized("invalid token")
+	}
+
+	if err := s.tokenCache.DeleteSession(ctx, claims.UserID, claims.SessionID); err != nil {
+		s.logger.Error("failed to delete session", zap.String("userId", userID), zap.Error(err))
+		return apperrors.Internal("failed to delete session", err)
+	}
+
+	if ttl := time.Until(claims.ExpiresAt); ttl > 0 {
+		if err := s.tokenCache.BlacklistAccessToken(ctx, accessToken, ttl); err != nil {
+			s.logger.Error("failed to blacklist access token", zap.String("userId", userID), zap.Error(err))
+			return apperrors.Internal("failed to blacklist access token", err)
+		}
+	}
+
 	return nil
 }
 
+func (s *userService) ListSessions(ctx context.Context, userID, currentSessionID string) ([]dto.SessionResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	currentUUID, _ := uuid.Parse(currentSessionID)
+
+	sessions, err := s.tokenCache.ListSessions(ctx, userUUID)
+	if err != nil {
+		s.logger.Error("failed to list sessions", zap.String("userId", userID), zap.Error(err))
+		return nil, apperrors.Internal("failed to list sessions", err)
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastUsedAt.After(sessions[j].LastUsedAt)
+	})
+
+	responses := make([]dto.SessionResponse, len(sessions))
+	for i, session := range sessions {
+		responses[i] = session.ToResponse(currentUUID)
+	}
+
+	return responses, nil
+}
+
+func (s *userService) RevokeSession(ctx context.Context, userID, sessionID string) error {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return apperrors.BadRequest("invalid user ID")
+	}
+
+	sessionUUID, err := uuid.Parse(sessionID)
+	if err != nil {
+		return apperrors.BadRequest("invalid session ID")
+	}
+
+	if _, err := s.tokenCache.GetSession(ctx, userUUID, sessionUUID); err != nil {
+		if err == redis.Nil {
+			return apperrors.NotFound("session not found")
+		}
+		s.logger.Error("failed to get session", zap.String("userId", userID), zap.Error(err))
+		return apperrors.Internal("failed to get session", err)
+	}
+
+	if err := s.tokenCache.DeleteSession(ctx, userUUID, sessionUUID); err != nil {
+		s.logger.Error("failed to delete session", zap.String("userId", userID), zap.Error(err))
+		return apperrors.Internal("failed to delete session", err)
+	}
+
+	return nil
+}
+
+// IntrospectToken reports whether an access token is currently usable.
+// Invalid, expired and blacklisted tokens are reported as inactive rather
+// than as errors; only a cache failure is returned as an error.
+func (s *userService) IntrospectToken(ctx context.Context, token string) (*dto.IntrospectResponse, error) {
+	claims, err := s.tokenService.ValidateToken(token)
+	if err != nil {
+		return &dto.IntrospectResponse{Active: false}, nil
+	}
+
+	blacklisted, err := s.tokenCache.IsAccessTokenBlacklisted(ctx, token)
+	if err != nil {
+		s.logger.Error("failed to check token blacklist", zap.String("userId", claims.UserID.String()), zap.Error(err))
+		return nil, apperrors.Internal("failed to check token blacklist", err)
+	}
+
+	if blacklisted {
+		return &dto.IntrospectResponse{Active: false}, nil
+	}
+
+	return &dto.IntrospectResponse{
+		Active:    true,
+		UserID:    claims.UserID.String(),
+		Email:     claims.Email,
+		ExpiresAt: &claims.ExpiresAt,
+	}, nil
+}
+
 func (s *userService) GetMe(ctx context.Context, userID string) (*dto.UserResponse, error) {
 	return s.getUserByID(ctx, userID)
 }
 
+func (s *userService) GetEngagement(ctx context.Context, userID string) (*dto.UserEngagementResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	active, _, err := s.usageRepo.GetByUserID(ctx, userUUID, dto.UsageListRequest{
+		Status: string(model.UsageStatusActive),
+		Limit:  engagementActiveLimit,
+	})
+	if err != nil {
+		s.logger.Error("failed to get active usages", zap.String("userId", userID), zap.Error(err))
+		return nil, err
+	}
+
+	stats, err := s.usageRepo.GetStats(ctx, nil, &userUUID)
+	if err != nil {
+		s.logger.Error("failed to get usage stats", zap.String("userId", userID), zap.Error(err))
+		return nil, err
+	}
+
+	activeUsages := make([]dto.UsageResponse, len(active))
+	for i, usage := range active {
+		activeUsages[i] = usage.ToResponse()
+	}
+
+	return &dto.UserEngagementResponse{
+		ActiveUsages:        activeUsages,
+		TotalUsages:         stats.TotalUsages,
+		CompletedUsages:     stats.CompletedUsages,
+		TotalMinutes:        stats.TotalMinutes,
+		TotalSpend:          stats.TotalRevenue,
+		TotalSpendFormatted: money.Format(stats.TotalRevenue),
+		Currency:            money.Currency(),
+	}, nil
+}
+
+// GetOverview assembles the account tab. Only the profile is required; any
+// other section that fails is logged and left null.
+func (s *userService) GetOverview(ctx context.Context, userID string) (*dto.UserOverviewResponse, error) {
+	profile, err := s.getUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	overview := &dto.UserOverviewResponse{Profile: *profile}
+
+	active, err := s.usages.GetByUserID(ctx, userID, dto.UsageListRequest{
+		Status: string(model.UsageStatusActive),
+		Limit:  engagementActiveLimit,
+	})
+	if err != nil {
+		s.logger.Warn("overview: failed to get active usages", zap.String("userId", userID), zap.Error(err))
+	} else {
+		overview.ActiveUsages = active.Items
+	}
+
+	upcoming, err := s.bookings.GetUpcoming(ctx, userID)
+	if err != nil {
+		s.logger.Warn("overview: failed to get upcoming bookings", zap.String("userId", userID), zap.Error(err))
+	} else {
+		overview.UpcomingBookings = upcoming
+	}
+
+	unread, err := s.notifications.CountUnread(ctx, userID)
+	if err != nil {
+		s.logger.Warn("overview: failed to count unread notifications", zap.String("userId", userID), zap.Error(err))
+	} else {
+		overview.UnreadNotifications = &unread
+	}
+
+	balance, err := s.usages.GetOutstandingBalance(ctx, userID)
+	if err != nil {
+		s.logger.Warn("overview: failed to get outstanding balance", zap.String("userId", userID), zap.Error(err))
+	} else {
+		overview.Balance = balance
+	}
+
+	ownerStats, err := s.dashboard.GetOwnerDashboard(ctx, userID)
+	switch {
+	case err != nil:
+		s.logger.Warn("overview: failed to get owner stats", zap.String("userId", userID), zap.Error(err))
+	case ownerStats.ListingCount > 0:
+		overview.OwnerStats = ownerStats
+	}
+
+	return overview, nil
+}
+
 func (s *userService) GetByID(ctx context.Context, userID string) (*dto.UserResponse, error) {
 	return s.getUserByID(ctx, userID)
 }
 
+func (s *userService) GetPublicProfiles(
+	ctx context.Context,
+	ids []string) (map[string]dto.PublicUserResponse, error) {
+	if len(ids) == 0 {
+		return nil, apperrors.BadRequest("at least one user ID is required")
+	}
+
+	if len(ids) > maxPublicProfileIDs {
+		return nil, apperrors.BadRequest(fmt.Sprintf("at most %d user IDs are allowed", maxPublicProfileIDs))
+	}
+
+	userIDs := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		parsed, err := uuid.Parse(id)
+		if err != nil {
+			return nil, apperrors.BadRequest("invalid user ID")
+		}
+		userIDs = append(userIDs, parsed)
+	}
+
+	users, err := s.userRepo.GetActiveByIDs(ctx, userIDs)
+	if err != nil {
+		s.logger.Error("failed to get public profiles", zap.Int("count", len(userIDs)), zap.Error(err))
+		return nil, err
+	}
+
+	profiles := make(map[string]dto.PublicUserResponse, len(users))
+	for _, user := range users {
+		profiles[user.ID.String()] = user.ToPublicResponse()
+	}
+
+	return profiles, nil
+}
+
 func (s *userService) getUserByID(ctx context.Context, userID string) (*dto.UserResponse, error) {
 	id, err := uuid.Parse(userID)
 	if err != nil {
@@ -176,7 +545,15 @@ func (s *userService) UpdateMe(
 		return nil, err
 	}
 
-	s.applyUserUpdates(user, req)
+	if req.PhoneNumber != nil && *req.PhoneNumber != user.PhoneNumber {
+		if err := s.checkPhoneAvailable(ctx, *req.PhoneNumber, user.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	if s.applyUserUpdates(user, req) {
+		s.geocodeUser(ctx, user)
+	}
 
 	if err := s.userRepo.Update(ctx, user); err != nil {
 		s.logger.Error("failed to update user", zap.String("userId", userID), zap.Error(err))
@@ -217,6 +594,10 @@ func (s *userService) UpdatePhone(
 		return nil, err
 	}
 
+	if err := s.checkPhoneAvailable(ctx, req.PhoneNumber, user.ID); err != nil {
+		return nil, err
+	}
+
 	user.PhoneNumber = req.PhoneNumber
 	user.IsPhoneVerified = false
 
@@ -258,13 +639,95 @@ func (s *userService) UpdatePassword(
 	return nil
 }
 
-func (s *userService) DeleteMe(ctx context.Context, userID string) error {
+// DeleteMe deletes the account, then signs it out everywhere: every session
+// is revoked and the calling access token is blacklisted. Whether the row is
+// soft- or hard-deleted is the repository's configuration.
+func (s *userService) DeleteMe(ctx context.Context, userID, accessToken string) error {
 	id, err := uuid.Parse(userID)
 	if err != nil {
 		return apperrors.BadRequest("invalid user ID")
 	}
 
-	return s.userRepo.Delete(ctx, id)
+	if err := s.userRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	sessions, err := s.tokenCache.ListSessions(ctx, id)
+	if err != nil {
+		s.logger.Error("failed to list sessions of deleted user", zap.String("userId", userID), zap.Error(err))
+		return apperrors.Internal("failed to revoke sessions", err)
+	}
+
+	for _, session := range sessions {
+		if err := s.tokenCache.DeleteSession(ctx, id, session.ID); err != nil {
+			s.logger.Error("failed to delete session of deleted user", zap.String("userId", userID), zap.Error(err))
+			return apperrors.Internal("failed to revoke sessions", err)
+		}
+	}
+
+	claims, err := s.tokenService.ValidateToken(accessToken)
+	if err != nil {
+		return nil
+	}
+
+	if ttl := time.Until(claims.ExpiresAt); ttl > 0 {
+		if err := s.tokenCache.BlacklistAccessToken(ctx, accessToken, ttl); err != nil {
+			s.logger.Error("failed to blacklist access token", zap.String("userId", userID), zap.Error(err))
+			return apperrors.Internal("failed to blacklist access token", err)
+		}
+	}
+
+	return nil
+}
+
+// CountNearby returns how many active users live within a radius of a point.
+// Only the aggregate count is ever returned; individual user locations stay
+// internal. Restricted to admins and dumpster owners.
+func (s *userService) CountNearby(
+	ctx context.Context,
+	requesterID string,
+	req dto.UserProximityRequest) (*dto.UserProximityResponse, error) {
+	requesterUUID, err := uuid.Parse(requesterID)
+	if err != nil {
+		return nil, apperrors.BadRequest("invalid user ID")
+	}
+
+	if req.Latitude < -90 || req.Latitude > 90 || req.Longitude < -180 || req.Longitude > 180 {
+		return nil, apperrors.BadRequest("invalid coordinates")
+	}
+
+	if req.Radius <= 0 || req.Radius > maxProximityRadius {
+		return nil, apperrors.BadRequest(fmt.Sprintf("radius must be greater than 0 and at most %.0f km", maxProximityRadius))
+	}
+
+	requester, err := s.userRepo.GetByID(ctx, requesterUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if requester.Role != model.UserRoleAdmin {
+		owned, err := s.dumpsterRepo.CountByOwner(ctx, requesterUUID)
+		if err != nil {
+			s.logger.Error("failed to count owned dumpsters", zap.String("userId", requesterID), zap.Error(err))
+			return nil, err
+		}
+		if owned == 0 {
+			return nil, apperrors.Forbidden("only dumpster owners can view service area demand")
+		}
+	}
+
+	count, err := s.userRepo.CountWithinRadius(ctx, req.Latitude, req.Longitude, req.Radius)
+	if err != nil {
+		s.logger.Error("failed to count users within radius", zap.String("userId", requesterID), zap.Error(err))
+		return nil, err
+	}
+
+	return &dto.UserProximityResponse{
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
+		Radius:    req.Radius,
+		UserCount: count,
+	}, nil
 }
 
 func (s *userService) getUserForUpdate(ctx context.Context, userID string) (*model.User, error) {
@@ -276,7 +739,22 @@ func (s *userService) getUserForUpdate(ctx context.Context, userID string) (*mod
 	return s.userRepo.GetByID(ctx, id)
 }
 
-func (s *userService) applyUserUpdates(user *model.User, req dto.UpdateUserRequest) {
+func (s *userService) checkPhoneAvailable(ctx context.Context, phone string, userID uuid.UUID) error {
+	if !s.cfg.UniqueVerifiedPhones {
+		return nil
+	}
+
+	taken, err := s.userRepo.IsPhoneVerifiedElsewhere(ctx, phone, userID)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return apperrors.AlreadyExists("phone number is already verified on another account")
+	}
+	return nil
+}
+
+func (s *userService) applyUserUpdates(user *model.User, req dto.UpdateUserRequest) bool {
 	if req.FirstName != nil {
 		user.FirstName = *req.FirstName
 	}
@@ -290,16 +768,43 @@ func (s *userService) applyUserUpdates(user *model.User, req dto.UpdateUserReque
 	if req.DateOfBirth != nil {
 		user.DateOfBirth = *req.DateOfBirth
 	}
+	addressChanged := false
 	if req.Address != nil {
+		addressChanged = addressChanged || user.Address != *req.Address
 		user.Address = *req.Address
 	}
 	if req.City != nil {
+		addressChanged = addressChanged || user.City != *req.City
 		user.City = *req.City
 	}
 	if req.State != nil {
+		addressChanged = addressChanged || user.State != *req.State
 		user.State = *req.State
 	}
 	if req.ZipCode != nil {
+		addressChanged = addressChanged || user.ZipCode != *req.ZipCode
 		user.ZipCode = *req.ZipCode
 	}
+	return addressChanged
+}
+
+func (s *userService) geocodeUser(ctx context.Context, user *model.User) {
+	user.Latitude = nil
+	user.Longitude = nil
+
+	coords, err := s.geocoder.Geocode(ctx, geo.Address{
+		Street:  user.Address,
+		City:    user.City,
+		State:   user.State,
+		ZipCode: user.ZipCode,
+	})
+	if err != nil {
+		if !errors.Is(err, geo.ErrNotFound) {
+			s.logger.Warn("failed to geocode user address", zap.String("userId", user.ID.String()), zap.Error(err))
+		}
+		return
+	}
+
+	user.Latitude = &coords.Latitude
+	user.Longitude = &coords.Longitude
 }
@@ -0,0 +1,189 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// placeHoldScript drops the dumpster's expired holds, refuses the new hold
+// if another user holds an overlapping range, and otherwise stores it. Each
+// hold is a hash field keyed by token with the value
+// "userID|startMs|endMs|expiresAtMs". It returns 1 when the hold was placed.
+var placeHoldScript = redis.NewScript(`
+local now = tonumber(ARGV[5])
+local start = tonumber(ARGV[3])
+local finish = tonumber(ARGV[4])
+local holds = redis.call('HGETALL', KEYS[1])
+for i = 1, #holds, 2 do
+	local user, s, e, exp = string.match(holds[i + 1], '^([^|]+)|(%d+)|(%d+)|(%d+)$')
+	if exp == nil or tonumber(exp) <= now then
+		redis.call('HDEL', KEYS[1], holds[i])
+	elseif user ~= ARGV[2] and tonumber(s) < finish and tonumber(e) > start then
+		return 0
+	end
+end
+redis.call('HSET', KEYS[1], ARGV[1], ARGV[2] .. '|' .. ARGV[3] .. '|' .. ARGV[4] .. '|' .. ARGV[6])
+if redis.call('PTTL', KEYS[1]) < tonumber(ARGV[7]) then
+	redis.call('PEXPIRE', KEYS[1], ARGV[7])
+end
+return 1
+`)
+
+// BookingHold reserves [Start, End) on a dumpster for one user until
+// ExpiresAt, so the dates can't be booked by anyone else in the meantime.
+type BookingHold struct {
+	Token      string
+	DumpsterID uuid.UUID
+	UserID     uuid.UUID
+	Start      time.Time
+	End        time.Time
+	ExpiresAt  time.Time
+}
+
+func (h *BookingHold) overlaps(start, end time.Time) bool {
+	return h.Start.Before(end) && h.End.After(start)
+}
+
+// BookingHoldCache keeps short-lived holds per dumpster. Holds expire on
+// their own; nothing needs to clean them up.
+type BookingHoldCache interface {
+	Place(ctx context.Context, hold *BookingHold) (bool, error)
+	HasConflict(ctx context.Context, dumpsterID, userID uuid.UUID, start, end time.Time) (bool, error)
+	Consume(ctx context.Context, dumpsterID, userID uuid.UUID, token string) (bool, error)
+}
+
+type bookingHoldCache struct {
+	client *redis.Client
+}
+
+func NewBookingHoldCache(client *redis.Client) BookingHoldCache {
+	return &bookingHoldCache{
+		client: client,
+	}
+}
+
+func bookingHoldsKey(dumpsterID uuid.UUID) string {
+	return fmt.Sprintf("booking_holds:%s", dumpsterID.String())
+}
+
+// Place stores the hold unless another user holds an overlapping range, in
+// which case it returns false. The caller's own holds never conflict.
+func (c *bookingHoldCache) Place(ctx context.Context, hold *BookingHold) (bool, error) {
+	now := time.Now()
+	ttl := hold.ExpiresAt.Sub(now)
+	if ttl <= 0 {
+		return false, nil
+	}
+
+	placed, err := placeHoldScript.Run(ctx, c.client, []string{bookingHoldsKey(hold.DumpsterID)},
+		hold.Token,
+		hold.UserID.String(),
+		hold.Start.UnixMilli(),
+		hold.End.UnixMilli(),
+		now.UnixMilli(),
+		hold.ExpiresAt.UnixMilli(),
+		ttl.Milliseconds(),
+	).Int64()
+	if err != nil {
+		return false, err
+	}
+	return placed == 1, nil
+}
+
+// HasConflict reports whether a live hold by anyone other than userID
+// overlaps [start, end). Pass uuid.Nil to count every hold.
+func (c *bookingHoldCache) HasConflict(
+	ctx context.Context,
+	dumpsterID, userID uuid.UUID,
+	start, end time.Time) (bool, error) {
+	holds, err := c.list(ctx, dumpsterID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, hold := range holds {
+		if hold.UserID != userID && hold.overlaps(start, end) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Consume releases the user's hold with the given token, reporting whether a
+// live hold was found. Tokens held by other users are left alone.
+func (c *bookingHoldCache) Consume(ctx context.Context, dumpsterID, userID uuid.UUID, token string) (bool, error) {
+	key := bookingHoldsKey(dumpsterID)
+
+	value, err := c.client.HGet(ctx, key, token).Result()
+	if errors.Is(err, redis.Nil) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	hold, ok := parseBookingHold(dumpsterID, token, value)
+	if !ok || hold.UserID != userID {
+		return false, nil
+	}
+
+	if err := c.client.HDel(ctx, key, token).Err(); err != nil {
+		return false, err
+	}
+	return hold.ExpiresAt.After(time.Now()), nil
+}
+
+// list returns the dumpster's live holds, skipping expired and malformed ones.
+func (c *bookingHoldCache) list(ctx context.Context, dumpsterID uuid.UUID) ([]*BookingHold, error) {
+	raw, err := c.client.HGetAll(ctx, bookingHoldsKey(dumpsterID)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	holds := make([]*BookingHold, 0, len(raw))
+	for token, value := range raw {
+		hold, ok := parseBookingHold(dumpsterID, token, value)
+		if !ok || !hold.ExpiresAt.After(now) {
+			continue
+		}
+		holds = append(holds, hold)
+	}
+	return holds, nil
+}
+
+func parseBookingHold(dumpsterID uuid.UUID, token, value string) (*BookingHold, bool) {
+	parts := strings.Split(value, "|")
+	if len(parts) != 4 {
+		return nil, false
+	}
+
+	userID, err := uuid.Parse(parts[0])
+	if err != nil {
+		return nil, false
+	}
+
+	var millis [3]int64
+	for i, part := range parts[1:] {
+		millis[i], err = strconv.ParseInt(part, 10, 64)
+		if err != nil {
+			return nil, false
+		}
+	}
+
+	return &BookingHold{
+		Token:      token,
+		DumpsterID: dumpsterID,
+		UserID:     userID,
+		Start:      time.UnixMilli(millis[0]),
+		End:        time.UnixMilli(millis[1]),
+		ExpiresAt:  time.UnixMilli(millis[2]),
+	}, true
+}
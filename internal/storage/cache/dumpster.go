@@ -0,0 +1,95 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+	"waste-space/internal/dto"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// ErrDumpsterNotCached is returned by DumpsterCache.Get when nothing, not
+// even a not-found marker, is cached for the ID.
+var ErrDumpsterNotCached = errors.New("dumpster not cached")
+
+// missingDumpster marks an ID recently looked up and not found.
+const missingDumpster = "-"
+
+// DumpsterCache holds dumpster-by-ID responses. Entries are the response
+// every viewer gets; anything that depends on who is asking must be applied
+// after the read, never stored here.
+type DumpsterCache interface {
+	// Get returns the cached response, nil for a cached not-found, or
+	// ErrDumpsterNotCached.
+	Get(ctx context.Context, id uuid.UUID) (*dto.DumpsterResponse, error)
+	Set(ctx context.Context, response *dto.DumpsterResponse, ttl time.Duration) error
+	SetMissing(ctx context.Context, id uuid.UUID, ttl time.Duration) error
+	Invalidate(ctx context.Context, ids ...uuid.UUID) error
+}
+
+type dumpsterCache struct {
+	client *redis.Client
+}
+
+func NewDumpsterCache(client *redis.Client) DumpsterCache {
+	return &dumpsterCache{
+		client: client,
+	}
+}
+
+func dumpsterKey(id uuid.UUID) string {
+	return fmt.Sprintf("dumpster:%s", id.String())
+}
+
+func (c *dumpsterCache) Get(ctx context.Context, id uuid.UUID) (*dto.DumpsterResponse, error) {
+	raw, err := c.client.Get(ctx, dumpsterKey(id)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrDumpsterNotCached
+		}
+		return nil, err
+	}
+
+	if string(raw) == missingDumpster {
+		return nil, nil
+	}
+
+	var response dto.DumpsterResponse
+	if err := json.Unmarshal(raw, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (c *dumpsterCache) Set(ctx context.Context, response *dto.DumpsterResponse, ttl time.Duration) error {
+	id, err := uuid.Parse(response.ID)
+	if err != nil {
+		return err
+	}
+
+	raw, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+	return c.client.Set(ctx, dumpsterKey(id), raw, ttl).Err()
+}
+
+func (c *dumpsterCache) SetMissing(ctx context.Context, id uuid.UUID, ttl time.Duration) error {
+	return c.client.Set(ctx, dumpsterKey(id), missingDumpster, ttl).Err()
+}
+
+func (c *dumpsterCache) Invalidate(ctx context.Context, ids ...uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = dumpsterKey(id)
+	}
+	return c.client.Del(ctx, keys...).Err()
+}
@@ -0,0 +1,92 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// loginFailureScript counts a failed login in a fixed window and, once the
+// count reaches the threshold, swaps the counter for a lock key. It returns
+// the lock's remaining milliseconds, or 0 while the account is not locked.
+var loginFailureScript = redis.NewScript(`
+local count = redis.call('INCR', KEYS[1])
+if count == 1 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[1])
+end
+if count >= tonumber(ARGV[2]) then
+	redis.call('SET', KEYS[2], 1, 'PX', ARGV[3])
+	redis.call('DEL', KEYS[1])
+	return tonumber(ARGV[3])
+end
+return 0
+`)
+
+// LoginAttemptCache tracks failed logins per email address. Addresses are
+// hashed before use as keys, and are tracked whether or not an account
+// exists for them.
+type LoginAttemptCache interface {
+	RecordFailure(ctx context.Context, email string, threshold int, window, cooldown time.Duration) (time.Duration, error)
+	LockRemaining(ctx context.Context, email string) (time.Duration, error)
+	Reset(ctx context.Context, email string) error
+}
+
+type loginAttemptCache struct {
+	client *redis.Client
+}
+
+func NewLoginAttemptCache(client *redis.Client) LoginAttemptCache {
+	return &loginAttemptCache{
+		client: client,
+	}
+}
+
+func loginEmailHash(email string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(email))))
+	return hex.EncodeToString(sum[:])
+}
+
+func loginFailuresKey(email string) string {
+	return fmt.Sprintf("login_failures:%s", loginEmailHash(email))
+}
+
+func loginLockKey(email string) string {
+	return fmt.Sprintf("login_lock:%s", loginEmailHash(email))
+}
+
+// RecordFailure counts one failed login for email within window. When this
+// failure reaches threshold the address is locked for cooldown, which is
+// returned; otherwise the result is zero.
+func (c *loginAttemptCache) RecordFailure(
+	ctx context.Context,
+	email string,
+	threshold int,
+	window, cooldown time.Duration) (time.Duration, error) {
+	keys := []string{loginFailuresKey(email), loginLockKey(email)}
+	locked, err := loginFailureScript.Run(ctx, c.client, keys, window.Milliseconds(), threshold, cooldown.Milliseconds()).Int64()
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(locked) * time.Millisecond, nil
+}
+
+// LockRemaining returns how long email stays locked, or zero if it isn't.
+func (c *loginAttemptCache) LockRemaining(ctx context.Context, email string) (time.Duration, error) {
+	ttl, err := c.client.PTTL(ctx, loginLockKey(email)).Result()
+	if err != nil {
+		return 0, err
+	}
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+func (c *loginAttemptCache) Reset(ctx context.Context, email string) error {
+	return c.client.Del(ctx, loginFailuresKey(email)).Err()
+}
@@ -0,0 +1,51 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"waste-space/internal/model"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const maintenanceKey = "maintenance:state"
+
+type MaintenanceCache interface {
+	GetState(ctx context.Context) (*model.MaintenanceState, error)
+	SetState(ctx context.Context, state *model.MaintenanceState) error
+}
+
+type maintenanceCache struct {
+	client *redis.Client
+}
+
+func NewMaintenanceCache(client *redis.Client) MaintenanceCache {
+	return &maintenanceCache{
+		client: client,
+	}
+}
+
+func (c *maintenanceCache) GetState(ctx context.Context) (*model.MaintenanceState, error) {
+	raw, err := c.client.Get(ctx, maintenanceKey).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return &model.MaintenanceState{Mode: model.MaintenanceModeOff}, nil
+		}
+		return nil, err
+	}
+
+	var state model.MaintenanceState
+	if err := json.Unmarshal(raw, &state); err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+func (c *maintenanceCache) SetState(ctx context.Context, state *model.MaintenanceState) error {
+	raw, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	return c.client.Set(ctx, maintenanceKey, raw, 0).Err()
+}
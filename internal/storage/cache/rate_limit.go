@@ -0,0 +1,54 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// rateLimitScript counts a hit in a fixed window, starting the window's
+// expiry on the first hit, and returns the count and the window's remaining
+// milliseconds.
+var rateLimitScript = redis.NewScript(`
+local count = redis.call('INCR', KEYS[1])
+if count == 1 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[1])
+end
+return {count, redis.call('PTTL', KEYS[1])}
+`)
+
+type RateLimitCache interface {
+	Hit(ctx context.Context, key string, window time.Duration) (int64, time.Duration, error)
+}
+
+type rateLimitCache struct {
+	client *redis.Client
+}
+
+func NewRateLimitCache(client *redis.Client) RateLimitCache {
+	return &rateLimitCache{
+		client: client,
+	}
+}
+
+func rateLimitKey(key string) string {
+	return fmt.Sprintf("rate_limit:%s", key)
+}
+
+// Hit records one request against key and returns how many requests the key
+// has made in the current window and how long until the window resets.
+func (c *rateLimitCache) Hit(ctx context.Context, key string, window time.Duration) (int64, time.Duration, error) {
+	result, err := rateLimitScript.Run(ctx, c.client, []string{rateLimitKey(key)}, window.Milliseconds()).Int64Slice()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	ttl := time.Duration(result[1]) * time.Millisecond
+	if ttl < 0 {
+		ttl = window
+	}
+
+	return result[0], ttl, nil
+}
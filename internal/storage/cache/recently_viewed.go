@@ -0,0 +1,65 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// recentlyViewedTTL drops the history of users who stop browsing.
+const recentlyViewedTTL = 30 * 24 * time.Hour
+
+type RecentlyViewedCache interface {
+	Record(ctx context.Context, userID, dumpsterID uuid.UUID, limit int) error
+	List(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+}
+
+type recentlyViewedCache struct {
+	client *redis.Client
+}
+
+func NewRecentlyViewedCache(client *redis.Client) RecentlyViewedCache {
+	return &recentlyViewedCache{
+		client: client,
+	}
+}
+
+func recentlyViewedKey(userID uuid.UUID) string {
+	return fmt.Sprintf("recently_viewed:%s", userID.String())
+}
+
+// Record moves dumpsterID to the head of the user's list, removing any earlier
+// occurrence, and trims the list to limit entries.
+func (c *recentlyViewedCache) Record(ctx context.Context, userID, dumpsterID uuid.UUID, limit int) error {
+	key := recentlyViewedKey(userID)
+	id := dumpsterID.String()
+
+	pipe := c.client.TxPipeline()
+	pipe.LRem(ctx, key, 0, id)
+	pipe.LPush(ctx, key, id)
+	pipe.LTrim(ctx, key, 0, int64(limit-1))
+	pipe.Expire(ctx, key, recentlyViewedTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// List returns the user's viewed dumpster IDs, most recent first.
+func (c *recentlyViewedCache) List(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	raw, err := c.client.LRange(ctx, recentlyViewedKey(userID), 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(raw))
+	for _, value := range raw {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
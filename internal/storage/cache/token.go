@@ -2,17 +2,21 @@ package cache
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
+	"waste-space/internal/model"
 
 	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
 )
 
 type TokenCache interface {
-	SetRefreshToken(ctx context.Context, userID uuid.UUID, token string, ttl time.Duration) error
-	GetRefreshToken(ctx context.Context, userID uuid.UUID) (string, error)
-	DeleteRefreshToken(ctx context.Context, userID uuid.UUID) error
+	SetSession(ctx context.Context, session *model.Session, ttl time.Duration) error
+	GetSession(ctx context.Context, userID, sessionID uuid.UUID) (*model.Session, error)
+	ListSessions(ctx context.Context, userID uuid.UUID) ([]*model.Session, error)
+	DeleteSession(ctx context.Context, userID, sessionID uuid.UUID) error
 	BlacklistAccessToken(ctx context.Context, token string, ttl time.Duration) error
 	IsAccessTokenBlacklisted(ctx context.Context, token string) (bool, error)
 }
@@ -27,19 +31,83 @@ func NewTokenCache(client *redis.Client) TokenCache {
 	}
 }
 
-func (c *tokenCache) SetRefreshToken(ctx context.Context, userID uuid.UUID, token string, ttl time.Duration) error {
-	key := fmt.Sprintf("refresh_token:%s", userID.String())
-	return c.client.Set(ctx, key, token, ttl).Err()
+func sessionKey(userID, sessionID uuid.UUID) string {
+	return fmt.Sprintf("session:%s:%s", userID.String(), sessionID.String())
 }
 
-func (c *tokenCache) GetRefreshToken(ctx context.Context, userID uuid.UUID) (string, error) {
-	key := fmt.Sprintf("refresh_token:%s", userID.String())
-	return c.client.Get(ctx, key).Result()
+func sessionIndexKey(userID uuid.UUID) string {
+	return fmt.Sprintf("sessions:%s", userID.String())
 }
 
-func (c *tokenCache) DeleteRefreshToken(ctx context.Context, userID uuid.UUID) error {
-	key := fmt.Sprintf("refresh_token:%s", userID.String())
-	return c.client.Del(ctx, key).Err()
+func (c *tokenCache) SetSession(ctx context.Context, session *model.Session, ttl time.Duration) error {
+	raw, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+
+	pipe := c.client.TxPipeline()
+	pipe.Set(ctx, sessionKey(session.UserID, session.ID), raw, ttl)
+	pipe.SAdd(ctx, sessionIndexKey(session.UserID), session.ID.String())
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+func (c *tokenCache) GetSession(ctx context.Context, userID, sessionID uuid.UUID) (*model.Session, error) {
+	raw, err := c.client.Get(ctx, sessionKey(userID, sessionID)).Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	var session model.Session
+	if err := json.Unmarshal(raw, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// ListSessions returns the user's live sessions. Index entries whose session
+// key has already expired are pruned along the way.
+func (c *tokenCache) ListSessions(ctx context.Context, userID uuid.UUID) ([]*model.Session, error) {
+	ids, err := c.client.SMembers(ctx, sessionIndexKey(userID)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*model.Session, 0, len(ids))
+	var stale []any
+	for _, id := range ids {
+		sessionID, err := uuid.Parse(id)
+		if err != nil {
+			stale = append(stale, id)
+			continue
+		}
+
+		session, err := c.GetSession(ctx, userID, sessionID)
+		if err != nil {
+			if errors.Is(err, redis.Nil) {
+				stale = append(stale, id)
+				continue
+			}
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+
+	if len(stale) > 0 {
+		if err := c.client.SRem(ctx, sessionIndexKey(userID), stale...).Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return sessions, nil
+}
+
+func (c *tokenCache) DeleteSession(ctx context.Context, userID, sessionID uuid.UUID) error {
+	pipe := c.client.TxPipeline()
+	pipe.Del(ctx, sessionKey(userID, sessionID))
+	pipe.SRem(ctx, sessionIndexKey(userID), sessionID.String())
+	_, err := pipe.Exec(ctx)
+	return err
 }
 
 func (c *tokenCache) BlacklistAccessToken(ctx context.Context, token string, ttl time.Duration) error {
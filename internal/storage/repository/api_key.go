@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+	"waste-space/internal/model"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *model.APIKey) error
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]*model.APIKey, error)
+	CountActiveByUser(ctx context.Context, userID uuid.UUID) (int64, error)
+	GetByHash(ctx context.Context, keyHash string) (*model.APIKey, error)
+	Revoke(ctx context.Context, userID, id uuid.UUID, revokedAt time.Time) error
+	TouchLastUsed(ctx context.Context, id uuid.UUID, usedAt time.Time) error
+}
+
+type apiKeyRepository struct {
+	db *gorm.DB
+}
+
+func NewAPIKeyRepository(db *gorm.DB) APIKeyRepository {
+	return &apiKeyRepository{db: db}
+}
+
+func (r *apiKeyRepository) Create(ctx context.Context, key *model.APIKey) error {
+	if err := r.db.WithContext(ctx).Create(key).Error; err != nil {
+		return apperrors.Internal("failed to create API key", err)
+	}
+	return nil
+}
+
+func (r *apiKeyRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]*model.APIKey, error) {
+	var keys []*model.APIKey
+	result := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC, id DESC").
+		Find(&keys)
+	if result.Error != nil {
+		return nil, apperrors.Internal("failed to list API keys", result.Error)
+	}
+	return keys, nil
+}
+
+func (r *apiKeyRepository) CountActiveByUser(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var count int64
+	result := r.db.WithContext(ctx).
+		Model(&model.APIKey{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Count(&count)
+	if result.Error != nil {
+		return 0, apperrors.Internal("failed to count API keys", result.Error)
+	}
+	return count, nil
+}
+
+func (r *apiKeyRepository) GetByHash(ctx context.Context, keyHash string) (*model.APIKey, error) {
+	var key model.APIKey
+	if err := r.db.WithContext(ctx).Where("key_hash = ?", keyHash).First(&key).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, apperrors.NotFound("API key not found")
+		}
+		return nil, apperrors.Internal("failed to get API key", err)
+	}
+	return &key, nil
+}
+
+// Revoke revokes the user's key. Revoking an already revoked key is a no-op;
+// a key that doesn't exist or belongs to someone else is not found.
+func (r *apiKeyRepository) Revoke(ctx context.Context, userID, id uuid.UUID, revokedAt time.Time) error {
+	result := r.db.WithContext(ctx).
+		Model(&model.APIKey{}).
+		Where("id = ? AND user_id = ?", id, userID).
+		Update("revoked_at", gorm.Expr("COALESCE(revoked_at, ?)", revokedAt))
+	if result.Error != nil {
+		return apperrors.Internal("failed to revoke API key", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return apperrors.NotFound("API key not found")
+	}
+
+	return nil
+}
+
+func (r *apiKeyRepository) TouchLastUsed(ctx context.Context, id uuid.UUID, usedAt time.Time) error {
+	result := r.db.WithContext(ctx).
+		Model(&model.APIKey{}).
+		Where("id = ?", id).
+		Update("last_used_at", usedAt)
+	if result.Error != nil {
+		return apperrors.Internal("failed to record API key use", result.Error)
+	}
+	return nil
+}
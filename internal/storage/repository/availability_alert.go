@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"waste-space/internal/model"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type AvailabilityAlertRepository interface {
+	Create(ctx context.Context, alert *model.AvailabilityAlert) error
+	Delete(ctx context.Context, userID, dumpsterID uuid.UUID) error
+	ClaimByDumpsterID(ctx context.Context, dumpsterID uuid.UUID) ([]*model.AvailabilityAlert, error)
+}
+
+type availabilityAlertRepository struct {
+	db *gorm.DB
+}
+
+func NewAvailabilityAlertRepository(db *gorm.DB) AvailabilityAlertRepository {
+	return &availabilityAlertRepository{db: db}
+}
+
+// Create subscribes the user to the dumpster. Subscribing twice is a no-op.
+func (r *availabilityAlertRepository) Create(ctx context.Context, alert *model.AvailabilityAlert) error {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "dumpster_id"}},
+		DoNothing: true,
+	}).Create(alert)
+	if result.Error != nil {
+		return apperrors.Internal("failed to create availability alert", result.Error)
+	}
+	return nil
+}
+
+func (r *availabilityAlertRepository) Delete(ctx context.Context, userID, dumpsterID uuid.UUID) error {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ? AND dumpster_id = ?", userID, dumpsterID).
+		Delete(&model.AvailabilityAlert{})
+	if result.Error != nil {
+		return apperrors.Internal("failed to delete availability alert", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return apperrors.NotFound("availability alert not found")
+	}
+
+	return nil
+}
+
+// ClaimByDumpsterID deletes and returns every alert on the dumpster in one
+// statement, so concurrent callers never notify the same subscriber twice.
+func (r *availabilityAlertRepository) ClaimByDumpsterID(
+	ctx context.Context,
+	dumpsterID uuid.UUID) ([]*model.AvailabilityAlert, error) {
+	var alerts []*model.AvailabilityAlert
+	result := r.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Where("dumpster_id = ?", dumpsterID).
+		Delete(&alerts)
+	if result.Error != nil {
+		return nil, apperrors.Internal("failed to claim availability alerts", result.Error)
+	}
+	return alerts, nil
+}
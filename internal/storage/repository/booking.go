@@ -0,0 +1,305 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+	"waste-space/internal/dto"
+	"waste-space/internal/model"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type BookingRepository interface {
+	Create(ctx context.Context, booking *model.Booking) error
+	GetByID(ctx context.Context, id uuid.UUID) (*model.Booking, error)
+	HasOverlap(ctx context.Context, dumpsterID uuid.UUID, start, end time.Time) (bool, error)
+	GetBookedDumpsterIDs(ctx context.Context, dumpsterIDs []uuid.UUID, at time.Time) (map[uuid.UUID]bool, error)
+	Confirm(ctx context.Context, id uuid.UUID, confirmedAt time.Time) error
+	ExpirePending(ctx context.Context, createdBefore time.Time) ([]*model.Booking, error)
+	EndEarly(ctx context.Context, booking *model.Booking, originalEnd time.Time, refund *model.Payment) error
+	GetUpcomingByUser(ctx context.Context, userID uuid.UUID, from time.Time) ([]*model.Booking, error)
+	GetByDumpsterIDBefore(ctx context.Context, dumpsterID uuid.UUID, before Keyset, limit int) ([]*model.Booking, error)
+	CountPendingByOwner(ctx context.Context, ownerID uuid.UUID) (int64, error)
+	GetOwnerRevenueByDumpster(ctx context.Context, ownerID uuid.UUID, from, to time.Time) ([]dto.StatementRevenueRow, error)
+	GetLeadTimeStats(ctx context.Context, dumpsterID uuid.UUID) (*dto.BookingLeadTimeResponse, error)
+}
+
+type bookingRepository struct {
+	db *gorm.DB
+}
+
+func NewBookingRepository(db *gorm.DB) BookingRepository {
+	return &bookingRepository{db: db}
+}
+
+// Create inserts the booking while holding an exclusive lock on its
+// dumpster, so concurrent bookings for the same dumpster are serialized and
+// the dumpster can't be deleted before the insert. Dates overlapping a
+// pending or confirmed booking are refused with AlreadyExists.
+func (r *bookingRepository) Create(ctx context.Context, booking *model.Booking) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockDumpster(tx, booking.DumpsterID, "UPDATE"); err != nil {
+			return err
+		}
+
+		overlaps, err := hasOverlap(tx, booking.DumpsterID, booking.StartDate, booking.EndDate)
+		if err != nil {
+			return err
+		}
+		if overlaps {
+			return apperrors.AlreadyExists("dumpster is already booked for the requested dates")
+		}
+
+		if err := tx.Create(booking).Error; err != nil {
+			return apperrors.Internal("failed to create booking", err)
+		}
+
+		return nil
+	})
+}
+
+func withDeletedDumpster(db *gorm.DB) *gorm.DB {
+	return db.Unscoped()
+}
+
+func (r *bookingRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Booking, error) {
+	var booking model.Booking
+	result := r.db.WithContext(ctx).Preload("Dumpster", withDeletedDumpster).Where("id = ?", id).First(&booking)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, apperrors.NotFound("booking not found")
+		}
+		return nil, apperrors.Internal("failed to get booking", result.Error)
+	}
+	return &booking, nil
+}
+
+func (r *bookingRepository) HasOverlap(
+	ctx context.Context,
+	dumpsterID uuid.UUID,
+	start, end time.Time) (bool, error) {
+	return hasOverlap(r.db.WithContext(ctx), dumpsterID, start, end)
+}
+
+func hasOverlap(db *gorm.DB, dumpsterID uuid.UUID, start, end time.Time) (bool, error) {
+	var count int64
+	result := db.
+		Model(&model.Booking{}).
+		Where("dumpster_id = ? AND status IN ?", dumpsterID, []model.BookingStatus{model.BookingStatusPending, model.BookingStatusConfirmed}).
+		Where("start_date < ? AND end_date > ?", end, start).
+		Limit(1).
+		Count(&count)
+	if result.Error != nil {
+		return false, apperrors.Internal("failed to check booking overlap", result.Error)
+	}
+	return count > 0, nil
+}
+
+// GetBookedDumpsterIDs reports which of the dumpsters have a pending or
+// confirmed booking covering at.
+func (r *bookingRepository) GetBookedDumpsterIDs(
+	ctx context.Context,
+	dumpsterIDs []uuid.UUID,
+	at time.Time) (map[uuid.UUID]bool, error) {
+	booked := make(map[uuid.UUID]bool)
+	if len(dumpsterIDs) == 0 {
+		return booked, nil
+	}
+
+	var ids []uuid.UUID
+	result := r.db.WithContext(ctx).
+		Model(&model.Booking{}).
+		Distinct("dumpster_id").
+		Where("dumpster_id IN ? AND status IN ?", dumpsterIDs, []model.BookingStatus{model.BookingStatusPending, model.BookingStatusConfirmed}).
+		Where("start_date <= ? AND end_date > ?", at, at).
+		Pluck("dumpster_id", &ids)
+	if result.Error != nil {
+		return nil, apperrors.Internal("failed to check current bookings", result.Error)
+	}
+
+	for _, id := range ids {
+		booked[id] = true
+	}
+
+	return booked, nil
+}
+
+// Confirm only transitions bookings that are still pending, so a confirmation
+// racing the expiry sweep resolves to whichever update commits first.
+func (r *bookingRepository) Confirm(ctx context.Context, id uuid.UUID, confirmedAt time.Time) error {
+	result := r.db.WithContext(ctx).
+		Model(&model.Booking{}).
+		Where("id = ? AND status = ?", id, model.BookingStatusPending).
+		Updates(map[string]any{
+			"status":       model.BookingStatusConfirmed,
+			"confirmed_at": confirmedAt,
+		})
+	if result.Error != nil {
+		return apperrors.Internal("failed to confirm booking", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return apperrors.BadRequest("booking is no longer pending")
+	}
+
+	return nil
+}
+
+func (r *bookingRepository) ExpirePending(ctx context.Context, createdBefore time.Time) ([]*model.Booking, error) {
+	var bookings []*model.Booking
+	result := r.db.WithContext(ctx).
+		Model(&bookings).
+		Clauses(clause.Returning{}).
+		Where("status = ? AND created_at < ?", model.BookingStatusPending, createdBefore).
+		Updates(map[string]any{
+			"status":     model.BookingStatusExpired,
+			"expired_at": time.Now(),
+		})
+	if result.Error != nil {
+		return nil, apperrors.Internal("failed to expire pending bookings", result.Error)
+	}
+	return bookings, nil
+}
+
+// GetUpcomingByUser returns the user's confirmed bookings starting at or
+// after from, soonest first, with their dumpsters loaded.
+func (r *bookingRepository) GetUpcomingByUser(
+	ctx context.Context,
+	userID uuid.UUID,
+	from time.Time) ([]*model.Booking, error) {
+	var bookings []*model.Booking
+	result := r.db.WithContext(ctx).
+		Preload("Dumpster", withDeletedDumpster).
+		Where("user_id = ? AND status = ? AND start_date >= ?", userID, model.BookingStatusConfirmed, from).
+		Order("start_date ASC").
+		Find(&bookings)
+	if result.Error != nil {
+		return nil, apperrors.Internal("failed to get upcoming bookings", result.Error)
+	}
+	return bookings, nil
+}
+
+// EndEarly saves the shortened, repriced booking and, when refund is set,
+// its updated refund totals in one transaction. The booking is only updated
+// while it is still confirmed and still ends at originalEnd, so two
+// concurrent requests cannot both shorten it.
+func (r *bookingRepository) EndEarly(
+	ctx context.Context,
+	booking *model.Booking,
+	originalEnd time.Time,
+	refund *model.Payment) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.Booking{}).
+			Where("id = ? AND status = ? AND end_date = ?", booking.ID, model.BookingStatusConfirmed, originalEnd).
+			Updates(map[string]any{
+				"end_date":       booking.EndDate,
+				"subtotal":       booking.Subtotal,
+				"tax":            booking.Tax,
+				"total_price":    booking.TotalPrice,
+				"ended_early_at": booking.EndedEarlyAt,
+			})
+		if result.Error != nil {
+			return apperrors.Internal("failed to end booking early", result.Error)
+		}
+
+		if result.RowsAffected == 0 {
+			return apperrors.BadRequest("booking changed while being ended early")
+		}
+
+		if refund == nil {
+			return nil
+		}
+
+		if err := tx.Model(&model.Payment{}).
+			Where("id = ?", refund.ID).
+			Updates(map[string]any{
+				"refunded_amount":  refund.RefundedAmount,
+				"refund_reference": refund.RefundReference,
+				"refunded_at":      refund.RefundedAt,
+			}).Error; err != nil {
+			return apperrors.Internal("failed to record refund", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *bookingRepository) GetByDumpsterIDBefore(
+	ctx context.Context,
+	dumpsterID uuid.UUID,
+	before Keyset,
+	limit int) ([]*model.Booking, error) {
+	var bookings []*model.Booking
+	result := beforeKeyset(r.db.WithContext(ctx), before).
+		Where("dumpster_id = ?", dumpsterID).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&bookings)
+	if result.Error != nil {
+		return nil, apperrors.Internal("failed to get bookings", result.Error)
+	}
+	return bookings, nil
+}
+
+func (r *bookingRepository) CountPendingByOwner(ctx context.Context, ownerID uuid.UUID) (int64, error) {
+	var count int64
+	result := r.db.WithContext(ctx).
+		Model(&model.Booking{}).
+		Where("status = ?", model.BookingStatusPending).
+		Where("dumpster_id IN (?)", r.db.Model(&model.Dumpster{}).Select("id").Where("owner_id = ?", ownerID)).
+		Count(&count)
+	if result.Error != nil {
+		return 0, apperrors.Internal("failed to count pending bookings", result.Error)
+	}
+	return count, nil
+}
+
+// GetOwnerRevenueByDumpster counts and sums the subtotals, per listing, of
+// the owner's confirmed bookings that ended in [from, to), including
+// listings since deleted. Tax is left out since it isn't the owner's.
+func (r *bookingRepository) GetOwnerRevenueByDumpster(
+	ctx context.Context,
+	ownerID uuid.UUID,
+	from, to time.Time) ([]dto.StatementRevenueRow, error) {
+	var rows []dto.StatementRevenueRow
+	result := r.db.WithContext(ctx).
+		Model(&model.Booking{}).
+		Select("dumpsters.id AS dumpster_id, dumpsters.title AS title, COUNT(*) AS count, "+
+			"COALESCE(SUM(bookings.subtotal), 0) AS revenue").
+		Joins("JOIN dumpsters ON dumpsters.id = bookings.dumpster_id").
+		Where("dumpsters.owner_id = ? AND bookings.status = ?", ownerID, model.BookingStatusConfirmed).
+		Where("bookings.end_date >= ? AND bookings.end_date < ?", from, to).
+		Group("dumpsters.id, dumpsters.title").
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, apperrors.Internal("failed to get booking revenue by dumpster", result.Error)
+	}
+
+	return rows, nil
+}
+
+// GetLeadTimeStats averages, and takes the median of, the days between
+// creating and starting each confirmed booking of the dumpster. Bookings
+// made after their start date count as zero days ahead.
+func (r *bookingRepository) GetLeadTimeStats(ctx context.Context, dumpsterID uuid.UUID) (*dto.BookingLeadTimeResponse, error) {
+	var stats dto.BookingLeadTimeResponse
+
+	leadDays := "GREATEST(EXTRACT(EPOCH FROM (start_date - created_at)) / 86400, 0)"
+	result := r.db.WithContext(ctx).
+		Model(&model.Booking{}).
+		Select(
+			"COUNT(*) AS bookings, "+
+				"COALESCE(AVG("+leadDays+"), 0) AS average_days, "+
+				"COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY "+leadDays+"), 0) AS median_days",
+		).
+		Where("dumpster_id = ? AND status = ?", dumpsterID, model.BookingStatusConfirmed).
+		Scan(&stats)
+	if result.Error != nil {
+		return nil, apperrors.Internal("failed to compute booking lead time", result.Error)
+	}
+	return &stats, nil
+}
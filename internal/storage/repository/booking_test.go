@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+	"waste-space/internal/model"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+func newTestBooking(dumpsterID, userID uuid.UUID, start time.Time) *model.Booking {
+	return &model.Booking{
+		DumpsterID: dumpsterID,
+		UserID:     userID,
+		StartDate:  start,
+		EndDate:    start.Add(48 * time.Hour),
+		Subtotal:   10000,
+		TotalPrice: 10000,
+		Status:     model.BookingStatusPending,
+	}
+}
+
+func TestBookingCreateRejectsConcurrentOverlap(t *testing.T) {
+	gdb := openTestDB(t)
+	repo := NewBookingRepository(gdb)
+	ctx := context.Background()
+
+	owner := createTestUser(t, gdb)
+	dumpster := createTestDumpster(t, gdb, owner.ID)
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+
+	var wg sync.WaitGroup
+	errs := make([]error, 4)
+	for i := range errs {
+		renter := createTestUser(t, gdb)
+		booking := newTestBooking(dumpster.ID, renter.ID, start.Add(time.Duration(i)*time.Hour))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = repo.Create(ctx, booking)
+		}()
+	}
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			succeeded++
+		case !apperrors.Is(err, apperrors.ErrorTypeAlreadyExists):
+			t.Fatalf("overlapping booking returned %v, want AlreadyExists", err)
+		}
+	}
+	if succeeded != 1 {
+		t.Fatalf("%d overlapping bookings were created, want 1", succeeded)
+	}
+}
+
+func TestBookingConfirmRacesExpiry(t *testing.T) {
+	gdb := openTestDB(t)
+	repo := NewBookingRepository(gdb)
+	ctx := context.Background()
+
+	owner := createTestUser(t, gdb)
+	renter := createTestUser(t, gdb)
+	dumpster := createTestDumpster(t, gdb, owner.ID)
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+
+	for i := range 20 {
+		booking := newTestBooking(dumpster.ID, renter.ID, start.Add(time.Duration(i)*72*time.Hour))
+		if err := repo.Create(ctx, booking); err != nil {
+			t.Fatalf("create booking: %v", err)
+		}
+
+		var (
+			wg         sync.WaitGroup
+			confirmErr error
+			expired    []*model.Booking
+			expireErr  error
+		)
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			confirmErr = repo.Confirm(ctx, booking.ID, time.Now())
+		}()
+		go func() {
+			defer wg.Done()
+			expired, expireErr = repo.ExpirePending(ctx, time.Now().Add(time.Minute))
+		}()
+		wg.Wait()
+
+		if expireErr != nil {
+			t.Fatalf("expire pending: %v", expireErr)
+		}
+		if confirmErr != nil && !apperrors.Is(confirmErr, apperrors.ErrorTypeBadRequest) {
+			t.Fatalf("confirm returned %v, want nil or BadRequest", confirmErr)
+		}
+
+		confirmed := confirmErr == nil
+		if wasExpired := len(expired) == 1; confirmed == wasExpired {
+			t.Fatalf("confirmed=%v expired=%d: exactly one side must win", confirmed, len(expired))
+		}
+
+		want := model.BookingStatusExpired
+		if confirmed {
+			want = model.BookingStatusConfirmed
+		}
+		var got model.Booking
+		if err := gdb.First(&got, "id = ?", booking.ID).Error; err != nil {
+			t.Fatalf("reload booking: %v", err)
+		}
+		if got.Status != want {
+			t.Fatalf("booking status %q, want %q", got.Status, want)
+		}
+	}
+}
@@ -0,0 +1,10 @@
+package repository
+
+import "gorm.io/gorm"
+
+func deleteScope(db *gorm.DB, hard bool) *gorm.DB {
+	if hard {
+		return db.Unscoped()
+	}
+	return db
+}
@@ -2,14 +2,18 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 	"waste-space/internal/dto"
 	"waste-space/internal/model"
 	apperrors "waste-space/pkg/errors"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 const (
@@ -17,38 +21,141 @@ const (
 	maxPageSize           = 100
 	defaultNearbyDistance = 25.0
 	earthRadiusKm         = 6371.0
+	maxSlugAttempts       = 10
+	fullZipCodeLength     = 5
 )
 
+const notSnoozedCondition = "(unavailable_until IS NULL OR unavailable_until <= NOW())"
+
+const publishedCondition = "unpublished_at IS NULL"
+
+const hasAllTagsCondition = `id IN (
+	SELECT dumpster_id FROM dumpster_tags
+	WHERE tag IN ?
+	GROUP BY dumpster_id
+	HAVING COUNT(*) = ?
+)`
+
+const activeOwnerCondition = `owner_id IN (
+	SELECT id FROM users WHERE is_active = true AND deleted_at IS NULL
+)`
+
+const notInUseCondition = `NOT EXISTS (
+	SELECT 1 FROM dumpster_usages
+	WHERE dumpster_usages.dumpster_id = dumpsters.id
+	AND dumpster_usages.status = ?
+	AND dumpster_usages.deleted_at IS NULL
+)`
+
 type DumpsterRepository interface {
 	Create(ctx context.Context, dumpster *model.Dumpster) error
-	GetByID(ctx context.Context, id uuid.UUID) (*model.Dumpster, error)
+	GetByID(ctx context.Context, id uuid.UUID, opts ...QueryOption) (*model.Dumpster, error)
+	GetBySlug(ctx context.Context, slug string, opts ...QueryOption) (*model.Dumpster, error)
+	GetByIDs(ctx context.Context, ids []uuid.UUID, opts ...QueryOption) ([]*model.Dumpster, error)
 	Update(ctx context.Context, dumpster *model.Dumpster) error
 	Delete(ctx context.Context, id uuid.UUID) error
-	List(ctx context.Context, req dto.DumpsterListRequest) ([]*model.Dumpster, int64, error)
-	Search(ctx context.Context, req dto.DumpsterSearchRequest) ([]*model.Dumpster, int64, error)
-	FindNearby(ctx context.Context, req dto.NearbyDumpstersRequest) ([]*model.Dumpster, error)
+	DeleteAllByOwner(ctx context.Context, ownerID uuid.UUID, cancelActive bool) (*dto.DeleteOwnerDumpstersResponse, error)
+	Merge(ctx context.Context, keepID, duplicateID uuid.UUID) (*dto.DumpsterMergeResult, error)
+	List(ctx context.Context, req dto.DumpsterListRequest, opts ...QueryOption) ([]*model.Dumpster, int64, error)
+	ListByOwner(ctx context.Context, ownerID uuid.UUID, opts ...QueryOption) ([]*model.Dumpster, error)
+	Search(ctx context.Context, req dto.DumpsterSearchRequest, opts ...QueryOption) ([]*model.Dumpster, int64, error)
+	SearchText(ctx context.Context, query string, limit int, opts ...QueryOption) ([]*model.Dumpster, error)
+	ListUnreviewed(
+		ctx context.Context,
+		ownerID *uuid.UUID,
+		req dto.UnreviewedDumpsterListRequest,
+		opts ...QueryOption) ([]*model.Dumpster, int64, error)
+	FindNearby(ctx context.Context, req dto.NearbyDumpstersRequest, opts ...QueryOption) ([]*model.Dumpster, error)
+	GetDensity(ctx context.Context, req dto.DumpsterDensityRequest) ([]dto.DensityCell, error)
+	CountByState(ctx context.Context, req dto.DumpsterRegionCountsRequest) ([]dto.StateCount, error)
+	CountByCity(ctx context.Context, req dto.DumpsterRegionCountsRequest, limit int) ([]dto.CityCount, error)
+	GetPriceStats(ctx context.Context, req dto.DumpsterPriceStatsRequest) (*dto.DumpsterPriceStatsResponse, error)
+	GetNearbyPriceStats(ctx context.Context, lat, lng, radiusKm float64, size model.DumpsterSize) (*dto.PriceSuggestionStats, error)
+	CountPairedWith(ctx context.Context, id uuid.UUID, sourceUsers, limit int) ([]dto.PairedDumpsterCount, error)
+	CountByOwner(ctx context.Context, ownerID uuid.UUID) (int64, error)
+	GetOwnerListingSummary(ctx context.Context, ownerID uuid.UUID) (int64, float64, error)
+	ReplaceTags(ctx context.Context, dumpsterID uuid.UUID, tags []string) error
+	ApplyRevision(ctx context.Context, dumpster *model.Dumpster, loadedAt time.Time, revision *model.DumpsterRevision) error
+	SetUnpublished(ctx context.Context, id uuid.UUID, unpublished bool) (bool, error)
+	SetFeatured(ctx context.Context, id uuid.UUID, featured bool) error
+	ListFeatured(ctx context.Context, limit int, opts ...QueryOption) ([]*model.Dumpster, error)
+	EnsureShareSecret(ctx context.Context, id uuid.UUID, candidate string) (string, error)
+	SetShareSecret(ctx context.Context, id uuid.UUID, secret string) error
+}
+
+var dumpsterSortOrders = map[string]string{
+	"newest":       "created_at DESC, id DESC",
+	"price":        "price_per_day ASC, id ASC",
+	"rating":       "rating DESC, id DESC",
+	"availability": "is_available DESC, created_at DESC, id DESC",
+}
+
+func IsValidDumpsterSort(sortBy string) bool {
+	_, ok := dumpsterSortOrders[sortBy]
+	return ok
+}
+
+type DumpsterRepositoryConfig struct {
+	AvailabilityTracksUsage bool
+	DefaultSort             string
+	// HardDelete makes Delete and DeleteAllByOwner remove dumpsters
+	// permanently instead of soft-deleting them.
+	HardDelete bool
+	MaxOffset  int
 }
 
 type dumpsterRepository struct {
-	db *gorm.DB
+	db      *gorm.DB
+	replica *gorm.DB
+	cfg     DumpsterRepositoryConfig
 }
 
-func NewDumpsterRepository(db *gorm.DB) DumpsterRepository {
-	return &dumpsterRepository{db: db}
+func NewDumpsterRepository(db, replica *gorm.DB, cfg DumpsterRepositoryConfig) DumpsterRepository {
+	return &dumpsterRepository{db: db, replica: replica, cfg: cfg}
 }
 
+func (r *dumpsterRepository) read(ctx context.Context) *gorm.DB {
+	return readDB(ctx, r.db, r.replica)
+}
+
+// Create inserts the dumpster. If its slug is already taken, a numeric
+// suffix is appended ("-2", "-3", ...) until the insert goes through.
 func (r *dumpsterRepository) Create(ctx context.Context, dumpster *model.Dumpster) error {
-	result := r.db.WithContext(ctx).Create(dumpster)
-	if result.Error != nil {
-		return apperrors.Internal("failed to create dumpster", result.Error)
+	slug := dumpster.Slug
+	for attempt := 1; attempt <= maxSlugAttempts; attempt++ {
+		if attempt > 1 {
+			dumpster.Slug = fmt.Sprintf("%s-%d", slug, attempt)
+		}
+
+		result := r.db.WithContext(ctx).Create(dumpster)
+		if result.Error == nil {
+			return nil
+		}
+		if !isUniqueViolation(result.Error, "idx_dumpsters_slug") {
+			return apperrors.Internal("failed to create dumpster", result.Error)
+		}
 	}
 
-	return nil
+	return apperrors.Internal("failed to create dumpster", fmt.Errorf("no free slug for %q", slug))
+}
+
+func (r *dumpsterRepository) GetByID(ctx context.Context, id uuid.UUID, opts ...QueryOption) (*model.Dumpster, error) {
+	var dumpster model.Dumpster
+	query := applyPreloads(r.db.WithContext(ctx), dumpsterDefaultPreloads, opts)
+	result := query.Where("id = ?", id).First(&dumpster)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, apperrors.NotFound("dumpster not found")
+		}
+		return nil, apperrors.Internal("failed to get dumpster", result.Error)
+	}
+	return &dumpster, nil
 }
 
-func (r *dumpsterRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Dumpster, error) {
+func (r *dumpsterRepository) GetBySlug(ctx context.Context, slug string, opts ...QueryOption) (*model.Dumpster, error) {
 	var dumpster model.Dumpster
-	result := r.db.WithContext(ctx).Preload("Owner").Where("id = ?", id).First(&dumpster)
+	query := applyPreloads(r.db.WithContext(ctx), dumpsterDefaultPreloads, opts)
+	result := query.Where("slug = ?", slug).First(&dumpster)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return nil, apperrors.NotFound("dumpster not found")
@@ -58,8 +165,24 @@ func (r *dumpsterRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.
 	return &dumpster, nil
 }
 
+// GetByIDs loads the dumpsters with the given IDs in no particular order,
+// silently skipping any that no longer exist.
+func (r *dumpsterRepository) GetByIDs(ctx context.Context, ids []uuid.UUID, opts ...QueryOption) ([]*model.Dumpster, error) {
+	var dumpsters []*model.Dumpster
+	if len(ids) == 0 {
+		return dumpsters, nil
+	}
+
+	query := applyPreloads(r.db.WithContext(ctx), dumpsterDefaultPreloads, opts)
+	result := query.Where("id IN ?", ids).Find(&dumpsters)
+	if result.Error != nil {
+		return nil, apperrors.Internal("failed to get dumpsters", result.Error)
+	}
+	return dumpsters, nil
+}
+
 func (r *dumpsterRepository) Update(ctx context.Context, dumpster *model.Dumpster) error {
-	result := r.db.WithContext(ctx).Save(dumpster)
+	result := r.db.WithContext(ctx).Omit("Slug", "Tags", "Images", "UnpublishedAt", "ShareSecret", "IsFeatured").Save(dumpster)
 	if result.Error != nil {
 		return apperrors.Internal("failed to update dumpster", result.Error)
 	}
@@ -71,26 +194,328 @@ func (r *dumpsterRepository) Update(ctx context.Context, dumpster *model.Dumpste
 	return nil
 }
 
+func lockDumpster(tx *gorm.DB, id uuid.UUID, strength string) error {
+	var dumpster model.Dumpster
+	if err := tx.Clauses(clause.Locking{Strength: strength}).
+		Select("id").
+		Where("id = ?", id).
+		First(&dumpster).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return apperrors.NotFound("dumpster not found")
+		}
+		return apperrors.Internal("failed to lock dumpster", err)
+	}
+	return nil
+}
+
+// Delete soft-deletes the dumpster, or with HardDelete configured removes it
+// permanently. A hard delete is irreversible and cascades to the listing's
+// reviews, usages, bookings, tags, images and pricing rules. A dumpster with
+// an active usage, or a pending or confirmed booking that hasn't ended, is
+// refused with AlreadyExists.
 func (r *dumpsterRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	result := r.db.WithContext(ctx).Delete(&model.Dumpster{}, id)
-	if result.Error != nil {
-		return apperrors.Internal("failed to delete dumpster", result.Error)
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockDumpster(tx, id, "UPDATE"); err != nil {
+			return err
+		}
+
+		var active int64
+		if err := tx.Model(&model.DumpsterUsage{}).
+			Where("dumpster_id = ? AND status = ?", id, model.UsageStatusActive).
+			Count(&active).Error; err != nil {
+			return apperrors.Internal("failed to check active usages", err)
+		}
+		if active > 0 {
+			return apperrors.AlreadyExists("dumpster has an active usage and can't be deleted")
+		}
+
+		var booked int64
+		if err := tx.Model(&model.Booking{}).
+			Where("dumpster_id = ? AND status IN ? AND end_date > ?",
+				id, []model.BookingStatus{model.BookingStatusPending, model.BookingStatusConfirmed}, time.Now()).
+			Count(&booked).Error; err != nil {
+			return apperrors.Internal("failed to check upcoming bookings", err)
+		}
+		if booked > 0 {
+			return apperrors.AlreadyExists("dumpster has upcoming bookings and can't be deleted")
+		}
+
+		result := deleteScope(tx, r.cfg.HardDelete).Delete(&model.Dumpster{}, id)
+		if result.Error != nil {
+			return apperrors.Internal("failed to delete dumpster", result.Error)
+		}
+
+		if result.RowsAffected == 0 {
+			return apperrors.NotFound("dumpster not found")
+		}
+
+		return nil
+	})
+}
+
+// DeleteAllByOwner soft-deletes every listing the owner has in one
+// transaction. Listings with an active usage are left alone unless
+// cancelActive is set, in which case those usages are cancelled first.
+// Listings with a pending or confirmed booking that hasn't ended are always
+// left alone, as Delete refuses them.
+func (r *dumpsterRepository) DeleteAllByOwner(
+	ctx context.Context,
+	ownerID uuid.UUID,
+	cancelActive bool) (*dto.DeleteOwnerDumpstersResponse, error) {
+	result := &dto.DeleteOwnerDumpstersResponse{}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var ids []uuid.UUID
+		if err := tx.Model(&model.Dumpster{}).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("owner_id = ?", ownerID).
+			Pluck("id", &ids).Error; err != nil {
+			return apperrors.Internal("failed to lock dumpsters", err)
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		var busy []uuid.UUID
+		if err := tx.Model(&model.DumpsterUsage{}).
+			Distinct("dumpster_id").
+			Where("dumpster_id IN ? AND status = ?", ids, model.UsageStatusActive).
+			Pluck("dumpster_id", &busy).Error; err != nil {
+			return apperrors.Internal("failed to check active usages", err)
+		}
+
+		var booked []uuid.UUID
+		if err := tx.Model(&model.Booking{}).
+			Distinct("dumpster_id").
+			Where("dumpster_id IN ? AND status IN ? AND end_date > ?",
+				ids, []model.BookingStatus{model.BookingStatusPending, model.BookingStatusConfirmed}, time.Now()).
+			Pluck("dumpster_id", &booked).Error; err != nil {
+			return apperrors.Internal("failed to check upcoming bookings", err)
+		}
+
+		kept := make(map[uuid.UUID]struct{}, len(busy)+len(booked))
+		for _, id := range booked {
+			kept[id] = struct{}{}
+		}
+
+		if len(busy) > 0 {
+			if cancelActive {
+				var cancellable []uuid.UUID
+				for _, id := range busy {
+					if _, ok := kept[id]; !ok {
+						cancellable = append(cancellable, id)
+					}
+				}
+				if len(cancellable) > 0 {
+					cancelled := tx.Model(&model.DumpsterUsage{}).
+						Where("dumpster_id IN ? AND status = ?", cancellable, model.UsageStatusActive).
+						Updates(map[string]any{
+							"status":   model.UsageStatusCancelled,
+							"end_time": time.Now(),
+						})
+					if cancelled.Error != nil {
+						return apperrors.Internal("failed to cancel active usages", cancelled.Error)
+					}
+					result.CancelledUsages = cancelled.RowsAffected
+				}
+			} else {
+				for _, id := range busy {
+					kept[id] = struct{}{}
+				}
+			}
+		}
+
+		deletable := make([]uuid.UUID, 0, len(ids))
+		for _, id := range ids {
+			if _, ok := kept[id]; !ok {
+				deletable = append(deletable, id)
+			}
+		}
+		result.Skipped = int64(len(kept))
+
+		if len(deletable) == 0 {
+			return nil
+		}
+
+		deleted := deleteScope(tx, r.cfg.HardDelete).Where("id IN ?", deletable).Delete(&model.Dumpster{})
+		if deleted.Error != nil {
+			return apperrors.Internal("failed to delete dumpsters", deleted.Error)
+		}
+		result.Deleted = deleted.RowsAffected
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	if result.RowsAffected == 0 {
-		return apperrors.NotFound("dumpster not found")
+	return result, nil
+}
+
+func checkMergeConflicts(tx *gorm.DB, keepID, duplicateID uuid.UUID) error {
+	open := []model.BookingStatus{model.BookingStatusPending, model.BookingStatusConfirmed}
+	now := time.Now()
+
+	var overlapping int64
+	if err := tx.Raw(`SELECT COUNT(*) FROM bookings d
+		JOIN bookings k ON k.dumpster_id = @keep AND k.status IN @open AND k.deleted_at IS NULL
+			AND k.start_date < d.end_date AND k.end_date > d.start_date
+		WHERE d.dumpster_id = @duplicate AND d.status IN @open AND d.deleted_at IS NULL`,
+		sql.Named("keep", keepID), sql.Named("duplicate", duplicateID), sql.Named("open", open)).
+		Scan(&overlapping).Error; err != nil {
+		return apperrors.Internal("failed to check overlapping bookings", err)
+	}
+	if overlapping > 0 {
+		return apperrors.AlreadyExists("the duplicate has bookings that overlap the kept dumpster's bookings")
+	}
+
+	var duplicateActive int64
+	if err := tx.Model(&model.DumpsterUsage{}).
+		Where("dumpster_id = ? AND status = ?", duplicateID, model.UsageStatusActive).
+		Count(&duplicateActive).Error; err != nil {
+		return apperrors.Internal("failed to check active usages", err)
+	}
+
+	if duplicateActive > 0 {
+		var keptBooked int64
+		if err := tx.Model(&model.Booking{}).
+			Where("dumpster_id = ? AND status IN ? AND start_date <= ? AND end_date > ?", keepID, open, now, now).
+			Count(&keptBooked).Error; err != nil {
+			return apperrors.Internal("failed to check current bookings", err)
+		}
+		if keptBooked > 0 {
+			return apperrors.AlreadyExists("the duplicate has an active usage while the kept dumpster is booked")
+		}
+	}
+
+	var keep model.Dumpster
+	if err := tx.Select("exclusive_use").First(&keep, "id = ?", keepID).Error; err != nil {
+		return apperrors.Internal("failed to get dumpster", err)
+	}
+	if !keep.ExclusiveUse {
+		return nil
+	}
+
+	var keptActive int64
+	if err := tx.Model(&model.DumpsterUsage{}).
+		Where("dumpster_id = ? AND status = ?", keepID, model.UsageStatusActive).
+		Count(&keptActive).Error; err != nil {
+		return apperrors.Internal("failed to check active usages", err)
+	}
+	if keptActive == 0 {
+		return nil
+	}
+	if duplicateActive > 0 {
+		return apperrors.AlreadyExists("both dumpsters have an active usage and the kept dumpster is exclusive-use")
+	}
+
+	var duplicateBooked int64
+	if err := tx.Model(&model.Booking{}).
+		Where("dumpster_id = ? AND status IN ? AND start_date <= ? AND end_date > ?", duplicateID, open, now, now).
+		Count(&duplicateBooked).Error; err != nil {
+		return apperrors.Internal("failed to check current bookings", err)
+	}
+	if duplicateBooked > 0 {
+		return apperrors.AlreadyExists("the duplicate is booked while the exclusive-use kept dumpster is in use")
 	}
 
 	return nil
 }
 
+// Merge folds the duplicate listing into the kept one and soft-deletes the
+// duplicate, in one transaction. It is refused with AlreadyExists when the
+// duplicate's bookings or active usages would clash with the kept listing's.
+func (r *dumpsterRepository) Merge(ctx context.Context, keepID, duplicateID uuid.UUID) (*dto.DumpsterMergeResult, error) {
+	result := &dto.DumpsterMergeResult{}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var locked []uuid.UUID
+		if err := tx.Model(&model.Dumpster{}).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ?", []uuid.UUID{keepID, duplicateID}).
+			Pluck("id", &locked).Error; err != nil {
+			return apperrors.Internal("failed to lock dumpsters", err)
+		}
+		if len(locked) != 2 {
+			return apperrors.NotFound("dumpster not found")
+		}
+
+		if err := checkMergeConflicts(tx, keepID, duplicateID); err != nil {
+			return err
+		}
+
+		reviewed := tx.Unscoped().Model(&model.Review{}).Select("user_id").Where("dumpster_id = ?", keepID)
+		reviews := tx.Unscoped().Model(&model.Review{}).
+			Where("dumpster_id = ? AND user_id NOT IN (?)", duplicateID, reviewed).
+			Update("dumpster_id", keepID)
+		if reviews.Error != nil {
+			return apperrors.Internal("failed to move reviews", reviews.Error)
+		}
+		result.ReviewsMoved = reviews.RowsAffected
+
+		if err := tx.Model(&model.Review{}).
+			Where("dumpster_id = ?", duplicateID).
+			Count(&result.ReviewsSkipped).Error; err != nil {
+			return apperrors.Internal("failed to count skipped reviews", err)
+		}
+
+		usages := tx.Unscoped().Model(&model.DumpsterUsage{}).
+			Where("dumpster_id = ?", duplicateID).
+			Update("dumpster_id", keepID)
+		if usages.Error != nil {
+			return apperrors.Internal("failed to move usages", usages.Error)
+		}
+		result.UsagesMoved = usages.RowsAffected
+
+		bookings := tx.Unscoped().Model(&model.Booking{}).
+			Where("dumpster_id = ?", duplicateID).
+			Update("dumpster_id", keepID)
+		if bookings.Error != nil {
+			return apperrors.Internal("failed to move bookings", bookings.Error)
+		}
+		result.BookingsMoved = bookings.RowsAffected
+
+		if err := tx.Exec(`UPDATE dumpsters SET
+			rating = (SELECT COALESCE(AVG(rating), 0) FROM reviews WHERE dumpster_id = @id AND deleted_at IS NULL),
+			review_count = (SELECT COUNT(*) FROM reviews WHERE dumpster_id = @id AND deleted_at IS NULL),
+			updated_at = NOW()
+			WHERE id = @id`, sql.Named("id", keepID)).Error; err != nil {
+			return apperrors.Internal("failed to update dumpster rating", err)
+		}
+
+		if err := deleteScope(tx, r.cfg.HardDelete).Delete(&model.Dumpster{}, duplicateID).Error; err != nil {
+			return apperrors.Internal("failed to delete duplicate dumpster", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *dumpsterRepository) ListByOwner(ctx context.Context, ownerID uuid.UUID, opts ...QueryOption) ([]*model.Dumpster, error) {
+	var dumpsters []*model.Dumpster
+
+	query := applyPreloads(r.db.WithContext(ctx), dumpsterDefaultPreloads, opts)
+	result := query.Where("owner_id = ?", ownerID).Order("created_at ASC").Find(&dumpsters)
+	if result.Error != nil {
+		return nil, apperrors.Internal("failed to list owner dumpsters", result.Error)
+	}
+	return dumpsters, nil
+}
+
 func (r *dumpsterRepository) List(
 	ctx context.Context,
-	req dto.DumpsterListRequest) ([]*model.Dumpster, int64, error) {
+	req dto.DumpsterListRequest,
+	opts ...QueryOption) ([]*model.Dumpster, int64, error) {
 	var dumpsters []*model.Dumpster
 	var total int64
 
-	query := r.db.WithContext(ctx).Model(&model.Dumpster{}).Preload("Owner")
+	query := applyPreloads(r.read(ctx).Model(&model.Dumpster{}), dumpsterDefaultPreloads, opts).
+		Where(publishedCondition)
 
 	if req.MaxPrice != nil {
 		query = query.Where("price_per_day <= ?", *req.MaxPrice)
@@ -100,8 +525,17 @@ func (r *dumpsterRepository) List(
 		query = query.Where("size = ?", req.Size)
 	}
 
+	if tags := model.NormalizeTags(strings.Split(req.Tags, ",")); len(tags) > 0 {
+		query = query.Where(hasAllTagsCondition, tags, len(tags))
+	}
+
+	query = applyCreatedRange(query, req.CreatedFrom, req.CreatedTo)
+
 	if req.AvailableNow != nil && *req.AvailableNow {
-		query = query.Where("is_available = ?", true)
+		query = query.Where("is_available = ?", true).Where(notSnoozedCondition)
+		if r.cfg.AvailabilityTracksUsage {
+			query = query.Where(notInUseCondition, model.UsageStatusActive)
+		}
 	}
 
 	if err := query.Count(&total).Error; err != nil {
@@ -115,18 +549,11 @@ func (r *dumpsterRepository) List(
 	}
 
 	offset := (page - 1) * limit
-
-	sortBy := "created_at DESC"
-	switch req.SortBy {
-	case "price":
-		sortBy = "price_per_day ASC"
-	case "rating":
-		sortBy = "rating DESC"
-	case "availability":
-		sortBy = "is_available DESC, created_at DESC"
+	if err := checkPageDepth(offset, r.cfg.MaxOffset); err != nil {
+		return nil, 0, err
 	}
 
-	query = query.Order(sortBy).Limit(limit).Offset(offset)
+	query = query.Order(r.sortOrder(req.SortBy)).Limit(limit).Offset(offset)
 
 	if err := query.Find(&dumpsters).Error; err != nil {
 		return nil, 0, apperrors.Internal("failed to list dumpsters", err)
@@ -137,11 +564,13 @@ func (r *dumpsterRepository) List(
 
 func (r *dumpsterRepository) Search(
 	ctx context.Context,
-	req dto.DumpsterSearchRequest) ([]*model.Dumpster, int64, error) {
+	req dto.DumpsterSearchRequest,
+	opts ...QueryOption) ([]*model.Dumpster, int64, error) {
 	var dumpsters []*model.Dumpster
 	var total int64
 
-	query := r.db.WithContext(ctx).Model(&model.Dumpster{}).Preload("Owner")
+	query := applyPreloads(r.read(ctx).Model(&model.Dumpster{}), dumpsterDefaultPreloads, opts).
+		Where(publishedCondition)
 
 	if req.Query != "" {
 		searchPattern := "%" + req.Query + "%"
@@ -152,12 +581,8 @@ func (r *dumpsterRepository) Search(
 		query = query.Where("city ILIKE ?", "%"+req.City+"%")
 	}
 
-	if req.State != "" {
-		query = query.Where("state = ?", req.State)
-	}
-
-	if req.ZipCode != "" {
-		query = query.Where("zip_code = ?", req.ZipCode)
+	for _, cond := range locationConditions(req.State, req.ZipCode) {
+		query = query.Where(cond.sql, cond.args...)
 	}
 
 	if req.MinPrice != nil {
@@ -172,8 +597,20 @@ func (r *dumpsterRepository) Search(
 		query = query.Where("size = ?", req.Size)
 	}
 
+	if tags := model.NormalizeTags(strings.Split(req.Tags, ",")); len(tags) > 0 {
+		query = query.Where(hasAllTagsCondition, tags, len(tags))
+	}
+
+	query = applyCreatedRange(query, req.CreatedFrom, req.CreatedTo)
+
 	if req.IsAvailable != nil {
 		query = query.Where("is_available = ?", *req.IsAvailable)
+		if *req.IsAvailable {
+			query = query.Where(notSnoozedCondition)
+			if r.cfg.AvailabilityTracksUsage {
+				query = query.Where(notInUseCondition, model.UsageStatusActive)
+			}
+		}
 	}
 
 	if err := query.Count(&total).Error; err != nil {
@@ -187,17 +624,106 @@ func (r *dumpsterRepository) Search(
 	}
 
 	offset := (page - 1) * limit
+	if err := checkPageDepth(offset, r.cfg.MaxOffset); err != nil {
+		return nil, 0, err
+	}
 
-	if err := query.Order("created_at DESC").Limit(limit).Offset(offset).Find(&dumpsters).Error; err != nil {
+	if err := query.Order(r.sortOrder(req.SortBy)).Limit(limit).Offset(offset).Find(&dumpsters).Error; err != nil {
 		return nil, 0, apperrors.Internal("failed to search dumpsters", err)
 	}
 
 	return dumpsters, total, nil
 }
 
+// ListUnreviewed pages through listings with no reviews yet, oldest first,
+// so the ones that have waited longest come up first. Unpublished listings
+// are included.
+func (r *dumpsterRepository) ListUnreviewed(
+	ctx context.Context,
+	ownerID *uuid.UUID,
+	req dto.UnreviewedDumpsterListRequest,
+	opts ...QueryOption) ([]*model.Dumpster, int64, error) {
+	var dumpsters []*model.Dumpster
+	var total int64
+
+	query := applyPreloads(r.db.WithContext(ctx).Model(&model.Dumpster{}), dumpsterDefaultPreloads, opts).
+		Where("review_count = 0")
+
+	if ownerID != nil {
+		query = query.Where("owner_id = ?", *ownerID)
+	}
+
+	query = applyCreatedRange(query, req.CreatedFrom, req.CreatedTo)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, apperrors.Internal("failed to count unreviewed dumpsters", err)
+	}
+
+	page := max(req.Page, 1)
+	limit := max(req.Limit, defaultPageSize)
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	offset := (page - 1) * limit
+	if err := checkPageDepth(offset, r.cfg.MaxOffset); err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.Order("created_at ASC, id ASC").Limit(limit).Offset(offset).Find(&dumpsters).Error; err != nil {
+		return nil, 0, apperrors.Internal("failed to list unreviewed dumpsters", err)
+	}
+
+	return dumpsters, total, nil
+}
+
+type condition struct {
+	sql  string
+	args []any
+}
+
+func locationConditions(state, zipCode string) []condition {
+	var conds []condition
+
+	if state = strings.ToUpper(strings.TrimSpace(state)); state != "" {
+		conds = append(conds, condition{sql: "UPPER(state) = ?", args: []any{state}})
+	}
+
+	if zipCode = strings.TrimSpace(zipCode); zipCode != "" {
+		if len(zipCode) < fullZipCodeLength {
+			conds = append(conds, condition{sql: "LEFT(zip_code, ?) = ?", args: []any{len(zipCode), zipCode}})
+		} else {
+			conds = append(conds, condition{sql: "zip_code = ?", args: []any{zipCode}})
+		}
+	}
+
+	return conds
+}
+
+func applyCreatedRange(query *gorm.DB, from, to *time.Time) *gorm.DB {
+	if from != nil {
+		query = query.Where("dumpsters.created_at >= ?", *from)
+	}
+	if to != nil {
+		query = query.Where("dumpsters.created_at < ?", *to)
+	}
+	return query
+}
+
+func (r *dumpsterRepository) sortOrder(sortBy string) string {
+	if order, ok := dumpsterSortOrders[sortBy]; ok {
+		return order
+	}
+	if order, ok := dumpsterSortOrders[r.cfg.DefaultSort]; ok {
+		return order
+	}
+	return dumpsterSortOrders["newest"]
+}
+
 func (r *dumpsterRepository) FindNearby(
 	ctx context.Context,
-	req dto.NearbyDumpstersRequest) ([]*model.Dumpster, error) {
+	req dto.NearbyDumpstersRequest,
+	opts ...QueryOption) ([]*model.Dumpster, error) {
 	var dumpsters []*model.Dumpster
 
 	maxDistance := defaultNearbyDistance
@@ -207,31 +733,479 @@ func (r *dumpsterRepository) FindNearby(
 
 	limit := max(req.Limit, defaultPageSize)
 
+	conditions := []string{"distance < ?"}
+	args := []any{maxDistance}
+
+	if req.MaxPrice != nil {
+		conditions = append(conditions, "price_per_day <= ?")
+		args = append(args, *req.MaxPrice)
+	}
+
+	if req.Size != "" {
+		conditions = append(conditions, "size = ?")
+		args = append(args, req.Size)
+	}
+
+	if req.AvailableNow != nil && *req.AvailableNow {
+		conditions = append(conditions, "is_available = true", notSnoozedCondition)
+		if r.cfg.AvailabilityTracksUsage {
+			conditions = append(conditions, notInUseCondition)
+			args = append(args, model.UsageStatusActive)
+		}
+	}
+
 	query := fmt.Sprintf(`
 		SELECT * FROM (
-			SELECT *,
-			(%f * acos(cos(radians(%f)) * cos(radians(latitude)) *
-			cos(radians(longitude) - radians(%f)) +
-			sin(radians(%f)) * sin(radians(latitude)))) AS distance
+			SELECT *, %s AS distance
 			FROM dumpsters
-			WHERE deleted_at IS NULL
-		) AS dumpsters_with_distance
-		WHERE distance < %f
+			WHERE deleted_at IS NULL AND unpublished_at IS NULL
+		) AS dumpsters
+		WHERE %s
 		ORDER BY distance
 		LIMIT %d
-	`, earthRadiusKm,
-		req.Latitude,
-		req.Longitude,
-		req.Latitude,
-		maxDistance,
+	`, distanceExpression(req.Latitude, req.Longitude),
+		strings.Join(conditions, " AND "),
 		limit)
 
-	if err := r.db.WithContext(ctx).
-		Preload("Owner").
-		Raw(query).
-		Scan(&dumpsters).Error; err != nil {
+	if err := applyPreloads(r.read(ctx), dumpsterDefaultPreloads, opts).
+		Raw(query, args...).
+		Find(&dumpsters).Error; err != nil {
 		return nil, apperrors.Internal("failed to find nearby dumpsters", err)
 	}
 
 	return dumpsters, nil
 }
+
+func distanceExpression(lat, lng float64) string {
+	return fmt.Sprintf(`(%f * acos(cos(radians(%f)) * cos(radians(latitude)) *
+		cos(radians(longitude) - radians(%f)) +
+		sin(radians(%f)) * sin(radians(latitude))))`, earthRadiusKm, lat, lng, lat)
+}
+
+// GetNearbyPriceStats returns the 25th, 50th and 75th percentile daily price
+// of published listings of the given size within radiusKm of the point.
+func (r *dumpsterRepository) GetNearbyPriceStats(
+	ctx context.Context,
+	lat, lng, radiusKm float64,
+	size model.DumpsterSize) (*dto.PriceSuggestionStats, error) {
+	var stats dto.PriceSuggestionStats
+
+	query := fmt.Sprintf(`
+		SELECT
+			COUNT(*) AS count,
+			COALESCE(percentile_cont(0.25) WITHIN GROUP (ORDER BY price_per_day), 0) AS low,
+			COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY price_per_day), 0) AS median,
+			COALESCE(percentile_cont(0.75) WITHIN GROUP (ORDER BY price_per_day), 0) AS high
+		FROM (
+			SELECT price_per_day, %s AS distance
+			FROM dumpsters
+			WHERE deleted_at IS NULL AND unpublished_at IS NULL AND size = ?
+		) AS comparables
+		WHERE distance < ?
+	`, distanceExpression(lat, lng))
+
+	if err := r.read(ctx).Raw(query, size, radiusKm).Scan(&stats).Error; err != nil {
+		return nil, apperrors.Internal("failed to compute nearby price stats", err)
+	}
+
+	return &stats, nil
+}
+
+// CountPairedWith counts, for every other published listing, how many of the
+// sourceUsers most recent users of the given dumpster have also used or
+// booked it, most shared users first. Draft usages and cancelled bookings
+// don't count.
+func (r *dumpsterRepository) CountPairedWith(
+	ctx context.Context,
+	id uuid.UUID,
+	sourceUsers, limit int) ([]dto.PairedDumpsterCount, error) {
+	var counts []dto.PairedDumpsterCount
+
+	query := fmt.Sprintf(`
+		WITH source_users AS (
+			SELECT user_id FROM (
+				SELECT user_id, created_at FROM dumpster_usages
+				WHERE dumpster_id = ? AND deleted_at IS NULL AND status <> ?
+				UNION ALL
+				SELECT user_id, created_at FROM bookings
+				WHERE dumpster_id = ? AND deleted_at IS NULL AND status <> ?
+			) AS uses
+			GROUP BY user_id
+			ORDER BY MAX(created_at) DESC
+			LIMIT %d
+		), co_uses AS (
+			SELECT dumpster_id, user_id FROM dumpster_usages
+			WHERE user_id IN (SELECT user_id FROM source_users) AND deleted_at IS NULL AND status <> ?
+			UNION
+			SELECT dumpster_id, user_id FROM bookings
+			WHERE user_id IN (SELECT user_id FROM source_users) AND deleted_at IS NULL AND status <> ?
+		)
+		SELECT co_uses.dumpster_id, COUNT(*) AS shared_users
+		FROM co_uses
+		JOIN dumpsters ON dumpsters.id = co_uses.dumpster_id
+		WHERE co_uses.dumpster_id <> ?
+			AND dumpsters.deleted_at IS NULL
+			AND %s
+			AND %s
+		GROUP BY co_uses.dumpster_id
+		ORDER BY shared_users DESC, co_uses.dumpster_id
+		LIMIT %d
+	`, sourceUsers, publishedCondition, activeOwnerCondition, limit)
+
+	draft, cancelled := model.UsageStatusDraft, model.BookingStatusCancelled
+	if err := r.read(ctx).Raw(query, id, draft, id, cancelled, draft, cancelled, id).Scan(&counts).Error; err != nil {
+		return nil, apperrors.Internal("failed to count paired dumpsters", err)
+	}
+
+	return counts, nil
+}
+
+func (r *dumpsterRepository) GetDensity(
+	ctx context.Context,
+	req dto.DumpsterDensityRequest) ([]dto.DensityCell, error) {
+	var cells []dto.DensityCell
+
+	query := r.read(ctx).
+		Model(&model.Dumpster{}).
+		Select(
+			"LEAST(width_bucket(latitude, ?, ?, ?), ?) AS row, LEAST(width_bucket(longitude, ?, ?, ?), ?) AS col, COUNT(*) AS count",
+			req.MinLat, req.MaxLat, req.GridSize, req.GridSize,
+			req.MinLng, req.MaxLng, req.GridSize, req.GridSize,
+		).
+		Where("latitude BETWEEN ? AND ?", req.MinLat, req.MaxLat).
+		Where("longitude BETWEEN ? AND ?", req.MinLng, req.MaxLng).
+		Where("is_available = ?", true).
+		Where(notSnoozedCondition).
+		Where(publishedCondition)
+
+	if r.cfg.AvailabilityTracksUsage {
+		query = query.Where(notInUseCondition, model.UsageStatusActive)
+	}
+
+	if err := query.Group("row, col").Order("row, col").Scan(&cells).Error; err != nil {
+		return nil, apperrors.Internal("failed to compute dumpster density", err)
+	}
+
+	return cells, nil
+}
+
+func (r *dumpsterRepository) CountByState(
+	ctx context.Context,
+	req dto.DumpsterRegionCountsRequest) ([]dto.StateCount, error) {
+	var counts []dto.StateCount
+
+	if err := r.regionQuery(ctx, req).
+		Select("state, COUNT(*) AS count").
+		Group("state").
+		Order("count DESC, state").
+		Scan(&counts).Error; err != nil {
+		return nil, apperrors.Internal("failed to count dumpsters by state", err)
+	}
+
+	return counts, nil
+}
+
+func (r *dumpsterRepository) CountByCity(
+	ctx context.Context,
+	req dto.DumpsterRegionCountsRequest,
+	limit int) ([]dto.CityCount, error) {
+	var counts []dto.CityCount
+
+	if err := r.regionQuery(ctx, req).
+		Select("city, state, COUNT(*) AS count").
+		Group("state, city").
+		Order("count DESC, state, city").
+		Limit(limit).
+		Scan(&counts).Error; err != nil {
+		return nil, apperrors.Internal("failed to count dumpsters by city", err)
+	}
+
+	return counts, nil
+}
+
+func (r *dumpsterRepository) regionQuery(ctx context.Context, req dto.DumpsterRegionCountsRequest) *gorm.DB {
+	query := r.read(ctx).Model(&model.Dumpster{})
+
+	if req.PublishedOnly {
+		query = query.Where(publishedCondition)
+	}
+
+	if req.AvailableOnly {
+		query = query.Where("is_available = ?", true).Where(notSnoozedCondition)
+		if r.cfg.AvailabilityTracksUsage {
+			query = query.Where(notInUseCondition, model.UsageStatusActive)
+		}
+	}
+
+	return query
+}
+
+// GetPriceStats aggregates price_per_day over every listing matching the
+// location filters. Aggregates are coalesced so an empty match yields zeros.
+func (r *dumpsterRepository) GetPriceStats(
+	ctx context.Context,
+	req dto.DumpsterPriceStatsRequest) (*dto.DumpsterPriceStatsResponse, error) {
+	var stats dto.DumpsterPriceStatsResponse
+
+	query := r.read(ctx).
+		Model(&model.Dumpster{}).
+		Select(
+			"COUNT(*) AS count, " +
+				"COALESCE(MIN(price_per_day), 0) AS min_price, " +
+				"COALESCE(MAX(price_per_day), 0) AS max_price, " +
+				"COALESCE(AVG(price_per_day), 0) AS avg_price, " +
+				"COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY price_per_day), 0) AS median",
+		).
+		Where(publishedCondition)
+
+	if city := strings.TrimSpace(req.City); city != "" {
+		query = query.Where("city ILIKE ?", city)
+	}
+
+	for _, cond := range locationConditions(req.State, req.ZipCode) {
+		query = query.Where(cond.sql, cond.args...)
+	}
+
+	if err := query.Scan(&stats).Error; err != nil {
+		return nil, apperrors.Internal("failed to compute dumpster price stats", err)
+	}
+
+	return &stats, nil
+}
+
+func (r *dumpsterRepository) CountByOwner(ctx context.Context, ownerID uuid.UUID) (int64, error) {
+	var count int64
+	result := r.db.WithContext(ctx).
+		Model(&model.Dumpster{}).
+		Where("owner_id = ?", ownerID).
+		Count(&count)
+	if result.Error != nil {
+		return 0, apperrors.Internal("failed to count dumpsters by owner", result.Error)
+	}
+
+	return count, nil
+}
+
+// GetOwnerListingSummary returns the owner's listing count and the average
+// rating across those listings, weighted by each listing's review count.
+func (r *dumpsterRepository) GetOwnerListingSummary(ctx context.Context, ownerID uuid.UUID) (int64, float64, error) {
+	var summary struct {
+		Count     int64
+		AvgRating float64
+	}
+
+	result := r.db.WithContext(ctx).
+		Model(&model.Dumpster{}).
+		Select(
+			"COUNT(*) AS count, "+
+				"COALESCE(SUM(rating * review_count) / NULLIF(SUM(review_count), 0), 0) AS avg_rating",
+		).
+		Where("owner_id = ?", ownerID).
+		Scan(&summary)
+	if result.Error != nil {
+		return 0, 0, apperrors.Internal("failed to summarize owner listings", result.Error)
+	}
+
+	return summary.Count, summary.AvgRating, nil
+}
+
+func (r *dumpsterRepository) ReplaceTags(ctx context.Context, dumpsterID uuid.UUID, tags []string) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return replaceDumpsterTags(tx, dumpsterID, tags)
+	})
+	if err != nil {
+		return apperrors.Internal("failed to replace dumpster tags", err)
+	}
+
+	return nil
+}
+
+func replaceDumpsterTags(tx *gorm.DB, dumpsterID uuid.UUID, tags []string) error {
+	if err := tx.Where("dumpster_id = ?", dumpsterID).Delete(&model.DumpsterTag{}).Error; err != nil {
+		return err
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	dumpsterTags := make([]model.DumpsterTag, len(tags))
+	for i, tag := range tags {
+		dumpsterTags[i] = model.DumpsterTag{DumpsterID: dumpsterID, Tag: tag}
+	}
+	return tx.Create(&dumpsterTags).Error
+}
+
+// ApplyRevision saves the dumpster's editable fields and tags and records
+// revision in one transaction. The write only goes through if the row is
+// still at loadedAt; if someone else saved it in between, nothing changes
+// and AlreadyExists is returned so the caller can reload and retry.
+func (r *dumpsterRepository) ApplyRevision(
+	ctx context.Context,
+	dumpster *model.Dumpster,
+	loadedAt time.Time,
+	revision *model.DumpsterRevision) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		dumpster.UpdatedAt = time.Now()
+		result := tx.Model(&model.Dumpster{}).
+			Where("id = ? AND updated_at = ?", dumpster.ID, loadedAt).
+			Updates(map[string]any{
+				"title":         dumpster.Title,
+				"description":   dumpster.Description,
+				"location":      dumpster.Location,
+				"latitude":      dumpster.Latitude,
+				"longitude":     dumpster.Longitude,
+				"address":       dumpster.Address,
+				"city":          dumpster.City,
+				"state":         dumpster.State,
+				"zip_code":      dumpster.ZipCode,
+				"price_per_day": dumpster.PricePerDay,
+				"size":          dumpster.Size,
+				"capacity":      dumpster.Capacity,
+				"weight":        dumpster.Weight,
+				"is_available":  dumpster.IsAvailable,
+				"auto_release":  dumpster.AutoRelease,
+				"exclusive_use": dumpster.ExclusiveUse,
+				"updated_at":    dumpster.UpdatedAt,
+			})
+		if result.Error != nil {
+			return apperrors.Internal("failed to update dumpster", result.Error)
+		}
+
+		if result.RowsAffected == 0 {
+			return apperrors.AlreadyExists("dumpster was modified by another request; reload and try again")
+		}
+
+		if err := replaceDumpsterTags(tx, dumpster.ID, dumpster.TagNames()); err != nil {
+			return apperrors.Internal("failed to replace dumpster tags", err)
+		}
+
+		if err := createDumpsterRevision(tx, revision); err != nil {
+			return apperrors.Internal("failed to record dumpster revision", err)
+		}
+
+		return nil
+	})
+}
+
+// SetUnpublished hides or republishes the listing. It reports whether the
+// state actually changed, so concurrent callers act on a transition once.
+func (r *dumpsterRepository) SetUnpublished(ctx context.Context, id uuid.UUID, unpublished bool) (bool, error) {
+	query := r.db.WithContext(ctx).Model(&model.Dumpster{}).Where("id = ?", id)
+
+	var result *gorm.DB
+	if unpublished {
+		result = query.Where("unpublished_at IS NULL").Update("unpublished_at", time.Now())
+	} else {
+		result = query.Where("unpublished_at IS NOT NULL").Update("unpublished_at", nil)
+	}
+	if result.Error != nil {
+		return false, apperrors.Internal("failed to update dumpster publication", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// EnsureShareSecret stores candidate as the dumpster's share secret unless it
+// already has one, and returns whichever secret is now in effect.
+func (r *dumpsterRepository) EnsureShareSecret(ctx context.Context, id uuid.UUID, candidate string) (string, error) {
+	if err := r.db.WithContext(ctx).
+		Model(&model.Dumpster{}).
+		Where("id = ? AND share_secret IS NULL", id).
+		UpdateColumn("share_secret", candidate).Error; err != nil {
+		return "", apperrors.Internal("failed to set share secret", err)
+	}
+
+	var dumpster model.Dumpster
+	result := r.db.WithContext(ctx).Select("id", "share_secret").Where("id = ?", id).First(&dumpster)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return "", apperrors.NotFound("dumpster not found")
+		}
+		return "", apperrors.Internal("failed to get share secret", result.Error)
+	}
+	if dumpster.ShareSecret == nil {
+		return "", apperrors.Internal("failed to get share secret", errors.New("share secret missing after update"))
+	}
+
+	return *dumpster.ShareSecret, nil
+}
+
+func (r *dumpsterRepository) SetShareSecret(ctx context.Context, id uuid.UUID, secret string) error {
+	result := r.db.WithContext(ctx).
+		Model(&model.Dumpster{}).
+		Where("id = ?", id).
+		UpdateColumn("share_secret", secret)
+	if result.Error != nil {
+		return apperrors.Internal("failed to rotate share secret", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return apperrors.NotFound("dumpster not found")
+	}
+
+	return nil
+}
+
+func (r *dumpsterRepository) SetFeatured(ctx context.Context, id uuid.UUID, featured bool) error {
+	result := r.db.WithContext(ctx).
+		Model(&model.Dumpster{}).
+		Where("id = ?", id).
+		Update("is_featured", featured)
+	if result.Error != nil {
+		return apperrors.Internal("failed to update featured flag", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return apperrors.NotFound("dumpster not found")
+	}
+
+	return nil
+}
+
+// SearchText returns up to limit published listings of active owners whose
+// title, description or location contains query, best rated first.
+func (r *dumpsterRepository) SearchText(
+	ctx context.Context,
+	query string,
+	limit int,
+	opts ...QueryOption) ([]*model.Dumpster, error) {
+	var dumpsters []*model.Dumpster
+
+	pattern := "%" + query + "%"
+	result := applyPreloads(r.read(ctx), dumpsterDefaultPreloads, opts).
+		Where(publishedCondition).
+		Where(activeOwnerCondition).
+		Where("title ILIKE ? OR description ILIKE ? OR location ILIKE ?", pattern, pattern, pattern).
+		Order("rating DESC, created_at DESC, id DESC").
+		Limit(limit).
+		Find(&dumpsters)
+	if result.Error != nil {
+		return nil, apperrors.Internal("failed to search dumpsters", result.Error)
+	}
+
+	return dumpsters, nil
+}
+
+// ListFeatured returns up to limit featured listings that are published,
+// bookable right now and owned by an active account, best rated first.
+func (r *dumpsterRepository) ListFeatured(ctx context.Context, limit int, opts ...QueryOption) ([]*model.Dumpster, error) {
+	var dumpsters []*model.Dumpster
+
+	query := applyPreloads(r.db.WithContext(ctx), dumpsterDefaultPreloads, opts).
+		Where("is_featured = ?", true).
+		Where("is_available = ?", true).
+		Where(notSnoozedCondition).
+		Where(publishedCondition).
+		Where(activeOwnerCondition)
+
+	if r.cfg.AvailabilityTracksUsage {
+		query = query.Where(notInUseCondition, model.UsageStatusActive)
+	}
+
+	if err := query.Order("rating DESC, review_count DESC, created_at DESC").Limit(limit).Find(&dumpsters).Error; err != nil {
+		return nil, apperrors.Internal("failed to list featured dumpsters", err)
+	}
+
+	return dumpsters, nil
+}
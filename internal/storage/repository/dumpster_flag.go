@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"waste-space/internal/dto"
+	"waste-space/internal/model"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type DumpsterFlagRepository interface {
+	Create(ctx context.Context, flag *model.DumpsterFlag) error
+	CountOpen(ctx context.Context, dumpsterID uuid.UUID) (int64, error)
+	CountByStatus(ctx context.Context, dumpsterID uuid.UUID, statuses ...model.DumpsterFlagStatus) (int64, error)
+	CountOpenByOwner(ctx context.Context, ownerID uuid.UUID) (map[uuid.UUID]int64, error)
+	List(ctx context.Context, dumpsterID *uuid.UUID, req dto.DumpsterFlagListRequest) ([]*model.DumpsterFlag, int64, error)
+	ResolveOpen(ctx context.Context, dumpsterID, resolvedBy uuid.UUID, status model.DumpsterFlagStatus) (int64, error)
+}
+
+type dumpsterFlagRepository struct {
+	db *gorm.DB
+}
+
+func NewDumpsterFlagRepository(db *gorm.DB) DumpsterFlagRepository {
+	return &dumpsterFlagRepository{db: db}
+}
+
+// Create records the flag. A reporter may hold only one open flag per
+// listing; a second one is rejected with AlreadyExists.
+func (r *dumpsterFlagRepository) Create(ctx context.Context, flag *model.DumpsterFlag) error {
+	result := r.db.WithContext(ctx).Create(flag)
+	if result.Error != nil {
+		if isUniqueViolation(result.Error, "uniq_dumpster_flags_open_reporter") {
+			return apperrors.AlreadyExists("you have already flagged this dumpster")
+		}
+		return apperrors.Internal("failed to create dumpster flag", result.Error)
+	}
+
+	return nil
+}
+
+func (r *dumpsterFlagRepository) CountOpen(ctx context.Context, dumpsterID uuid.UUID) (int64, error) {
+	var count int64
+	result := r.db.WithContext(ctx).
+		Model(&model.DumpsterFlag{}).
+		Where("dumpster_id = ? AND status = ?", dumpsterID, model.DumpsterFlagStatusOpen).
+		Count(&count)
+	if result.Error != nil {
+		return 0, apperrors.Internal("failed to count dumpster flags", result.Error)
+	}
+
+	return count, nil
+}
+
+func (r *dumpsterFlagRepository) CountByStatus(
+	ctx context.Context,
+	dumpsterID uuid.UUID,
+	statuses ...model.DumpsterFlagStatus) (int64, error) {
+	var count int64
+	result := r.db.WithContext(ctx).
+		Model(&model.DumpsterFlag{}).
+		Where("dumpster_id = ? AND status IN ?", dumpsterID, statuses).
+		Count(&count)
+	if result.Error != nil {
+		return 0, apperrors.Internal("failed to count dumpster flags", result.Error)
+	}
+
+	return count, nil
+}
+
+// CountOpenByOwner counts open flags per listing of the owner. Listings
+// without open flags are absent from the map.
+func (r *dumpsterFlagRepository) CountOpenByOwner(ctx context.Context, ownerID uuid.UUID) (map[uuid.UUID]int64, error) {
+	var rows []struct {
+		DumpsterID uuid.UUID
+		Count      int64
+	}
+	result := r.db.WithContext(ctx).
+		Model(&model.DumpsterFlag{}).
+		Select("dumpster_id, COUNT(*) AS count").
+		Where("status = ?", model.DumpsterFlagStatusOpen).
+		Where("dumpster_id IN (?)", r.db.Model(&model.Dumpster{}).Select("id").Where("owner_id = ?", ownerID)).
+		Group("dumpster_id").
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, apperrors.Internal("failed to count owner dumpster flags", result.Error)
+	}
+
+	counts := make(map[uuid.UUID]int64, len(rows))
+	for _, row := range rows {
+		counts[row.DumpsterID] = row.Count
+	}
+
+	return counts, nil
+}
+
+func (r *dumpsterFlagRepository) List(
+	ctx context.Context,
+	dumpsterID *uuid.UUID,
+	req dto.DumpsterFlagListRequest) ([]*model.DumpsterFlag, int64, error) {
+	var flags []*model.DumpsterFlag
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&model.DumpsterFlag{})
+
+	if dumpsterID != nil {
+		query = query.Where("dumpster_id = ?", *dumpsterID)
+	}
+
+	if req.Status != "" {
+		query = query.Where("status = ?", req.Status)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, apperrors.Internal("failed to count dumpster flags", err)
+	}
+
+	page := max(req.Page, 1)
+	limit := max(req.Limit, defaultPageSize)
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	offset := (page - 1) * limit
+
+	if err := query.Order("created_at DESC, id DESC").Limit(limit).Offset(offset).Find(&flags).Error; err != nil {
+		return nil, 0, apperrors.Internal("failed to list dumpster flags", err)
+	}
+
+	return flags, total, nil
+}
+
+// ResolveOpen closes every open flag on the dumpster with the given status
+// and returns how many were closed.
+func (r *dumpsterFlagRepository) ResolveOpen(
+	ctx context.Context,
+	dumpsterID, resolvedBy uuid.UUID,
+	status model.DumpsterFlagStatus) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.DumpsterFlag{}).
+		Where("dumpster_id = ? AND status = ?", dumpsterID, model.DumpsterFlagStatusOpen).
+		Updates(map[string]any{
+			"status":      status,
+			"resolved_by": resolvedBy,
+			"resolved_at": time.Now(),
+		})
+	if result.Error != nil {
+		return 0, apperrors.Internal("failed to resolve dumpster flags", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"waste-space/internal/model"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type DumpsterImageRepository interface {
+	CreateBatch(ctx context.Context, dumpsterID uuid.UUID, images []*model.DumpsterImage, maxImages int) error
+	CountByDumpster(ctx context.Context, dumpsterID uuid.UUID) (int64, error)
+	GetByDumpsterID(ctx context.Context, dumpsterID uuid.UUID) ([]model.DumpsterImage, error)
+	Delete(ctx context.Context, dumpsterID, id uuid.UUID) error
+}
+
+type dumpsterImageRepository struct {
+	db *gorm.DB
+}
+
+func NewDumpsterImageRepository(db *gorm.DB) DumpsterImageRepository {
+	return &dumpsterImageRepository{db: db}
+}
+
+// CreateBatch appends images after the dumpster's existing ones. The dumpster
+// row is locked so concurrent confirmations can't push it past maxImages.
+func (r *dumpsterImageRepository) CreateBatch(
+	ctx context.Context,
+	dumpsterID uuid.UUID,
+	images []*model.DumpsterImage,
+	maxImages int) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var dumpster model.Dumpster
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id").
+			Where("id = ?", dumpsterID).
+			First(&dumpster).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return apperrors.NotFound("dumpster not found")
+			}
+			return apperrors.Internal("failed to lock dumpster", err)
+		}
+
+		var existing int64
+		if err := tx.Model(&model.DumpsterImage{}).
+			Where("dumpster_id = ?", dumpsterID).
+			Count(&existing).Error; err != nil {
+			return apperrors.Internal("failed to count images", err)
+		}
+		if int(existing)+len(images) > maxImages {
+			return apperrors.BadRequest(fmt.Sprintf("a dumpster can have at most %d images", maxImages))
+		}
+
+		for i, image := range images {
+			image.DumpsterID = dumpsterID
+			image.Position = int(existing) + i
+		}
+
+		if err := tx.Create(images).Error; err != nil {
+			if isUniqueViolation(err, "uniq_dumpster_images_object_key") {
+				return apperrors.AlreadyExists("image already confirmed")
+			}
+			return apperrors.Internal("failed to create images", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *dumpsterImageRepository) CountByDumpster(ctx context.Context, dumpsterID uuid.UUID) (int64, error) {
+	var count int64
+	result := r.db.WithContext(ctx).
+		Model(&model.DumpsterImage{}).
+		Where("dumpster_id = ?", dumpsterID).
+		Count(&count)
+	if result.Error != nil {
+		return 0, apperrors.Internal("failed to count images", result.Error)
+	}
+	return count, nil
+}
+
+func (r *dumpsterImageRepository) GetByDumpsterID(ctx context.Context, dumpsterID uuid.UUID) ([]model.DumpsterImage, error) {
+	var images []model.DumpsterImage
+	result := r.db.WithContext(ctx).
+		Where("dumpster_id = ?", dumpsterID).
+		Order("position ASC").
+		Find(&images)
+	if result.Error != nil {
+		return nil, apperrors.Internal("failed to get images", result.Error)
+	}
+	return images, nil
+}
+
+func (r *dumpsterImageRepository) Delete(ctx context.Context, dumpsterID, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).
+		Where("id = ? AND dumpster_id = ?", id, dumpsterID).
+		Delete(&model.DumpsterImage{})
+	if result.Error != nil {
+		return apperrors.Internal("failed to delete image", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return apperrors.NotFound("image not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"waste-space/internal/dto"
+	"waste-space/internal/model"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type DumpsterRevisionRepository interface {
+	Create(ctx context.Context, revision *model.DumpsterRevision) error
+	List(ctx context.Context, dumpsterID uuid.UUID, req dto.DumpsterRevisionListRequest) ([]*model.DumpsterRevision, int64, error)
+	Get(ctx context.Context, dumpsterID uuid.UUID, revision int) (*model.DumpsterRevision, error)
+}
+
+type dumpsterRevisionRepository struct {
+	db *gorm.DB
+}
+
+func NewDumpsterRevisionRepository(db *gorm.DB) DumpsterRevisionRepository {
+	return &dumpsterRevisionRepository{db: db}
+}
+
+// Create numbers the revision after the dumpster's latest one. Concurrent
+// edits racing for the same number are caught by the unique index.
+func (r *dumpsterRevisionRepository) Create(ctx context.Context, revision *model.DumpsterRevision) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return createDumpsterRevision(tx, revision)
+	})
+	if err != nil {
+		return apperrors.Internal("failed to record dumpster revision", err)
+	}
+	return nil
+}
+
+func createDumpsterRevision(tx *gorm.DB, revision *model.DumpsterRevision) error {
+	var latest int
+	if err := tx.Model(&model.DumpsterRevision{}).
+		Select("COALESCE(MAX(revision), 0)").
+		Where("dumpster_id = ?", revision.DumpsterID).
+		Scan(&latest).Error; err != nil {
+		return err
+	}
+
+	revision.Revision = latest + 1
+	return tx.Create(revision).Error
+}
+
+func (r *dumpsterRevisionRepository) List(
+	ctx context.Context,
+	dumpsterID uuid.UUID,
+	req dto.DumpsterRevisionListRequest) ([]*model.DumpsterRevision, int64, error) {
+	var revisions []*model.DumpsterRevision
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&model.DumpsterRevision{}).Where("dumpster_id = ?", dumpsterID)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, apperrors.Internal("failed to count dumpster revisions", err)
+	}
+
+	page := max(req.Page, 1)
+	limit := max(req.Limit, defaultPageSize)
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	offset := (page - 1) * limit
+
+	if err := query.Order("revision DESC").Limit(limit).Offset(offset).Find(&revisions).Error; err != nil {
+		return nil, 0, apperrors.Internal("failed to list dumpster revisions", err)
+	}
+
+	return revisions, total, nil
+}
+
+func (r *dumpsterRevisionRepository) Get(ctx context.Context, dumpsterID uuid.UUID, revision int) (*model.DumpsterRevision, error) {
+	var rev model.DumpsterRevision
+	result := r.db.WithContext(ctx).
+		Where("dumpster_id = ? AND revision = ?", dumpsterID, revision).
+		First(&rev)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, apperrors.NotFound("revision not found")
+		}
+		return nil, apperrors.Internal("failed to get dumpster revision", result.Error)
+	}
+	return &rev, nil
+}
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+	"waste-space/internal/model"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func TestLocationConditions(t *testing.T) {
+	tests := []struct {
+		name    string
+		state   string
+		zipCode string
+		want    []condition
+	}{
+		{name: "no filters"},
+		{name: "blank filters", state: "  ", zipCode: " "},
+		{
+			name:  "mixed-case state",
+			state: " cA ",
+			want:  []condition{{sql: "UPPER(state) = ?", args: []any{"CA"}}},
+		},
+		{
+			name:    "partial zip",
+			zipCode: "902",
+			want:    []condition{{sql: "LEFT(zip_code, ?) = ?", args: []any{3, "902"}}},
+		},
+		{
+			name:    "full zip",
+			zipCode: " 90210 ",
+			want:    []condition{{sql: "zip_code = ?", args: []any{"90210"}}},
+		},
+		{
+			name:    "zip+4",
+			zipCode: "90210-1234",
+			want:    []condition{{sql: "zip_code = ?", args: []any{"90210-1234"}}},
+		},
+		{
+			name:    "state and partial zip",
+			state:   "ny",
+			zipCode: "1",
+			want: []condition{
+				{sql: "UPPER(state) = ?", args: []any{"NY"}},
+				{sql: "LEFT(zip_code, ?) = ?", args: []any{1, "1"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := locationConditions(tt.state, tt.zipCode)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("locationConditions(%q, %q) = %#v, want %#v", tt.state, tt.zipCode, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDumpsterDeleteRacesCreate(t *testing.T) {
+	tests := []struct {
+		name   string
+		create func(ctx context.Context, gdb *gorm.DB, dumpsterID, userID uuid.UUID) error
+	}{
+		{
+			name: "booking",
+			create: func(ctx context.Context, gdb *gorm.DB, dumpsterID, userID uuid.UUID) error {
+				start := time.Now().Add(24 * time.Hour)
+				return NewBookingRepository(gdb).Create(ctx, newTestBooking(dumpsterID, userID, start))
+			},
+		},
+		{
+			name: "usage start",
+			create: func(ctx context.Context, gdb *gorm.DB, dumpsterID, userID uuid.UUID) error {
+				return NewUsageRepository(gdb, UsageRepositoryConfig{}).Create(ctx, &model.DumpsterUsage{
+					DumpsterID: dumpsterID,
+					UserID:     userID,
+					StartTime:  time.Now(),
+					Status:     model.UsageStatusActive,
+				})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gdb := openTestDB(t)
+			dumpsters := NewDumpsterRepository(gdb, gdb, DumpsterRepositoryConfig{})
+			ctx := context.Background()
+
+			owner := createTestUser(t, gdb)
+			renter := createTestUser(t, gdb)
+
+			for range 20 {
+				dumpster := createTestDumpster(t, gdb, owner.ID)
+
+				var (
+					wg                   sync.WaitGroup
+					createErr, deleteErr error
+				)
+				wg.Add(2)
+				go func() {
+					defer wg.Done()
+					createErr = tt.create(ctx, gdb, dumpster.ID, renter.ID)
+				}()
+				go func() {
+					defer wg.Done()
+					deleteErr = dumpsters.Delete(ctx, dumpster.ID)
+				}()
+				wg.Wait()
+
+				switch {
+				case createErr == nil && deleteErr == nil:
+					t.Fatal("both the create and the delete succeeded")
+				case createErr == nil:
+					if !apperrors.Is(deleteErr, apperrors.ErrorTypeAlreadyExists) {
+						t.Fatalf("losing delete returned %v, want AlreadyExists", deleteErr)
+					}
+				case deleteErr == nil:
+					if !apperrors.Is(createErr, apperrors.ErrorTypeNotFound) {
+						t.Fatalf("losing create returned %v, want NotFound", createErr)
+					}
+				default:
+					t.Fatalf("both sides failed: create %v, delete %v", createErr, deleteErr)
+				}
+			}
+		})
+	}
+}
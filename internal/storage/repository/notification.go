@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"waste-space/internal/dto"
+	"waste-space/internal/model"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type NotificationRepository interface {
+	Create(ctx context.Context, notification *model.Notification) error
+	GetByUserID(ctx context.Context, userID uuid.UUID, req dto.NotificationListRequest) ([]*model.Notification, int64, error)
+	MarkAsRead(ctx context.Context, userID, id uuid.UUID, readAt time.Time) error
+	MarkManyAsRead(ctx context.Context, userID uuid.UUID, ids []uuid.UUID, readAt time.Time) (int64, error)
+	MarkAllAsRead(ctx context.Context, userID uuid.UUID, readAt time.Time) (int64, error)
+	CountUnread(ctx context.Context, userID uuid.UUID) (int64, error)
+}
+
+type notificationRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) NotificationRepository {
+	return &notificationRepository{db: db}
+}
+
+func (r *notificationRepository) Create(ctx context.Context, notification *model.Notification) error {
+	result := r.db.WithContext(ctx).Create(notification)
+	if result.Error != nil {
+		return apperrors.Internal("failed to create notification", result.Error)
+	}
+	return nil
+}
+
+func (r *notificationRepository) GetByUserID(
+	ctx context.Context,
+	userID uuid.UUID,
+	req dto.NotificationListRequest) ([]*model.Notification, int64, error) {
+	var notifications []*model.Notification
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&model.Notification{}).Where("user_id = ?", userID)
+
+	if req.UnreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, apperrors.Internal("failed to count notifications", err)
+	}
+
+	page := max(req.Page, 1)
+	limit := max(req.Limit, defaultPageSize)
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	offset := (page - 1) * limit
+
+	if err := query.Order("created_at DESC, id DESC").Limit(limit).Offset(offset).Find(&notifications).Error; err != nil {
+		return nil, 0, apperrors.Internal("failed to get notifications", err)
+	}
+
+	return notifications, total, nil
+}
+
+func (r *notificationRepository) MarkAsRead(ctx context.Context, userID, id uuid.UUID, readAt time.Time) error {
+	result := r.db.WithContext(ctx).
+		Model(&model.Notification{}).
+		Where("id = ? AND user_id = ?", id, userID).
+		Where("read_at IS NULL").
+		Update("read_at", readAt)
+	if result.Error != nil {
+		return apperrors.Internal("failed to mark notification as read", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		var count int64
+		if err := r.db.WithContext(ctx).Model(&model.Notification{}).Where("id = ? AND user_id = ?", id, userID).Count(&count).Error; err != nil {
+			return apperrors.Internal("failed to get notification", err)
+		}
+		if count == 0 {
+			return apperrors.NotFound("notification not found")
+		}
+	}
+
+	return nil
+}
+
+// MarkManyAsRead marks the user's unread notifications among ids as read and
+// returns how many changed. Unknown, foreign and already-read IDs are skipped.
+func (r *notificationRepository) MarkManyAsRead(
+	ctx context.Context,
+	userID uuid.UUID,
+	ids []uuid.UUID,
+	readAt time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.Notification{}).
+		Where("id IN ? AND user_id = ?", ids, userID).
+		Where("read_at IS NULL").
+		Update("read_at", readAt)
+	if result.Error != nil {
+		return 0, apperrors.Internal("failed to mark notifications as read", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+func (r *notificationRepository) MarkAllAsRead(ctx context.Context, userID uuid.UUID, readAt time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.Notification{}).
+		Where("user_id = ?", userID).
+		Where("read_at IS NULL").
+		Update("read_at", readAt)
+	if result.Error != nil {
+		return 0, apperrors.Internal("failed to mark notifications as read", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+func (r *notificationRepository) CountUnread(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var count int64
+	result := r.db.WithContext(ctx).
+		Model(&model.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Count(&count)
+	if result.Error != nil {
+		return 0, apperrors.Internal("failed to count unread notifications", result.Error)
+	}
+	return count, nil
+}
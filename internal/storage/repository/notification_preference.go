@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"waste-space/internal/model"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type NotificationPreferenceRepository interface {
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*model.NotificationPreference, error)
+	Get(ctx context.Context, userID uuid.UUID, notificationType model.NotificationType) (*model.NotificationPreference, error)
+	Upsert(ctx context.Context, preferences []*model.NotificationPreference) error
+}
+
+type notificationPreferenceRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationPreferenceRepository(db *gorm.DB) NotificationPreferenceRepository {
+	return &notificationPreferenceRepository{db: db}
+}
+
+func (r *notificationPreferenceRepository) GetByUserID(
+	ctx context.Context,
+	userID uuid.UUID) ([]*model.NotificationPreference, error) {
+	var preferences []*model.NotificationPreference
+	result := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Find(&preferences)
+	if result.Error != nil {
+		return nil, apperrors.Internal("failed to get notification preferences", result.Error)
+	}
+	return preferences, nil
+}
+
+func (r *notificationPreferenceRepository) Get(
+	ctx context.Context,
+	userID uuid.UUID,
+	notificationType model.NotificationType) (*model.NotificationPreference, error) {
+	var preference model.NotificationPreference
+	result := r.db.WithContext(ctx).
+		Where("user_id = ? AND type = ?", userID, notificationType).
+		First(&preference)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, apperrors.NotFound("notification preference not found")
+		}
+		return nil, apperrors.Internal("failed to get notification preference", result.Error)
+	}
+	return &preference, nil
+}
+
+func (r *notificationPreferenceRepository) Upsert(
+	ctx context.Context,
+	preferences []*model.NotificationPreference) error {
+	if len(preferences) == 0 {
+		return nil
+	}
+
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "type"}},
+		DoUpdates: clause.AssignmentColumns([]string{"in_app", "email", "updated_at"}),
+	}).Create(&preferences)
+	if result.Error != nil {
+		return apperrors.Internal("failed to save notification preferences", result.Error)
+	}
+	return nil
+}
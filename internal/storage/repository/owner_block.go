@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"waste-space/internal/model"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type OwnerBlockRepository interface {
+	Create(ctx context.Context, block *model.OwnerBlock) error
+	Delete(ctx context.Context, ownerID, blockedUserID uuid.UUID) error
+	ListByOwner(ctx context.Context, ownerID uuid.UUID) ([]*model.OwnerBlock, error)
+	IsBlocked(ctx context.Context, ownerID, userID uuid.UUID) (bool, error)
+}
+
+type ownerBlockRepository struct {
+	db *gorm.DB
+}
+
+func NewOwnerBlockRepository(db *gorm.DB) OwnerBlockRepository {
+	return &ownerBlockRepository{db: db}
+}
+
+// Create blocks the user. Blocking someone already blocked is a no-op.
+func (r *ownerBlockRepository) Create(ctx context.Context, block *model.OwnerBlock) error {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "owner_id"}, {Name: "blocked_user_id"}},
+		DoNothing: true,
+	}).Create(block)
+	if result.Error != nil {
+		return apperrors.Internal("failed to block user", result.Error)
+	}
+	return nil
+}
+
+func (r *ownerBlockRepository) Delete(ctx context.Context, ownerID, blockedUserID uuid.UUID) error {
+	result := r.db.WithContext(ctx).
+		Where("owner_id = ? AND blocked_user_id = ?", ownerID, blockedUserID).
+		Delete(&model.OwnerBlock{})
+	if result.Error != nil {
+		return apperrors.Internal("failed to unblock user", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return apperrors.NotFound("block not found")
+	}
+
+	return nil
+}
+
+func (r *ownerBlockRepository) ListByOwner(ctx context.Context, ownerID uuid.UUID) ([]*model.OwnerBlock, error) {
+	var blocks []*model.OwnerBlock
+	result := r.db.WithContext(ctx).
+		Where("owner_id = ?", ownerID).
+		Order("created_at DESC").
+		Find(&blocks)
+	if result.Error != nil {
+		return nil, apperrors.Internal("failed to list blocked users", result.Error)
+	}
+	return blocks, nil
+}
+
+func (r *ownerBlockRepository) IsBlocked(ctx context.Context, ownerID, userID uuid.UUID) (bool, error) {
+	var count int64
+	result := r.db.WithContext(ctx).
+		Model(&model.OwnerBlock{}).
+		Where("owner_id = ? AND blocked_user_id = ?", ownerID, userID).
+		Count(&count)
+	if result.Error != nil {
+		return false, apperrors.Internal("failed to check user block", result.Error)
+	}
+	return count > 0, nil
+}
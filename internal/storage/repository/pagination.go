@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Keyset is a position in a newest-first listing ordered by created_at and
+// then id. A nil ID selects every row created strictly before CreatedAt.
+type Keyset struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+func beforeKeyset(query *gorm.DB, k Keyset) *gorm.DB {
+	return query.Where("(created_at, id) < (?, ?)", k.CreatedAt, k.ID)
+}
+
+func checkPageDepth(offset, maxOffset int) error {
+	if maxOffset <= 0 || offset <= maxOffset {
+		return nil
+	}
+	return apperrors.BadRequest(fmt.Sprintf(
+		"page is too deep: only the first %d results can be paged through; narrow the filters or page by a cursor such as a date range instead",
+		maxOffset))
+}
@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"context"
+	"database/sql/driver"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+	"waste-space/internal/dto"
+	"waste-space/internal/model"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	orderByClause = regexp.MustCompile(`ORDER BY (.+?)(?: LIMIT | OFFSET |$)`)
+	idTiebreaker  = regexp.MustCompile(`(^|[ ,.])"?id"? (ASC|DESC)$`)
+)
+
+// TestPaginatedQueriesEndWithIDTiebreaker checks that every paginated query
+// breaks ties on id, so rows sharing a timestamp keep one order across pages.
+func TestPaginatedQueriesEndWithIDTiebreaker(t *testing.T) {
+	type pagedQuery struct {
+		name string
+		run  func(ctx context.Context, gdb *gorm.DB) error
+	}
+
+	id := uuid.New()
+	tests := []pagedQuery{
+		{name: "dumpster list", run: func(ctx context.Context, gdb *gorm.DB) error {
+			_, _, err := NewDumpsterRepository(gdb, nil, DumpsterRepositoryConfig{}).List(ctx, dto.DumpsterListRequest{})
+			return err
+		}},
+		{name: "dumpster search", run: func(ctx context.Context, gdb *gorm.DB) error {
+			_, _, err := NewDumpsterRepository(gdb, nil, DumpsterRepositoryConfig{}).Search(ctx, dto.DumpsterSearchRequest{})
+			return err
+		}},
+		{name: "usages by dumpster", run: func(ctx context.Context, gdb *gorm.DB) error {
+			_, _, err := NewUsageRepository(gdb, UsageRepositoryConfig{}).GetByDumpsterID(ctx, id, dto.UsageListRequest{})
+			return err
+		}},
+		{name: "usages by user", run: func(ctx context.Context, gdb *gorm.DB) error {
+			_, _, err := NewUsageRepository(gdb, UsageRepositoryConfig{}).GetByUserID(ctx, id, dto.UsageListRequest{})
+			return err
+		}},
+		{name: "usages by user and dumpster", run: func(ctx context.Context, gdb *gorm.DB) error {
+			_, _, err := NewUsageRepository(gdb, UsageRepositoryConfig{}).GetByUserAndDumpster(ctx, id, id, dto.UsageListRequest{})
+			return err
+		}},
+		{name: "usage list", run: func(ctx context.Context, gdb *gorm.DB) error {
+			_, _, err := NewUsageRepository(gdb, UsageRepositoryConfig{}).List(ctx, dto.UsageListRequest{})
+			return err
+		}},
+		{name: "reviews for moderation", run: func(ctx context.Context, gdb *gorm.DB) error {
+			_, _, err := NewReviewRepository(gdb, ReviewRepositoryConfig{}).ListForModeration(ctx, nil, nil, dto.AdminReviewListRequest{})
+			return err
+		}},
+		{name: "reviews by dumpster", run: func(ctx context.Context, gdb *gorm.DB) error {
+			_, _, err := NewReviewRepository(gdb, ReviewRepositoryConfig{}).GetByDumpsterID(ctx, id, dto.ReviewListRequest{})
+			return err
+		}},
+		{name: "helpful reviews by dumpster", run: func(ctx context.Context, gdb *gorm.DB) error {
+			_, _, err := NewReviewRepository(gdb, ReviewRepositoryConfig{}).GetByDumpsterID(ctx, id, dto.ReviewListRequest{SortBy: "helpful"})
+			return err
+		}},
+		{name: "reviews by user", run: func(ctx context.Context, gdb *gorm.DB) error {
+			_, _, err := NewReviewRepository(gdb, ReviewRepositoryConfig{}).GetByUserID(ctx, id, dto.ReviewListRequest{})
+			return err
+		}},
+		{name: "unanswered reviews", run: func(ctx context.Context, gdb *gorm.DB) error {
+			_, _, err := NewReviewRepository(gdb, ReviewRepositoryConfig{}).GetUnansweredByOwner(ctx, id, dto.ReviewListRequest{})
+			return err
+		}},
+	}
+
+	for sortBy := range dumpsterSortOrders {
+		tests = append(tests, pagedQuery{name: "dumpster list sorted by " + sortBy, run: func(ctx context.Context, gdb *gorm.DB) error {
+			_, _, err := NewDumpsterRepository(gdb, nil, DumpsterRepositoryConfig{}).List(ctx, dto.DumpsterListRequest{SortBy: sortBy})
+			return err
+		}})
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gdb, rec := openRecordingDB(t, func(query string) fakeResult {
+				if strings.HasPrefix(query, "SELECT count(*)") {
+					return fakeResult{columns: []string{"count"}, rows: [][]driver.Value{{int64(1)}}}
+				}
+				return fakeResult{}
+			})
+
+			if err := tt.run(context.Background(), gdb); err != nil {
+				t.Fatalf("query failed: %v", err)
+			}
+
+			ordered := 0
+			for _, stmt := range rec.Statements() {
+				m := orderByClause.FindStringSubmatch(stmt)
+				if m == nil {
+					continue
+				}
+				ordered++
+				if !idTiebreaker.MatchString(strings.TrimSpace(m[1])) {
+					t.Errorf("ORDER BY %s has no id tiebreaker in %s", m[1], stmt)
+				}
+			}
+			if ordered == 0 {
+				t.Fatalf("no ordered statement in %q", rec.Statements())
+			}
+		})
+	}
+}
+
+func TestUsagePagingIsStableForIdenticalStartTimes(t *testing.T) {
+	gdb := openTestDB(t)
+	repo := NewUsageRepository(gdb, UsageRepositoryConfig{})
+	ctx := context.Background()
+
+	owner := createTestUser(t, gdb)
+	renter := createTestUser(t, gdb)
+	dumpster := createTestDumpster(t, gdb, owner.ID)
+
+	start := time.Now().Add(-time.Hour).Truncate(time.Second)
+	const total = 2*defaultPageSize + 5
+	for range total {
+		usage := &model.DumpsterUsage{
+			DumpsterID: dumpster.ID,
+			UserID:     renter.ID,
+			StartTime:  start,
+			Status:     model.UsageStatusCompleted,
+		}
+		if err := repo.Create(ctx, usage); err != nil {
+			t.Fatalf("create usage: %v", err)
+		}
+	}
+
+	seen := make(map[uuid.UUID]bool)
+	for page := 1; page <= 3; page++ {
+		usages, _, err := repo.GetByDumpsterID(ctx, dumpster.ID, dto.UsageListRequest{Page: page, Limit: defaultPageSize})
+		if err != nil {
+			t.Fatalf("page %d: %v", page, err)
+		}
+		for _, usage := range usages {
+			if seen[usage.ID] {
+				t.Fatalf("usage %s returned on more than one page", usage.ID)
+			}
+			seen[usage.ID] = true
+		}
+	}
+	if len(seen) != total {
+		t.Fatalf("paged through %d usages, want %d", len(seen), total)
+	}
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+	"waste-space/internal/model"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type PaymentRepository interface {
+	Create(ctx context.Context, payment *model.Payment) error
+	GetByIdempotencyKey(ctx context.Context, key string) (*model.Payment, error)
+	GetPaidByBookingID(ctx context.Context, bookingID uuid.UUID) (*model.Payment, error)
+	MarkFailed(ctx context.Context, id uuid.UUID, reason string) error
+	CompleteBookingPayment(ctx context.Context, payment *model.Payment, reference string, paidAt time.Time) (bool, error)
+}
+
+type paymentRepository struct {
+	db *gorm.DB
+}
+
+func NewPaymentRepository(db *gorm.DB) PaymentRepository {
+	return &paymentRepository{db: db}
+}
+
+// Create records a pending attempt. The idempotency key is unique, and a
+// booking or usage can hold only one pending or paid attempt, so concurrent
+// retries are rejected with AlreadyExists before anything is charged.
+func (r *paymentRepository) Create(ctx context.Context, payment *model.Payment) error {
+	result := r.db.WithContext(ctx).Create(payment)
+	if result.Error != nil {
+		if isUniqueViolation(result.Error, "uniq_payments_idempotency_key") ||
+			isUniqueViolation(result.Error, "uniq_payments_booking_active") ||
+			isUniqueViolation(result.Error, "uniq_payments_usage_active") {
+			return apperrors.AlreadyExists("a payment is already in progress or completed")
+		}
+		return apperrors.Internal("failed to create payment", result.Error)
+	}
+
+	return nil
+}
+
+// GetByIdempotencyKey returns nil, nil when no attempt used the key.
+func (r *paymentRepository) GetByIdempotencyKey(ctx context.Context, key string) (*model.Payment, error) {
+	var payment model.Payment
+	result := r.db.WithContext(ctx).Where("idempotency_key = ?", key).First(&payment)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, apperrors.Internal("failed to get payment", result.Error)
+	}
+
+	return &payment, nil
+}
+
+// GetPaidByBookingID returns nil, nil when the booking has no successful payment.
+func (r *paymentRepository) GetPaidByBookingID(ctx context.Context, bookingID uuid.UUID) (*model.Payment, error) {
+	var payment model.Payment
+	result := r.db.WithContext(ctx).
+		Where("booking_id = ? AND status = ?", bookingID, model.PaymentStatusPaid).
+		First(&payment)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, apperrors.Internal("failed to get payment", result.Error)
+	}
+
+	return &payment, nil
+}
+
+func (r *paymentRepository) MarkFailed(ctx context.Context, id uuid.UUID, reason string) error {
+	result := r.db.WithContext(ctx).
+		Model(&model.Payment{}).
+		Where("id = ? AND status = ?", id, model.PaymentStatusPending).
+		Updates(map[string]any{
+			"status":         model.PaymentStatusFailed,
+			"failure_reason": reason,
+		})
+	if result.Error != nil {
+		return apperrors.Internal("failed to mark payment failed", result.Error)
+	}
+
+	return nil
+}
+
+// CompleteBookingPayment marks the payment paid and the booking paid in one
+// transaction, confirming the booking if it was still pending. The payment
+// is recorded even when the booking has meanwhile expired or been cancelled,
+// because the charge went through; the returned bool is false in that case.
+func (r *paymentRepository) CompleteBookingPayment(
+	ctx context.Context,
+	payment *model.Payment,
+	reference string,
+	paidAt time.Time) (bool, error) {
+	bookingUpdated := false
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.Payment{}).
+			Where("id = ?", payment.ID).
+			Updates(map[string]any{
+				"status":             model.PaymentStatusPaid,
+				"provider_reference": reference,
+			}).Error; err != nil {
+			return apperrors.Internal("failed to mark payment paid", err)
+		}
+
+		result := tx.Model(&model.Booking{}).
+			Where("id = ? AND status IN ? AND paid_at IS NULL", payment.BookingID,
+				[]model.BookingStatus{model.BookingStatusPending, model.BookingStatusConfirmed}).
+			Updates(map[string]any{
+				"paid_at":      paidAt,
+				"status":       model.BookingStatusConfirmed,
+				"confirmed_at": gorm.Expr("COALESCE(confirmed_at, ?)", paidAt),
+			})
+		if result.Error != nil {
+			return apperrors.Internal("failed to mark booking paid", result.Error)
+		}
+
+		bookingUpdated = result.RowsAffected > 0
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	payment.Status = model.PaymentStatusPaid
+	payment.ProviderReference = reference
+
+	return bookingUpdated, nil
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"waste-space/internal/model"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type PriceChangeRepository interface {
+	Create(ctx context.Context, change *model.DumpsterPriceChange) error
+	GetByDumpsterIDBefore(ctx context.Context, dumpsterID uuid.UUID, before Keyset, limit int) ([]*model.DumpsterPriceChange, error)
+}
+
+type priceChangeRepository struct {
+	db *gorm.DB
+}
+
+func NewPriceChangeRepository(db *gorm.DB) PriceChangeRepository {
+	return &priceChangeRepository{db: db}
+}
+
+func (r *priceChangeRepository) Create(ctx context.Context, change *model.DumpsterPriceChange) error {
+	result := r.db.WithContext(ctx).Create(change)
+	if result.Error != nil {
+		return apperrors.Internal("failed to record price change", result.Error)
+	}
+	return nil
+}
+
+func (r *priceChangeRepository) GetByDumpsterIDBefore(
+	ctx context.Context,
+	dumpsterID uuid.UUID,
+	before Keyset,
+	limit int) ([]*model.DumpsterPriceChange, error) {
+	var changes []*model.DumpsterPriceChange
+	result := beforeKeyset(r.db.WithContext(ctx), before).
+		Where("dumpster_id = ?", dumpsterID).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&changes)
+	if result.Error != nil {
+		return nil, apperrors.Internal("failed to get price changes", result.Error)
+	}
+	return changes, nil
+}
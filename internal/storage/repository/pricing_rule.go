@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"waste-space/internal/model"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type PricingRuleRepository interface {
+	Create(ctx context.Context, rule *model.PricingRule) error
+	GetByID(ctx context.Context, dumpsterID, id uuid.UUID) (*model.PricingRule, error)
+	GetByDumpsterID(ctx context.Context, dumpsterID uuid.UUID) ([]model.PricingRule, error)
+	Update(ctx context.Context, rule *model.PricingRule) error
+	Delete(ctx context.Context, dumpsterID, id uuid.UUID) error
+}
+
+type pricingRuleRepository struct {
+	db *gorm.DB
+}
+
+func NewPricingRuleRepository(db *gorm.DB) PricingRuleRepository {
+	return &pricingRuleRepository{db: db}
+}
+
+func (r *pricingRuleRepository) Create(ctx context.Context, rule *model.PricingRule) error {
+	result := r.db.WithContext(ctx).Create(rule)
+	if result.Error != nil {
+		return apperrors.Internal("failed to create pricing rule", result.Error)
+	}
+	return nil
+}
+
+func (r *pricingRuleRepository) GetByID(ctx context.Context, dumpsterID, id uuid.UUID) (*model.PricingRule, error) {
+	var rule model.PricingRule
+	result := r.db.WithContext(ctx).Where("id = ? AND dumpster_id = ?", id, dumpsterID).First(&rule)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, apperrors.NotFound("pricing rule not found")
+		}
+		return nil, apperrors.Internal("failed to get pricing rule", result.Error)
+	}
+	return &rule, nil
+}
+
+func (r *pricingRuleRepository) GetByDumpsterID(ctx context.Context, dumpsterID uuid.UUID) ([]model.PricingRule, error) {
+	var rules []model.PricingRule
+	result := r.db.WithContext(ctx).
+		Where("dumpster_id = ?", dumpsterID).
+		Order("created_at DESC").
+		Find(&rules)
+	if result.Error != nil {
+		return nil, apperrors.Internal("failed to get pricing rules", result.Error)
+	}
+	return rules, nil
+}
+
+func (r *pricingRuleRepository) Update(ctx context.Context, rule *model.PricingRule) error {
+	result := r.db.WithContext(ctx).Save(rule)
+	if result.Error != nil {
+		return apperrors.Internal("failed to update pricing rule", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return apperrors.NotFound("pricing rule not found")
+	}
+
+	return nil
+}
+
+func (r *pricingRuleRepository) Delete(ctx context.Context, dumpsterID, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).
+		Where("id = ? AND dumpster_id = ?", id, dumpsterID).
+		Delete(&model.PricingRule{})
+	if result.Error != nil {
+		return apperrors.Internal("failed to delete pricing rule", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return apperrors.NotFound("pricing rule not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package repository
+
+import "gorm.io/gorm"
+
+// Relation names an association a repository read can preload.
+type Relation string
+
+const (
+	RelationOwner  Relation = "Owner"
+	RelationTags   Relation = "Tags"
+	RelationImages Relation = "Images"
+)
+
+var dumpsterDefaultPreloads = []Relation{RelationOwner, RelationTags, RelationImages}
+
+type queryOptions struct {
+	preloads    []Relation
+	preloadsSet bool
+}
+
+// QueryOption adjusts how a repository read is executed.
+type QueryOption func(*queryOptions)
+
+// WithPreload replaces the read's default preloads with exactly the given
+// relations. Each relation costs one extra query for the whole result set,
+// never one per row. Pass no relations to load the rows alone, e.g. when
+// only IDs or ownership are checked.
+func WithPreload(relations ...Relation) QueryOption {
+	return func(o *queryOptions) {
+		o.preloads = relations
+		o.preloadsSet = true
+	}
+}
+
+func applyPreloads(query *gorm.DB, defaults []Relation, opts []QueryOption) *gorm.DB {
+	options := queryOptions{preloads: defaults}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	for _, relation := range options.preloads {
+		query = query.Preload(string(relation))
+	}
+
+	return query
+}
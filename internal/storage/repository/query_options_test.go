@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"database/sql/driver"
+	"strings"
+	"testing"
+	"waste-space/internal/dto"
+
+	"github.com/google/uuid"
+)
+
+// dumpsterRows answers the list's count and page queries with n dumpsters,
+// each with its own owner, and every other query with no rows.
+func dumpsterRows(n int) func(query string) fakeResult {
+	return func(query string) fakeResult {
+		switch {
+		case strings.HasPrefix(query, "SELECT count(*)"):
+			return fakeResult{columns: []string{"count"}, rows: [][]driver.Value{{int64(n)}}}
+		case strings.HasPrefix(query, `SELECT * FROM "dumpsters"`):
+			rows := make([][]driver.Value, n)
+			for i := range rows {
+				rows[i] = []driver.Value{uuid.NewString(), uuid.NewString()}
+			}
+			return fakeResult{columns: []string{"id", "owner_id"}, rows: rows}
+		default:
+			return fakeResult{}
+		}
+	}
+}
+
+func TestDumpsterListStatementCountIsConstant(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []QueryOption
+		want int
+	}{
+		{name: "default preloads", want: 2 + len(dumpsterDefaultPreloads)},
+		{name: "owner only", opts: []QueryOption{WithPreload(RelationOwner)}, want: 3},
+		{name: "no preloads", opts: []QueryOption{WithPreload()}, want: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, n := range []int{1, 5, 50} {
+				gdb, rec := openRecordingDB(t, dumpsterRows(n))
+				repo := NewDumpsterRepository(gdb, nil, DumpsterRepositoryConfig{})
+
+				dumpsters, _, err := repo.List(context.Background(), dto.DumpsterListRequest{Limit: maxPageSize}, tt.opts...)
+				if err != nil {
+					t.Fatalf("list %d dumpsters: %v", n, err)
+				}
+				if len(dumpsters) != n {
+					t.Fatalf("listed %d dumpsters, want %d", len(dumpsters), n)
+				}
+				if got := len(rec.Statements()); got != tt.want {
+					t.Errorf("listing %d dumpsters ran %d statements, want %d: %q", n, got, tt.want, rec.Statements())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// fakeResult is the canned answer a recordingConn gives to a query.
+type fakeResult struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+// sqlRecorder is a database/sql connector that records every statement it
+// is sent and answers queries through respond, so repository SQL can be
+// inspected without a database.
+type sqlRecorder struct {
+	mu         sync.Mutex
+	statements []string
+	respond    func(query string) fakeResult
+}
+
+// openRecordingDB returns a gorm handle backed by a new sqlRecorder. A nil
+// respond answers every query with no rows.
+func openRecordingDB(t *testing.T, respond func(query string) fakeResult) (*gorm.DB, *sqlRecorder) {
+	t.Helper()
+
+	if respond == nil {
+		respond = func(string) fakeResult { return fakeResult{} }
+	}
+	rec := &sqlRecorder{respond: respond}
+
+	gdb, err := gorm.Open(postgres.New(postgres.Config{Conn: sql.OpenDB(rec)}), &gorm.Config{
+		Logger:                 logger.Default.LogMode(logger.Silent),
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("open recording db: %v", err)
+	}
+	return gdb, rec
+}
+
+// Statements returns the SQL recorded since the last Reset.
+func (r *sqlRecorder) Statements() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.statements...)
+}
+
+func (r *sqlRecorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.statements = nil
+}
+
+func (r *sqlRecorder) record(query string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.statements = append(r.statements, query)
+}
+
+func (r *sqlRecorder) Connect(context.Context) (driver.Conn, error) {
+	return &recordingConn{rec: r}, nil
+}
+
+func (r *sqlRecorder) Driver() driver.Driver { return nil }
+
+type recordingConn struct {
+	rec *sqlRecorder
+}
+
+func (c *recordingConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepared statements are not supported")
+}
+
+func (c *recordingConn) Close() error { return nil }
+
+func (c *recordingConn) Begin() (driver.Tx, error) { return c, nil }
+
+func (c *recordingConn) Commit() error { return nil }
+
+func (c *recordingConn) Rollback() error { return nil }
+
+func (c *recordingConn) CheckNamedValue(*driver.NamedValue) error { return nil }
+
+func (c *recordingConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	c.rec.record(query)
+	return driver.RowsAffected(0), nil
+}
+
+func (c *recordingConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	c.rec.record(query)
+	res := c.rec.respond(query)
+	return &recordedRows{columns: res.columns, rows: res.rows}, nil
+}
+
+type recordedRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *recordedRows) Columns() []string { return r.columns }
+
+func (r *recordedRows) Close() error { return nil }
+
+func (r *recordedRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type primaryKey struct{}
+
+// WithPrimary marks ctx so reads that would go to the read replica use the
+// primary instead. Use it where a read must see a write made just before,
+// since the replica may lag behind.
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+func usesPrimary(ctx context.Context) bool {
+	primary, _ := ctx.Value(primaryKey{}).(bool)
+	return primary
+}
+
+func readDB(ctx context.Context, primary, replica *gorm.DB) *gorm.DB {
+	if replica == nil || usesPrimary(ctx) {
+		return primary.WithContext(ctx)
+	}
+	return replica.WithContext(ctx)
+}
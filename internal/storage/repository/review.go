@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 	"waste-space/internal/dto"
 	"waste-space/internal/model"
 	apperrors "waste-space/pkg/errors"
@@ -15,20 +16,37 @@ type ReviewRepository interface {
 	Create(ctx context.Context, review *model.Review) error
 	GetByID(ctx context.Context, id uuid.UUID) (*model.Review, error)
 	Update(ctx context.Context, review *model.Review) error
-	Delete(ctx context.Context, id uuid.UUID) error
+	Delete(ctx context.Context, id uuid.UUID, reason model.ReviewDeletionReason) error
+	ListForModeration(ctx context.Context, dumpsterID, userID *uuid.UUID, req dto.AdminReviewListRequest) ([]*model.Review, int64, error)
 	GetByDumpsterID(ctx context.Context, dumpsterID uuid.UUID, req dto.ReviewListRequest) ([]*model.Review, int64, error)
 	GetByUserID(ctx context.Context, userID uuid.UUID, req dto.ReviewListRequest) ([]*model.Review, int64, error)
 	GetByUserAndDumpster(ctx context.Context, userID, dumpsterID uuid.UUID) (*model.Review, error)
 	GetAverageRating(ctx context.Context, dumpsterID uuid.UUID) (float64, error)
 	GetReviewCount(ctx context.Context, dumpsterID uuid.UUID) (int, error)
+	GetSentimentCounts(ctx context.Context, dumpsterID uuid.UUID) (*dto.ReviewSentimentCounts, error)
+	GetSummaries(ctx context.Context, dumpsterIDs []uuid.UUID) (map[uuid.UUID]dto.ReviewSummary, error)
+	CountNegativeByOwnerSince(ctx context.Context, ownerID uuid.UUID, since time.Time) (map[uuid.UUID]int64, error)
+	UpdateVoteCounts(ctx context.Context, id uuid.UUID, helpful, notHelpful int) error
+	GetByDumpsterIDBefore(ctx context.Context, dumpsterID uuid.UUID, before Keyset, limit int) ([]*model.Review, error)
+	StreamReceivedByOwner(ctx context.Context, ownerID uuid.UUID, fn func(dto.ReceivedReviewRow) error) error
+	GetUnansweredByOwner(ctx context.Context, ownerID uuid.UUID, req dto.ReviewListRequest) ([]*model.Review, int64, error)
+	SearchText(ctx context.Context, query string, limit int) ([]*model.Review, error)
+}
+
+type ReviewRepositoryConfig struct {
+	// HardDelete makes Delete remove reviews permanently instead of
+	// soft-deleting them with a reason.
+	HardDelete bool
+	MaxOffset  int
 }
 
 type reviewRepository struct {
-	db *gorm.DB
+	db  *gorm.DB
+	cfg ReviewRepositoryConfig
 }
 
-func NewReviewRepository(db *gorm.DB) ReviewRepository {
-	return &reviewRepository{db: db}
+func NewReviewRepository(db *gorm.DB, cfg ReviewRepositoryConfig) ReviewRepository {
+	return &reviewRepository{db: db, cfg: cfg}
 }
 
 func (r *reviewRepository) Create(ctx context.Context, review *model.Review) error {
@@ -64,8 +82,22 @@ func (r *reviewRepository) Update(ctx context.Context, review *model.Review) err
 	return nil
 }
 
-func (r *reviewRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	result := r.db.WithContext(ctx).Delete(&model.Review{}, id)
+// Delete soft-deletes the review and records why in the same statement.
+// With HardDelete configured the row and its votes are removed permanently
+// instead; the reason is not kept and moderators can no longer see it.
+func (r *reviewRepository) Delete(ctx context.Context, id uuid.UUID, reason model.ReviewDeletionReason) error {
+	var result *gorm.DB
+	if r.cfg.HardDelete {
+		result = r.db.WithContext(ctx).Unscoped().Delete(&model.Review{}, id)
+	} else {
+		result = r.db.WithContext(ctx).
+			Model(&model.Review{}).
+			Where("id = ?", id).
+			Updates(map[string]any{
+				"deleted_at":     time.Now(),
+				"deleted_reason": reason,
+			})
+	}
 	if result.Error != nil {
 		return apperrors.Internal("failed to delete review", result.Error)
 	}
@@ -77,6 +109,51 @@ func (r *reviewRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// ListForModeration lists reviews for admins. Soft-deleted reviews are
+// included only when req.IncludeDeleted is set; this is the only read path
+// that can return them.
+func (r *reviewRepository) ListForModeration(
+	ctx context.Context,
+	dumpsterID, userID *uuid.UUID,
+	req dto.AdminReviewListRequest) ([]*model.Review, int64, error) {
+	var reviews []*model.Review
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&model.Review{}).Preload("User")
+	if req.IncludeDeleted {
+		query = query.Unscoped()
+	}
+
+	if dumpsterID != nil {
+		query = query.Where("dumpster_id = ?", *dumpsterID)
+	}
+
+	if userID != nil {
+		query = query.Where("user_id = ?", *userID)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, apperrors.Internal("failed to count reviews", err)
+	}
+
+	page := max(req.Page, 1)
+	limit := max(req.Limit, defaultPageSize)
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	offset := (page - 1) * limit
+	if err := checkPageDepth(offset, r.cfg.MaxOffset); err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.Order("created_at DESC, id DESC").Limit(limit).Offset(offset).Find(&reviews).Error; err != nil {
+		return nil, 0, apperrors.Internal("failed to get reviews", err)
+	}
+
+	return reviews, total, nil
+}
+
 func (r *reviewRepository) GetByDumpsterID(
 	ctx context.Context,
 	dumpsterID uuid.UUID,
@@ -97,8 +174,16 @@ func (r *reviewRepository) GetByDumpsterID(
 	}
 
 	offset := (page - 1) * limit
+	if err := checkPageDepth(offset, r.cfg.MaxOffset); err != nil {
+		return nil, 0, err
+	}
+
+	sortBy := "created_at DESC, id DESC"
+	if req.SortBy == "helpful" {
+		sortBy = "helpful_count - not_helpful_count DESC, created_at DESC, id DESC"
+	}
 
-	if err := query.Order("created_at DESC").Limit(limit).Offset(offset).Find(&reviews).Error; err != nil {
+	if err := query.Order(sortBy).Limit(limit).Offset(offset).Find(&reviews).Error; err != nil {
 		return nil, 0, apperrors.Internal("failed to get reviews", err)
 	}
 
@@ -125,14 +210,55 @@ func (r *reviewRepository) GetByUserID(
 	}
 
 	offset := (page - 1) * limit
+	if err := checkPageDepth(offset, r.cfg.MaxOffset); err != nil {
+		return nil, 0, err
+	}
 
-	if err := query.Order("created_at DESC").Limit(limit).Offset(offset).Find(&reviews).Error; err != nil {
+	if err := query.Order("created_at DESC, id DESC").Limit(limit).Offset(offset).Find(&reviews).Error; err != nil {
 		return nil, 0, apperrors.Internal("failed to get reviews", err)
 	}
 
 	return reviews, total, nil
 }
 
+// GetUnansweredByOwner pages through live reviews on the owner's live
+// dumpsters that have no owner reply yet, oldest first.
+func (r *reviewRepository) GetUnansweredByOwner(
+	ctx context.Context,
+	ownerID uuid.UUID,
+	req dto.ReviewListRequest) ([]*model.Review, int64, error) {
+	var reviews []*model.Review
+	var total int64
+
+	query := r.db.WithContext(ctx).
+		Model(&model.Review{}).
+		Preload("User").
+		Preload("Dumpster").
+		Joins("JOIN dumpsters ON dumpsters.id = reviews.dumpster_id AND dumpsters.deleted_at IS NULL").
+		Where("dumpsters.owner_id = ? AND reviews.owner_reply IS NULL", ownerID)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, apperrors.Internal("failed to count unanswered reviews", err)
+	}
+
+	page := max(req.Page, 1)
+	limit := max(req.Limit, defaultPageSize)
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	offset := (page - 1) * limit
+	if err := checkPageDepth(offset, r.cfg.MaxOffset); err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.Order("reviews.created_at ASC, reviews.id ASC").Limit(limit).Offset(offset).Find(&reviews).Error; err != nil {
+		return nil, 0, apperrors.Internal("failed to get unanswered reviews", err)
+	}
+
+	return reviews, total, nil
+}
+
 func (r *reviewRepository) GetByUserAndDumpster(
 	ctx context.Context,
 	userID, dumpsterID uuid.UUID) (*model.Review, error) {
@@ -164,3 +290,200 @@ func (r *reviewRepository) GetReviewCount(ctx context.Context, dumpsterID uuid.U
 	}
 	return int(count), nil
 }
+
+const reviewSentimentExpression = `CASE
+		WHEN rating >= 4 THEN 'positive'
+		WHEN rating = 3 THEN 'neutral'
+		ELSE 'negative'
+	END`
+
+// GetSummaries averages and counts reviews for each of the dumpsters in one
+// grouped query. Dumpsters without reviews get a zero summary; IDs that
+// match no dumpster are left out.
+func (r *reviewRepository) GetSummaries(ctx context.Context, dumpsterIDs []uuid.UUID) (map[uuid.UUID]dto.ReviewSummary, error) {
+	summaries := make(map[uuid.UUID]dto.ReviewSummary, len(dumpsterIDs))
+	if len(dumpsterIDs) == 0 {
+		return summaries, nil
+	}
+
+	var rows []struct {
+		DumpsterID uuid.UUID
+		Average    float64
+		Count      int64
+	}
+	result := r.db.WithContext(ctx).
+		Model(&model.Dumpster{}).
+		Select("dumpsters.id AS dumpster_id, COALESCE(AVG(reviews.rating), 0) AS average, COUNT(reviews.id) AS count").
+		Joins("LEFT JOIN reviews ON reviews.dumpster_id = dumpsters.id AND reviews.deleted_at IS NULL").
+		Where("dumpsters.id IN ?", dumpsterIDs).
+		Group("dumpsters.id").
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, apperrors.Internal("failed to summarize reviews", result.Error)
+	}
+
+	for _, row := range rows {
+		summaries[row.DumpsterID] = dto.ReviewSummary{
+			DumpsterID: row.DumpsterID.String(),
+			Average:    row.Average,
+			Count:      row.Count,
+		}
+	}
+
+	return summaries, nil
+}
+
+func (r *reviewRepository) GetSentimentCounts(ctx context.Context, dumpsterID uuid.UUID) (*dto.ReviewSentimentCounts, error) {
+	var rows []struct {
+		Sentiment string
+		Count     int64
+	}
+	result := r.db.WithContext(ctx).
+		Model(&model.Review{}).
+		Select(reviewSentimentExpression+" AS sentiment, COUNT(*) AS count").
+		Where("dumpster_id = ?", dumpsterID).
+		Group("sentiment").
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, apperrors.Internal("failed to count review sentiment", result.Error)
+	}
+
+	var counts dto.ReviewSentimentCounts
+	for _, row := range rows {
+		switch row.Sentiment {
+		case "positive":
+			counts.Positive = row.Count
+		case "neutral":
+			counts.Neutral = row.Count
+		case "negative":
+			counts.Negative = row.Count
+		}
+	}
+	return &counts, nil
+}
+
+// CountNegativeByOwnerSince counts reviews in the negative sentiment bucket
+// written since the given time, per listing of the owner. Listings without
+// any are absent from the map.
+func (r *reviewRepository) CountNegativeByOwnerSince(
+	ctx context.Context,
+	ownerID uuid.UUID,
+	since time.Time) (map[uuid.UUID]int64, error) {
+	var rows []struct {
+		DumpsterID uuid.UUID
+		Count      int64
+	}
+	result := r.db.WithContext(ctx).
+		Model(&model.Review{}).
+		Select("dumpster_id, COUNT(*) AS count").
+		Where(reviewSentimentExpression+" = ?", "negative").
+		Where("created_at >= ?", since).
+		Where("dumpster_id IN (?)", r.db.Model(&model.Dumpster{}).Select("id").Where("owner_id = ?", ownerID)).
+		Group("dumpster_id").
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, apperrors.Internal("failed to count negative reviews", result.Error)
+	}
+
+	counts := make(map[uuid.UUID]int64, len(rows))
+	for _, row := range rows {
+		counts[row.DumpsterID] = row.Count
+	}
+
+	return counts, nil
+}
+
+func (r *reviewRepository) UpdateVoteCounts(ctx context.Context, id uuid.UUID, helpful, notHelpful int) error {
+	result := r.db.WithContext(ctx).
+		Model(&model.Review{}).
+		Where("id = ?", id).
+		UpdateColumns(map[string]any{
+			"helpful_count":     helpful,
+			"not_helpful_count": notHelpful,
+		})
+	if result.Error != nil {
+		return apperrors.Internal("failed to update review vote counts", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return apperrors.NotFound("review not found")
+	}
+
+	return nil
+}
+
+// SearchText returns up to limit live reviews whose comment contains query,
+// newest first. Reviews of listings hidden from public browsing, or owned by
+// inactive accounts, are left out.
+func (r *reviewRepository) SearchText(ctx context.Context, query string, limit int) ([]*model.Review, error) {
+	var reviews []*model.Review
+	result := r.db.WithContext(ctx).
+		Preload("Dumpster").
+		Where("comment ILIKE ?", "%"+query+"%").
+		Where("dumpster_id IN (SELECT id FROM dumpsters WHERE deleted_at IS NULL AND " +
+			publishedCondition + " AND " + activeOwnerCondition + ")").
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&reviews)
+	if result.Error != nil {
+		return nil, apperrors.Internal("failed to search reviews", result.Error)
+	}
+	return reviews, nil
+}
+
+func (r *reviewRepository) GetByDumpsterIDBefore(
+	ctx context.Context,
+	dumpsterID uuid.UUID,
+	before Keyset,
+	limit int) ([]*model.Review, error) {
+	var reviews []*model.Review
+	result := beforeKeyset(r.db.WithContext(ctx), before).
+		Where("dumpster_id = ?", dumpsterID).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&reviews)
+	if result.Error != nil {
+		return nil, apperrors.Internal("failed to get reviews", result.Error)
+	}
+	return reviews, nil
+}
+
+// StreamReceivedByOwner walks every live review on the owner's dumpsters,
+// newest first, through a database cursor so the full set is never held in
+// memory. Iteration stops at the first error returned by fn.
+func (r *reviewRepository) StreamReceivedByOwner(
+	ctx context.Context,
+	ownerID uuid.UUID,
+	fn func(dto.ReceivedReviewRow) error) error {
+	rows, err := r.db.WithContext(ctx).
+		Table("reviews").
+		Select(
+			"dumpsters.title AS dumpster_title, reviews.rating, reviews.comment, "+
+				"users.first_name AS reviewer_first_name, users.last_name AS reviewer_last_name, reviews.created_at",
+		).
+		Joins("JOIN dumpsters ON dumpsters.id = reviews.dumpster_id").
+		Joins("JOIN users ON users.id = reviews.user_id").
+		Where("dumpsters.owner_id = ? AND reviews.deleted_at IS NULL", ownerID).
+		Order("reviews.created_at DESC").
+		Rows()
+	if err != nil {
+		return apperrors.Internal("failed to query received reviews", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row dto.ReceivedReviewRow
+		if err := r.db.ScanRows(rows, &row); err != nil {
+			return apperrors.Internal("failed to read received review", err)
+		}
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return apperrors.Internal("failed to read received reviews", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"waste-space/internal/model"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ReviewVoteRepository interface {
+	Upsert(ctx context.Context, vote *model.ReviewVote) error
+	Delete(ctx context.Context, reviewID, userID uuid.UUID) error
+	GetCounts(ctx context.Context, reviewID uuid.UUID) (int, int, error)
+}
+
+type reviewVoteRepository struct {
+	db *gorm.DB
+}
+
+func NewReviewVoteRepository(db *gorm.DB) ReviewVoteRepository {
+	return &reviewVoteRepository{db: db}
+}
+
+func (r *reviewVoteRepository) Upsert(ctx context.Context, vote *model.ReviewVote) error {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "review_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"helpful", "updated_at"}),
+	}).Create(vote)
+	if result.Error != nil {
+		return apperrors.Internal("failed to save review vote", result.Error)
+	}
+	return nil
+}
+
+func (r *reviewVoteRepository) Delete(ctx context.Context, reviewID, userID uuid.UUID) error {
+	result := r.db.WithContext(ctx).
+		Where("review_id = ? AND user_id = ?", reviewID, userID).
+		Delete(&model.ReviewVote{})
+	if result.Error != nil {
+		return apperrors.Internal("failed to delete review vote", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return apperrors.NotFound("review vote not found")
+	}
+
+	return nil
+}
+
+func (r *reviewVoteRepository) GetCounts(ctx context.Context, reviewID uuid.UUID) (int, int, error) {
+	var counts struct {
+		Helpful    int
+		NotHelpful int
+	}
+	result := r.db.WithContext(ctx).
+		Model(&model.ReviewVote{}).
+		Select("COUNT(*) FILTER (WHERE helpful) AS helpful, COUNT(*) FILTER (WHERE NOT helpful) AS not_helpful").
+		Where("review_id = ?", reviewID).
+		Scan(&counts)
+	if result.Error != nil {
+		return 0, 0, apperrors.Internal("failed to count review votes", result.Error)
+	}
+	return counts.Helpful, counts.NotHelpful, nil
+}
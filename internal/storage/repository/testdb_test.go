@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"os"
+	"sync"
+	"testing"
+	"time"
+	"waste-space/internal/model"
+	"waste-space/pkg/db"
+
+	"github.com/google/uuid"
+	"github.com/pressly/goose/v3"
+	"gorm.io/gorm"
+)
+
+var migrateOnce sync.Once
+
+// openTestDB connects to the Postgres database named by TEST_DATABASE_URL,
+// applies the migrations once per run and empties every table. Tests that
+// need it are skipped when the variable is unset.
+func openTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL not set")
+	}
+
+	gdb, err := db.NewPostgresDSN(dsn)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	sqlDB, err := gdb.DB()
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	var migrateErr error
+	migrateOnce.Do(func() {
+		goose.SetLogger(goose.NopLogger())
+		if migrateErr = goose.SetDialect("postgres"); migrateErr == nil {
+			migrateErr = goose.Up(sqlDB, "../../../migrations")
+		}
+	})
+	if migrateErr != nil {
+		t.Fatalf("migrate: %v", migrateErr)
+	}
+
+	if err := gdb.Exec(`DO $$
+DECLARE t text;
+BEGIN
+	FOR t IN SELECT tablename FROM pg_tables WHERE schemaname = 'public' AND tablename <> 'goose_db_version' LOOP
+		EXECUTE 'TRUNCATE TABLE ' || quote_ident(t) || ' CASCADE';
+	END LOOP;
+END $$`).Error; err != nil {
+		t.Fatalf("truncate: %v", err)
+	}
+
+	return gdb
+}
+
+func createTestUser(t *testing.T, gdb *gorm.DB) *model.User {
+	t.Helper()
+
+	user := &model.User{
+		FirstName:    "Test",
+		LastName:     "User",
+		Email:        uuid.NewString() + "@example.com",
+		PasswordHash: "x",
+		PhoneNumber:  "+15555550100",
+		DateOfBirth:  time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC),
+		Address:      "1 Main St",
+		City:         "Springfield",
+		ZipCode:      "90210",
+	}
+	if err := gdb.Create(user).Error; err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	return user
+}
+
+func createTestDumpster(t *testing.T, gdb *gorm.DB, ownerID uuid.UUID) *model.Dumpster {
+	t.Helper()
+
+	dumpster := &model.Dumpster{
+		OwnerID:     ownerID,
+		Title:       "Test dumpster",
+		Slug:        "test-" + uuid.NewString(),
+		Location:    "Springfield",
+		Latitude:    34.1,
+		Longitude:   -118.4,
+		Address:     "1 Main St",
+		City:        "Springfield",
+		State:       "CA",
+		ZipCode:     "90210",
+		PricePerDay: 5000,
+		Size:        model.DumpsterSizeMedium,
+	}
+	if err := gdb.Create(dumpster).Error; err != nil {
+		t.Fatalf("create dumpster: %v", err)
+	}
+	return dumpster
+}
@@ -3,38 +3,109 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 	"waste-space/internal/dto"
 	"waste-space/internal/model"
 	apperrors "waste-space/pkg/errors"
+	"waste-space/pkg/money"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type UsageRepository interface {
 	Create(ctx context.Context, usage *model.DumpsterUsage) error
+	CreateExclusive(ctx context.Context, usage *model.DumpsterUsage) error
 	GetByID(ctx context.Context, id uuid.UUID) (*model.DumpsterUsage, error)
 	Update(ctx context.Context, usage *model.DumpsterUsage) error
+	UpdateDraft(ctx context.Context, usage *model.DumpsterUsage) error
+	Activate(ctx context.Context, usage *model.DumpsterUsage, exclusive bool) error
+	Complete(ctx context.Context, usage *model.DumpsterUsage) (bool, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 	GetByDumpsterID(ctx context.Context, dumpsterID uuid.UUID, req dto.UsageListRequest) ([]*model.DumpsterUsage, int64, error)
 	GetByUserID(ctx context.Context, userID uuid.UUID, req dto.UsageListRequest) ([]*model.DumpsterUsage, int64, error)
+	GetByUserAndDumpster(ctx context.Context, userID, dumpsterID uuid.UUID, req dto.UsageListRequest) ([]*model.DumpsterUsage, int64, error)
 	GetActiveUsageByUserAndDumpster(ctx context.Context, userID, dumpsterID uuid.UUID) (*model.DumpsterUsage, error)
+	HasActiveUsage(ctx context.Context, dumpsterID uuid.UUID) (bool, error)
+	GetInUseDumpsterIDs(ctx context.Context, dumpsterIDs []uuid.UUID) (map[uuid.UUID]bool, error)
 	GetStats(ctx context.Context, dumpsterID *uuid.UUID, userID *uuid.UUID) (*dto.UsageStatsResponse, error)
+	GetOwnerActivity(ctx context.Context, ownerID uuid.UUID, revenueSince time.Time) (int64, money.Amount, error)
+	GetOutstandingBalance(ctx context.Context, userID uuid.UUID) (int64, money.Amount, error)
+	MarkPaid(ctx context.Context, id uuid.UUID, paidAt time.Time) (bool, error)
+	GetTrends(ctx context.Context, dumpsterID, ownerID *uuid.UUID, granularity string, from, to time.Time) ([]dto.UsageTrendBucket, error)
+	GetUsedMinutes(ctx context.Context, dumpsterID uuid.UUID, from, to time.Time) (int64, error)
+	GetSpendingByMonth(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]dto.SpendingByMonth, error)
+	GetSpendingBySize(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]dto.SpendingBySize, error)
+	GetCompletedByListingWeight(ctx context.Context, userID uuid.UUID) ([]dto.UsageImpactGroup, error)
+	GetOwnerRevenueByDumpster(ctx context.Context, ownerID uuid.UUID, from, to time.Time) ([]dto.StatementRevenueRow, error)
+	GetLastStartByOwner(ctx context.Context, ownerID uuid.UUID) (map[uuid.UUID]time.Time, error)
 	List(ctx context.Context, req dto.UsageListRequest) ([]*model.DumpsterUsage, int64, error)
+	GetByDumpsterIDBefore(ctx context.Context, dumpsterID uuid.UUID, before Keyset, limit int) ([]*model.DumpsterUsage, error)
+}
+
+type UsageRepositoryConfig struct {
+	// HardDelete makes Delete remove usages permanently instead of
+	// soft-deleting them.
+	HardDelete bool
+	MaxOffset  int
 }
 
 type usageRepository struct {
-	db *gorm.DB
+	db  *gorm.DB
+	cfg UsageRepositoryConfig
 }
 
-func NewUsageRepository(db *gorm.DB) UsageRepository {
-	return &usageRepository{db: db}
+func NewUsageRepository(db *gorm.DB, cfg UsageRepositoryConfig) UsageRepository {
+	return &usageRepository{db: db, cfg: cfg}
 }
 
+// Create inserts the usage while holding a share lock on its dumpster, so
+// the dumpster can't be deleted between the caller's checks and the insert.
 func (r *usageRepository) Create(ctx context.Context, usage *model.DumpsterUsage) error {
-	result := r.db.WithContext(ctx).Create(usage)
-	if result.Error != nil {
-		return apperrors.Internal("failed to create usage", result.Error)
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockDumpster(tx, usage.DumpsterID, "SHARE"); err != nil {
+			return err
+		}
+
+		if err := tx.Create(usage).Error; err != nil {
+			return apperrors.Internal("failed to create usage", err)
+		}
+
+		return nil
+	})
+}
+
+// CreateExclusive inserts the usage only if no usage is active on its
+// dumpster. The dumpster row is locked first, so two concurrent starts
+// cannot both pass the check; the loser gets AlreadyExists.
+func (r *usageRepository) CreateExclusive(ctx context.Context, usage *model.DumpsterUsage) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockDumpster(tx, usage.DumpsterID, "UPDATE"); err != nil {
+			return err
+		}
+
+		if err := checkNotInUse(tx, usage.DumpsterID); err != nil {
+			return err
+		}
+
+		if err := tx.Create(usage).Error; err != nil {
+			return apperrors.Internal("failed to create usage", err)
+		}
+
+		return nil
+	})
+}
+
+func checkNotInUse(tx *gorm.DB, dumpsterID uuid.UUID) error {
+	var active int64
+	if err := tx.Model(&model.DumpsterUsage{}).
+		Where("dumpster_id = ? AND status = ?", dumpsterID, model.UsageStatusActive).
+		Count(&active).Error; err != nil {
+		return apperrors.Internal("failed to check active usage", err)
+	}
+	if active > 0 {
+		return apperrors.AlreadyExists("dumpster is already in use")
 	}
 	return nil
 }
@@ -64,8 +135,133 @@ func (r *usageRepository) Update(ctx context.Context, usage *model.DumpsterUsage
 	return nil
 }
 
+// UpdateDraft saves the start time and notes of a draft usage. A usage that
+// is no longer a draft is refused with BadRequest.
+func (r *usageRepository) UpdateDraft(ctx context.Context, usage *model.DumpsterUsage) error {
+	result := r.db.WithContext(ctx).
+		Model(&model.DumpsterUsage{}).
+		Where("id = ? AND status = ?", usage.ID, model.UsageStatusDraft).
+		Updates(map[string]any{
+			"start_time": usage.StartTime,
+			"notes":      usage.Notes,
+		})
+	if result.Error != nil {
+		return apperrors.Internal("failed to update usage", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return apperrors.BadRequest("usage session is not a draft")
+	}
+
+	return nil
+}
+
+// Activate moves a draft usage to active. With exclusive set it refuses with
+// AlreadyExists while another usage is active, as CreateExclusive does. A
+// usage that is no longer a draft is refused with BadRequest.
+func (r *usageRepository) Activate(ctx context.Context, usage *model.DumpsterUsage, exclusive bool) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		strength := "SHARE"
+		if exclusive {
+			strength = "UPDATE"
+		}
+		if err := lockDumpster(tx, usage.DumpsterID, strength); err != nil {
+			return err
+		}
+
+		if exclusive {
+			if err := checkNotInUse(tx, usage.DumpsterID); err != nil {
+				return err
+			}
+		}
+
+		result := tx.Model(&model.DumpsterUsage{}).
+			Where("id = ? AND status = ?", usage.ID, model.UsageStatusDraft).
+			Updates(map[string]any{
+				"status":     model.UsageStatusActive,
+				"start_time": usage.StartTime,
+			})
+		if result.Error != nil {
+			return apperrors.Internal("failed to activate usage", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return apperrors.BadRequest("usage session is not a draft")
+		}
+
+		usage.Status = model.UsageStatusActive
+		return nil
+	})
+}
+
+// Complete saves a finished usage and, when the dumpster opts into
+// AutoRelease and no other usage is still active on it, marks the dumpster
+// available again. The returned bool reports whether the dumpster is now free.
+// A usage that is no longer active is refused with AlreadyExists, so of two
+// concurrent completions only one goes through.
+func (r *usageRepository) Complete(ctx context.Context, usage *model.DumpsterUsage) (bool, error) {
+	free := false
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var dumpster model.Dumpster
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "is_available", "auto_release").
+			Where("id = ?", usage.DumpsterID).
+			First(&dumpster).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return apperrors.NotFound("dumpster not found")
+			}
+			return apperrors.Internal("failed to lock dumpster", err)
+		}
+
+		result := tx.Model(&model.DumpsterUsage{}).
+			Where("id = ? AND status = ?", usage.ID, model.UsageStatusActive).
+			Updates(map[string]any{
+				"end_time":         usage.EndTime,
+				"duration_minutes": usage.DurationMinutes,
+				"total_cost":       usage.TotalCost,
+				"notes":            usage.Notes,
+				"status":           usage.Status,
+			})
+		if result.Error != nil {
+			return apperrors.Internal("failed to update usage", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return apperrors.AlreadyExists("usage session has already ended")
+		}
+
+		var active int64
+		if err := tx.Model(&model.DumpsterUsage{}).
+			Where("dumpster_id = ? AND status = ?", usage.DumpsterID, model.UsageStatusActive).
+			Count(&active).Error; err != nil {
+			return apperrors.Internal("failed to check active usage", err)
+		}
+		if active > 0 {
+			return nil
+		}
+
+		if !dumpster.IsAvailable && dumpster.AutoRelease {
+			if err := tx.Model(&model.Dumpster{}).
+				Where("id = ?", usage.DumpsterID).
+				Update("is_available", true).Error; err != nil {
+				return apperrors.Internal("failed to release dumpster", err)
+			}
+			dumpster.IsAvailable = true
+		}
+
+		free = dumpster.IsAvailable
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return free, nil
+}
+
+// Delete soft-deletes the usage, or with HardDelete configured removes the
+// row permanently along with its payments. A hard delete cannot be undone.
 func (r *usageRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	result := r.db.WithContext(ctx).Delete(&model.DumpsterUsage{}, id)
+	result := deleteScope(r.db.WithContext(ctx), r.cfg.HardDelete).Delete(&model.DumpsterUsage{}, id)
 	if result.Error != nil {
 		return apperrors.Internal("failed to delete usage", result.Error)
 	}
@@ -101,8 +297,48 @@ func (r *usageRepository) GetByDumpsterID(
 	}
 
 	offset := (page - 1) * limit
+	if err := checkPageDepth(offset, r.cfg.MaxOffset); err != nil {
+		return nil, 0, err
+	}
 
-	if err := query.Order("start_time DESC").Limit(limit).Offset(offset).Find(&usages).Error; err != nil {
+	if err := query.Order("start_time DESC, id DESC").Limit(limit).Offset(offset).Find(&usages).Error; err != nil {
+		return nil, 0, apperrors.Internal("failed to get usages", err)
+	}
+
+	return usages, total, nil
+}
+
+func (r *usageRepository) GetByUserAndDumpster(
+	ctx context.Context,
+	userID, dumpsterID uuid.UUID,
+	req dto.UsageListRequest) ([]*model.DumpsterUsage, int64, error) {
+	var usages []*model.DumpsterUsage
+	var total int64
+
+	query := r.db.WithContext(ctx).
+		Model(&model.DumpsterUsage{}).
+		Where("user_id = ? AND dumpster_id = ?", userID, dumpsterID)
+
+	if req.Status != "" {
+		query = query.Where("status = ?", req.Status)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, apperrors.Internal("failed to count usages", err)
+	}
+
+	page := max(req.Page, 1)
+	limit := max(req.Limit, defaultPageSize)
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	offset := (page - 1) * limit
+	if err := checkPageDepth(offset, r.cfg.MaxOffset); err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.Order("start_time DESC, id DESC").Limit(limit).Offset(offset).Find(&usages).Error; err != nil {
 		return nil, 0, apperrors.Internal("failed to get usages", err)
 	}
 
@@ -133,8 +369,11 @@ func (r *usageRepository) GetByUserID(
 	}
 
 	offset := (page - 1) * limit
+	if err := checkPageDepth(offset, r.cfg.MaxOffset); err != nil {
+		return nil, 0, err
+	}
 
-	if err := query.Order("start_time DESC").Limit(limit).Offset(offset).Find(&usages).Error; err != nil {
+	if err := query.Order("start_time DESC, id DESC").Limit(limit).Offset(offset).Find(&usages).Error; err != nil {
 		return nil, 0, apperrors.Internal("failed to get usages", err)
 	}
 
@@ -155,13 +394,51 @@ func (r *usageRepository) GetActiveUsageByUserAndDumpster(
 	return &usage, nil
 }
 
+func (r *usageRepository) HasActiveUsage(ctx context.Context, dumpsterID uuid.UUID) (bool, error) {
+	var count int64
+	result := r.db.WithContext(ctx).
+		Model(&model.DumpsterUsage{}).
+		Where("dumpster_id = ? AND status = ?", dumpsterID, model.UsageStatusActive).
+		Limit(1).
+		Count(&count)
+	if result.Error != nil {
+		return false, apperrors.Internal("failed to check active usage", result.Error)
+	}
+	return count > 0, nil
+}
+
+// GetInUseDumpsterIDs reports which of the given dumpsters have an active
+// usage. Dumpsters not in use are absent from the map.
+func (r *usageRepository) GetInUseDumpsterIDs(ctx context.Context, dumpsterIDs []uuid.UUID) (map[uuid.UUID]bool, error) {
+	inUse := make(map[uuid.UUID]bool)
+	if len(dumpsterIDs) == 0 {
+		return inUse, nil
+	}
+
+	var ids []uuid.UUID
+	result := r.db.WithContext(ctx).
+		Model(&model.DumpsterUsage{}).
+		Distinct("dumpster_id").
+		Where("dumpster_id IN ? AND status = ?", dumpsterIDs, model.UsageStatusActive).
+		Pluck("dumpster_id", &ids)
+	if result.Error != nil {
+		return nil, apperrors.Internal("failed to check active usages", result.Error)
+	}
+
+	for _, id := range ids {
+		inUse[id] = true
+	}
+
+	return inUse, nil
+}
+
 func (r *usageRepository) GetStats(
 	ctx context.Context,
 	dumpsterID *uuid.UUID,
 	userID *uuid.UUID) (*dto.UsageStatsResponse, error) {
 	var stats dto.UsageStatsResponse
 
-	query := r.db.WithContext(ctx).Model(&model.DumpsterUsage{})
+	query := r.db.WithContext(ctx).Model(&model.DumpsterUsage{}).Where("status <> ?", model.UsageStatusDraft)
 
 	if dumpsterID != nil {
 		query = query.Where("dumpster_id = ?", *dumpsterID)
@@ -191,17 +468,239 @@ func (r *usageRepository) GetStats(
 		stats.TotalMinutes = *totalMinutes
 	}
 
-	var totalRevenue *float64
-	if err := query.Select("COALESCE(SUM(total_cost), 0)").Scan(&totalRevenue).Error; err != nil {
+	if err := query.Select("COALESCE(SUM(total_cost), 0)").Scan(&stats.TotalRevenue).Error; err != nil {
 		return nil, apperrors.Internal("failed to calculate total revenue", err)
 	}
-	if totalRevenue != nil {
-		stats.TotalRevenue = *totalRevenue
-	}
 
 	return &stats, nil
 }
 
+// GetOwnerActivity returns, across all of the owner's dumpsters, the number of
+// active usages and the revenue from usages completed since revenueSince.
+func (r *usageRepository) GetOwnerActivity(
+	ctx context.Context,
+	ownerID uuid.UUID,
+	revenueSince time.Time) (int64, money.Amount, error) {
+	var activity struct {
+		ActiveCount int64
+		Revenue     money.Amount
+	}
+
+	result := r.db.WithContext(ctx).
+		Model(&model.DumpsterUsage{}).
+		Select(
+			"COUNT(*) FILTER (WHERE status = ?) AS active_count, "+
+				"COALESCE(SUM(total_cost) FILTER (WHERE status = ? AND end_time >= ?), 0) AS revenue",
+			model.UsageStatusActive, model.UsageStatusCompleted, revenueSince,
+		).
+		Where("dumpster_id IN (?)", r.db.Model(&model.Dumpster{}).Unscoped().Select("id").Where("owner_id = ?", ownerID)).
+		Scan(&activity)
+	if result.Error != nil {
+		return 0, 0, apperrors.Internal("failed to summarize owner usage activity", result.Error)
+	}
+
+	return activity.ActiveCount, activity.Revenue, nil
+}
+
+// GetLastStartByOwner returns when each of the owner's listings last had a
+// usage started. Drafts are ignored; listings that were never used are
+// absent from the map.
+func (r *usageRepository) GetLastStartByOwner(ctx context.Context, ownerID uuid.UUID) (map[uuid.UUID]time.Time, error) {
+	var rows []struct {
+		DumpsterID uuid.UUID
+		LastStart  time.Time
+	}
+	result := r.db.WithContext(ctx).
+		Model(&model.DumpsterUsage{}).
+		Select("dumpster_id, MAX(start_time) AS last_start").
+		Where("dumpster_id IN (?)", r.db.Model(&model.Dumpster{}).Select("id").Where("owner_id = ?", ownerID)).
+		Where("status <> ?", model.UsageStatusDraft).
+		Group("dumpster_id").
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, apperrors.Internal("failed to get last usage per dumpster", result.Error)
+	}
+
+	lastStarts := make(map[uuid.UUID]time.Time, len(rows))
+	for _, row := range rows {
+		lastStarts[row.DumpsterID] = row.LastStart
+	}
+
+	return lastStarts, nil
+}
+
+// GetUsedMinutes sums the minutes the dumpster's completed usages overlap
+// [from, to), clipping usages that started before or ended after the window.
+func (r *usageRepository) GetUsedMinutes(ctx context.Context, dumpsterID uuid.UUID, from, to time.Time) (int64, error) {
+	var minutes int64
+	result := r.db.WithContext(ctx).
+		Model(&model.DumpsterUsage{}).
+		Select("COALESCE(FLOOR(SUM(EXTRACT(EPOCH FROM LEAST(end_time, ?) - GREATEST(start_time, ?))) / 60), 0)::bigint", to, from).
+		Where("dumpster_id = ? AND status = ?", dumpsterID, model.UsageStatusCompleted).
+		Where("start_time < ? AND end_time > ?", to, from).
+		Scan(&minutes)
+	if result.Error != nil {
+		return 0, apperrors.Internal("failed to sum used minutes", result.Error)
+	}
+	return minutes, nil
+}
+
+// GetOutstandingBalance returns the number and summed cost of the user's
+// completed usages that have not been paid. Nothing owed yields zeros.
+func (r *usageRepository) GetOutstandingBalance(ctx context.Context, userID uuid.UUID) (int64, money.Amount, error) {
+	var balance struct {
+		Count   int64
+		Balance money.Amount
+	}
+
+	result := r.db.WithContext(ctx).
+		Model(&model.DumpsterUsage{}).
+		Select("COUNT(*) AS count, COALESCE(SUM(total_cost), 0) AS balance").
+		Where("user_id = ? AND status = ? AND paid_at IS NULL", userID, model.UsageStatusCompleted).
+		Scan(&balance)
+	if result.Error != nil {
+		return 0, 0, apperrors.Internal("failed to calculate outstanding balance", result.Error)
+	}
+
+	return balance.Count, balance.Balance, nil
+}
+
+// MarkPaid stamps a completed, unpaid usage as paid. It reports false when
+// the usage was already paid or is not completed.
+func (r *usageRepository) MarkPaid(ctx context.Context, id uuid.UUID, paidAt time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.DumpsterUsage{}).
+		Where("id = ? AND status = ? AND paid_at IS NULL", id, model.UsageStatusCompleted).
+		Update("paid_at", paidAt)
+	if result.Error != nil {
+		return false, apperrors.Internal("failed to mark usage paid", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// GetTrends buckets usages started in [from, to) with date_trunc, ignoring
+// drafts. Only non-empty buckets are returned; granularity must already be validated
+// since it is passed to date_trunc as-is.
+func (r *usageRepository) GetTrends(
+	ctx context.Context,
+	dumpsterID, ownerID *uuid.UUID,
+	granularity string,
+	from, to time.Time) ([]dto.UsageTrendBucket, error) {
+	var buckets []dto.UsageTrendBucket
+
+	query := r.db.WithContext(ctx).
+		Model(&model.DumpsterUsage{}).
+		Select(
+			"date_trunc(?, start_time) AS start, COUNT(*) AS usages, "+
+				"COALESCE(SUM(total_cost) FILTER (WHERE status = ?), 0) AS revenue",
+			granularity, model.UsageStatusCompleted,
+		).
+		Where("start_time >= ? AND start_time < ?", from, to).
+		Where("status <> ?", model.UsageStatusDraft)
+
+	if dumpsterID != nil {
+		query = query.Where("dumpster_id = ?", *dumpsterID)
+	}
+
+	if ownerID != nil {
+		query = query.Where("dumpster_id IN (?)", r.db.Model(&model.Dumpster{}).Unscoped().Select("id").Where("owner_id = ?", *ownerID))
+	}
+
+	if err := query.Group("start").Order("start").Scan(&buckets).Error; err != nil {
+		return nil, apperrors.Internal("failed to get usage trends", err)
+	}
+
+	return buckets, nil
+}
+
+// GetSpendingByMonth sums the user's completed usages that ended in
+// [from, to) per calendar month. Months without usages are absent.
+func (r *usageRepository) GetSpendingByMonth(
+	ctx context.Context,
+	userID uuid.UUID,
+	from, to time.Time) ([]dto.SpendingByMonth, error) {
+	var months []dto.SpendingByMonth
+	result := r.db.WithContext(ctx).
+		Model(&model.DumpsterUsage{}).
+		Select("date_trunc('month', end_time) AS month, COUNT(*) AS usages, COALESCE(SUM(total_cost), 0) AS total").
+		Where("user_id = ? AND status = ?", userID, model.UsageStatusCompleted).
+		Where("end_time >= ? AND end_time < ?", from, to).
+		Group("month").
+		Order("month").
+		Scan(&months)
+	if result.Error != nil {
+		return nil, apperrors.Internal("failed to get spending by month", result.Error)
+	}
+
+	return months, nil
+}
+
+// GetSpendingBySize sums the user's completed usages that ended in
+// [from, to) per dumpster size, including usages of since deleted dumpsters.
+// Sizes without usages are absent.
+func (r *usageRepository) GetSpendingBySize(
+	ctx context.Context,
+	userID uuid.UUID,
+	from, to time.Time) ([]dto.SpendingBySize, error) {
+	var sizes []dto.SpendingBySize
+	result := r.db.WithContext(ctx).
+		Model(&model.DumpsterUsage{}).
+		Select("dumpsters.size AS size, COUNT(*) AS usages, COALESCE(SUM(dumpster_usages.total_cost), 0) AS total").
+		Joins("JOIN dumpsters ON dumpsters.id = dumpster_usages.dumpster_id").
+		Where("dumpster_usages.user_id = ? AND dumpster_usages.status = ?", userID, model.UsageStatusCompleted).
+		Where("dumpster_usages.end_time >= ? AND dumpster_usages.end_time < ?", from, to).
+		Group("dumpsters.size").
+		Scan(&sizes)
+	if result.Error != nil {
+		return nil, apperrors.Internal("failed to get spending by size", result.Error)
+	}
+
+	return sizes, nil
+}
+
+// GetCompletedByListingWeight counts the user's completed usages per
+// dumpster size and listed weight, including usages of since deleted
+// dumpsters.
+func (r *usageRepository) GetCompletedByListingWeight(ctx context.Context, userID uuid.UUID) ([]dto.UsageImpactGroup, error) {
+	var groups []dto.UsageImpactGroup
+	result := r.db.WithContext(ctx).
+		Model(&model.DumpsterUsage{}).
+		Select("dumpsters.size AS size, dumpsters.weight AS weight, COUNT(*) AS usages").
+		Joins("JOIN dumpsters ON dumpsters.id = dumpster_usages.dumpster_id").
+		Where("dumpster_usages.user_id = ? AND dumpster_usages.status = ?", userID, model.UsageStatusCompleted).
+		Group("dumpsters.size, dumpsters.weight").
+		Scan(&groups)
+	if result.Error != nil {
+		return nil, apperrors.Internal("failed to count completed usages by listing", result.Error)
+	}
+
+	return groups, nil
+}
+
+// GetOwnerRevenueByDumpster counts and sums, per listing, the owner's
+// usages completed in [from, to), including listings since deleted.
+func (r *usageRepository) GetOwnerRevenueByDumpster(
+	ctx context.Context,
+	ownerID uuid.UUID,
+	from, to time.Time) ([]dto.StatementRevenueRow, error) {
+	var rows []dto.StatementRevenueRow
+	result := r.db.WithContext(ctx).
+		Model(&model.DumpsterUsage{}).
+		Select("dumpsters.id AS dumpster_id, dumpsters.title AS title, COUNT(*) AS count, "+
+			"COALESCE(SUM(dumpster_usages.total_cost), 0) AS revenue").
+		Joins("JOIN dumpsters ON dumpsters.id = dumpster_usages.dumpster_id").
+		Where("dumpsters.owner_id = ? AND dumpster_usages.status = ?", ownerID, model.UsageStatusCompleted).
+		Where("dumpster_usages.end_time >= ? AND dumpster_usages.end_time < ?", from, to).
+		Group("dumpsters.id, dumpsters.title").
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, apperrors.Internal("failed to get usage revenue by dumpster", result.Error)
+	}
+
+	return rows, nil
+}
+
 func (r *usageRepository) List(
 	ctx context.Context,
 	req dto.UsageListRequest) ([]*model.DumpsterUsage, int64, error) {
@@ -241,10 +740,30 @@ func (r *usageRepository) List(
 	}
 
 	offset := (page - 1) * limit
+	if err := checkPageDepth(offset, r.cfg.MaxOffset); err != nil {
+		return nil, 0, err
+	}
 
-	if err := query.Order("start_time DESC").Limit(limit).Offset(offset).Find(&usages).Error; err != nil {
+	if err := query.Order("start_time DESC, id DESC").Limit(limit).Offset(offset).Find(&usages).Error; err != nil {
 		return nil, 0, apperrors.Internal("failed to get usages", err)
 	}
 
 	return usages, total, nil
 }
+
+func (r *usageRepository) GetByDumpsterIDBefore(
+	ctx context.Context,
+	dumpsterID uuid.UUID,
+	before Keyset,
+	limit int) ([]*model.DumpsterUsage, error) {
+	var usages []*model.DumpsterUsage
+	result := beforeKeyset(r.db.WithContext(ctx), before).
+		Where("dumpster_id = ? AND status <> ?", dumpsterID, model.UsageStatusDraft).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&usages)
+	if result.Error != nil {
+		return nil, apperrors.Internal("failed to get dumpster usages", result.Error)
+	}
+	return usages, nil
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+	"waste-space/internal/model"
+	apperrors "waste-space/pkg/errors"
+)
+
+func TestUsageCompleteReleasesAfterLastConcurrentUser(t *testing.T) {
+	gdb := openTestDB(t)
+	repo := NewUsageRepository(gdb, UsageRepositoryConfig{})
+	ctx := context.Background()
+
+	owner := createTestUser(t, gdb)
+	dumpster := createTestDumpster(t, gdb, owner.ID)
+	if err := gdb.Model(dumpster).Updates(map[string]any{"auto_release": true, "is_available": false}).Error; err != nil {
+		t.Fatalf("prepare dumpster: %v", err)
+	}
+
+	start := time.Now().Add(-2 * time.Hour)
+	usages := make([]*model.DumpsterUsage, 2)
+	for i := range usages {
+		renter := createTestUser(t, gdb)
+		usages[i] = &model.DumpsterUsage{
+			DumpsterID: dumpster.ID,
+			UserID:     renter.ID,
+			StartTime:  start,
+			Status:     model.UsageStatusActive,
+		}
+		if err := repo.Create(ctx, usages[i]); err != nil {
+			t.Fatalf("create usage: %v", err)
+		}
+	}
+
+	// The renters finish a moment apart but their requests overlap.
+	var wg sync.WaitGroup
+	frees := make([]bool, len(usages))
+	errs := make([]error, len(usages))
+	for i, usage := range usages {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			time.Sleep(time.Duration(i) * 5 * time.Millisecond)
+			end := start.Add(time.Duration(i+1) * time.Hour)
+			usage.EndTime = &end
+			usage.Status = model.UsageStatusCompleted
+			frees[i], errs[i] = repo.Complete(ctx, usage)
+		}()
+	}
+	wg.Wait()
+
+	released := 0
+	for i := range usages {
+		if errs[i] != nil {
+			t.Fatalf("complete usage %d: %v", i, errs[i])
+		}
+		if frees[i] {
+			released++
+		}
+	}
+	if released != 1 {
+		t.Fatalf("dumpster reported free by %d completions, want 1", released)
+	}
+
+	var got model.Dumpster
+	if err := gdb.First(&got, "id = ?", dumpster.ID).Error; err != nil {
+		t.Fatalf("reload dumpster: %v", err)
+	}
+	if !got.IsAvailable {
+		t.Fatal("dumpster still unavailable after its last usage ended")
+	}
+}
+
+func TestUsageCompleteOnlyOnce(t *testing.T) {
+	gdb := openTestDB(t)
+	repo := NewUsageRepository(gdb, UsageRepositoryConfig{})
+	ctx := context.Background()
+
+	owner := createTestUser(t, gdb)
+	renter := createTestUser(t, gdb)
+	dumpster := createTestDumpster(t, gdb, owner.ID)
+
+	usage := &model.DumpsterUsage{
+		DumpsterID: dumpster.ID,
+		UserID:     renter.ID,
+		StartTime:  time.Now().Add(-time.Hour),
+		Status:     model.UsageStatusActive,
+	}
+	if err := repo.Create(ctx, usage); err != nil {
+		t.Fatalf("create usage: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			attempt := *usage
+			end := time.Now()
+			attempt.EndTime = &end
+			attempt.Status = model.UsageStatusCompleted
+			_, errs[i] = repo.Complete(ctx, &attempt)
+		}()
+	}
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			succeeded++
+		case !apperrors.Is(err, apperrors.ErrorTypeAlreadyExists):
+			t.Fatalf("losing completion returned %v, want AlreadyExists", err)
+		}
+	}
+	if succeeded != 1 {
+		t.Fatalf("%d completions succeeded, want 1", succeeded)
+	}
+}
@@ -14,23 +14,38 @@ type UserRepository interface {
 	Create(ctx context.Context, user *model.User) error
 	GetByID(ctx context.Context, id uuid.UUID) (*model.User, error)
 	GetByEmail(ctx context.Context, email string) (*model.User, error)
+	IsPhoneVerifiedElsewhere(ctx context.Context, phone string, userID uuid.UUID) (bool, error)
+	GetActiveByIDs(ctx context.Context, ids []uuid.UUID) ([]*model.User, error)
 	Update(ctx context.Context, user *model.User) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	List(ctx context.Context, limit, offset int) ([]*model.User, error)
 	Count(ctx context.Context) (int64, error)
+	CountWithinRadius(ctx context.Context, latitude, longitude, radiusKm float64) (int64, error)
 }
 
+type UserRepositoryConfig struct {
+	// HardDelete makes Delete remove users permanently instead of
+	// soft-deleting them.
+	HardDelete bool
+}
+
+const verifiedPhoneIndex = "uniq_users_verified_phone"
+
 type userRepository struct {
-	db *gorm.DB
+	db  *gorm.DB
+	cfg UserRepositoryConfig
 }
 
-func NewUserRepository(db *gorm.DB) UserRepository {
-	return &userRepository{db: db}
+func NewUserRepository(db *gorm.DB, cfg UserRepositoryConfig) UserRepository {
+	return &userRepository{db: db, cfg: cfg}
 }
 
 func (r *userRepository) Create(ctx context.Context, user *model.User) error {
 	result := r.db.WithContext(ctx).Create(user)
 	if result.Error != nil {
+		if isUniqueViolation(result.Error, verifiedPhoneIndex) {
+			return apperrors.AlreadyExists("phone number is already verified on another account")
+		}
 		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
 			return apperrors.AlreadyExists("user with this email already exists")
 		}
@@ -66,9 +81,39 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (*model.U
 	return &user, nil
 }
 
+// IsPhoneVerifiedElsewhere reports whether a live account other than userID
+// has verified phone. Pass uuid.Nil to check every account.
+func (r *userRepository) IsPhoneVerifiedElsewhere(ctx context.Context, phone string, userID uuid.UUID) (bool, error) {
+	var count int64
+	result := r.db.WithContext(ctx).
+		Model(&model.User{}).
+		Where("phone_number = ? AND is_phone_verified = ? AND id <> ?", phone, true, userID).
+		Limit(1).
+		Count(&count)
+	if result.Error != nil {
+		return false, apperrors.Internal("failed to check phone number", result.Error)
+	}
+	return count > 0, nil
+}
+
+func (r *userRepository) GetActiveByIDs(ctx context.Context, ids []uuid.UUID) ([]*model.User, error) {
+	var users []*model.User
+	result := r.db.WithContext(ctx).
+		Where("id IN ? AND is_active = ?", ids, true).
+		Find(&users)
+	if result.Error != nil {
+		return nil, apperrors.Internal("failed to get users", result.Error)
+	}
+
+	return users, nil
+}
+
 func (r *userRepository) Update(ctx context.Context, user *model.User) error {
 	result := r.db.WithContext(ctx).Save(user)
 	if result.Error != nil {
+		if isUniqueViolation(result.Error, verifiedPhoneIndex) {
+			return apperrors.AlreadyExists("phone number is already verified on another account")
+		}
 		return apperrors.Internal("failed to update user", result.Error)
 	}
 
@@ -79,8 +124,11 @@ func (r *userRepository) Update(ctx context.Context, user *model.User) error {
 	return nil
 }
 
+// Delete soft-deletes the user, or with HardDelete configured removes the
+// row permanently. A hard delete is irreversible and cascades to everything
+// the user owns or wrote: dumpsters, reviews, usages, bookings and payments.
 func (r *userRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	result := r.db.WithContext(ctx).Delete(&model.User{}, id)
+	result := deleteScope(r.db.WithContext(ctx), r.cfg.HardDelete).Delete(&model.User{}, id)
 	if result.Error != nil {
 		return apperrors.Internal("failed to delete user", result.Error)
 	}
@@ -97,7 +145,7 @@ func (r *userRepository) List(
 	limit, offset int) ([]*model.User, error) {
 	var users []*model.User
 	result := r.db.WithContext(ctx).
-		Order("created_at DESC").
+		Order("created_at DESC, id DESC").
 		Limit(limit).
 		Offset(offset).
 		Find(&users)
@@ -116,4 +164,25 @@ func (r *userRepository) Count(ctx context.Context) (int64, error) {
 	}
 
 	return count, nil
-}
\ No newline at end of file
+}
+
+func (r *userRepository) CountWithinRadius(
+	ctx context.Context,
+	latitude, longitude, radiusKm float64) (int64, error) {
+	var count int64
+	result := r.db.WithContext(ctx).Raw(`
+		SELECT COUNT(*) FROM users
+		WHERE deleted_at IS NULL
+		AND is_active = true
+		AND latitude IS NOT NULL
+		AND longitude IS NOT NULL
+		AND ? * acos(LEAST(1, cos(radians(?)) * cos(radians(latitude)) *
+			cos(radians(longitude) - radians(?)) +
+			sin(radians(?)) * sin(radians(latitude)))) < ?
+	`, earthRadiusKm, latitude, longitude, latitude, radiusKm).Scan(&count)
+	if result.Error != nil {
+		return 0, apperrors.Internal("failed to count users within radius", result.Error)
+	}
+
+	return count, nil
+}
@@ -0,0 +1,47 @@
+package worker
+
+import (
+	"context"
+	"time"
+	"waste-space/internal/service"
+
+	"go.uber.org/zap"
+)
+
+type BookingExpirer struct {
+	bookingService service.BookingService
+	interval       time.Duration
+	logger         *zap.Logger
+}
+
+func NewBookingExpirer(
+	bookingService service.BookingService,
+	interval time.Duration,
+	logger *zap.Logger) *BookingExpirer {
+	return &BookingExpirer{
+		bookingService: bookingService,
+		interval:       interval,
+		logger:         logger,
+	}
+}
+
+func (j *BookingExpirer) Name() string {
+	return "booking_expirer"
+}
+
+func (j *BookingExpirer) Interval() time.Duration {
+	return j.interval
+}
+
+func (j *BookingExpirer) Run(ctx context.Context) error {
+	expired, err := j.bookingService.ExpirePending(ctx)
+	if err != nil {
+		return err
+	}
+
+	if expired > 0 {
+		j.logger.Info("expired pending bookings", zap.Int("count", expired))
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE reviews
+    ADD COLUMN helpful_count INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN not_helpful_count INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE review_votes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    review_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    helpful BOOLEAN NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_review_votes_review FOREIGN KEY (review_id) REFERENCES reviews(id) ON DELETE CASCADE,
+    CONSTRAINT fk_review_votes_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT uniq_review_votes_review_user UNIQUE (review_id, user_id)
+);
+
+CREATE INDEX idx_review_votes_review_id ON review_votes(review_id);
+CREATE INDEX idx_reviews_helpfulness ON reviews((helpful_count - not_helpful_count)) WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS review_votes;
+
+ALTER TABLE reviews
+    DROP COLUMN IF EXISTS helpful_count,
+    DROP COLUMN IF EXISTS not_helpful_count;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE dumpsters ADD COLUMN unavailable_until TIMESTAMP;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE dumpsters DROP COLUMN IF EXISTS unavailable_until;
+-- +goose StatementEnd
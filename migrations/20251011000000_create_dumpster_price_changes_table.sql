@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE dumpster_price_changes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    dumpster_id UUID NOT NULL,
+    old_price DECIMAL(10,2) NOT NULL,
+    new_price DECIMAL(10,2) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_dumpster_price_changes_dumpster FOREIGN KEY (dumpster_id) REFERENCES dumpsters(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_dumpster_price_changes_dumpster_created_at ON dumpster_price_changes(dumpster_id, created_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS dumpster_price_changes;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE dumpster_tags (
+    dumpster_id UUID NOT NULL,
+    tag VARCHAR(50) NOT NULL,
+    PRIMARY KEY (dumpster_id, tag),
+    CONSTRAINT fk_dumpster_tags_dumpster FOREIGN KEY (dumpster_id) REFERENCES dumpsters(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_dumpster_tags_tag ON dumpster_tags(tag);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS dumpster_tags;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE dumpsters
+    ADD COLUMN auto_release BOOLEAN NOT NULL DEFAULT FALSE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE dumpsters
+    DROP COLUMN IF EXISTS auto_release;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE dumpsters
+    ADD COLUMN exclusive_use BOOLEAN NOT NULL DEFAULT FALSE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE dumpsters
+    DROP COLUMN IF EXISTS exclusive_use;
+-- +goose StatementEnd
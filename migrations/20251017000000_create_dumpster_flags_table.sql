@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE dumpsters
+    ADD COLUMN unpublished_at TIMESTAMP;
+
+CREATE TABLE dumpster_flags (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    dumpster_id UUID NOT NULL,
+    reporter_id UUID NOT NULL,
+    reason VARCHAR(500) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'open',
+    resolved_by UUID,
+    resolved_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_dumpster_flags_dumpster FOREIGN KEY (dumpster_id) REFERENCES dumpsters(id) ON DELETE CASCADE,
+    CONSTRAINT fk_dumpster_flags_reporter FOREIGN KEY (reporter_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_dumpster_flags_resolved_by FOREIGN KEY (resolved_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE UNIQUE INDEX uniq_dumpster_flags_open_reporter ON dumpster_flags(dumpster_id, reporter_id) WHERE status = 'open';
+CREATE INDEX idx_dumpster_flags_status ON dumpster_flags(status);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS dumpster_flags;
+
+ALTER TABLE dumpsters
+    DROP COLUMN IF EXISTS unpublished_at;
+-- +goose StatementEnd
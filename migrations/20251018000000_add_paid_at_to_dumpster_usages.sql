@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE dumpster_usages
+    ADD COLUMN paid_at TIMESTAMP;
+
+CREATE INDEX idx_dumpster_usages_unpaid ON dumpster_usages(user_id) WHERE status = 'completed' AND paid_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_dumpster_usages_unpaid;
+
+ALTER TABLE dumpster_usages
+    DROP COLUMN IF EXISTS paid_at;
+-- +goose StatementEnd
@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE bookings
+    ADD COLUMN paid_at TIMESTAMP;
+
+CREATE TABLE payments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL,
+    booking_id UUID,
+    usage_id UUID,
+    amount DECIMAL(10, 2) NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    idempotency_key VARCHAR(255) NOT NULL,
+    provider_reference VARCHAR(255),
+    failure_reason TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_payments_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_payments_booking FOREIGN KEY (booking_id) REFERENCES bookings(id) ON DELETE CASCADE,
+    CONSTRAINT fk_payments_usage FOREIGN KEY (usage_id) REFERENCES dumpster_usages(id) ON DELETE CASCADE,
+    CONSTRAINT chk_payments_target CHECK ((booking_id IS NULL) <> (usage_id IS NULL)),
+    CONSTRAINT chk_payments_status CHECK (status IN ('pending', 'paid', 'failed')),
+    CONSTRAINT uniq_payments_idempotency_key UNIQUE (idempotency_key)
+);
+
+CREATE UNIQUE INDEX uniq_payments_booking_active ON payments(booking_id) WHERE status IN ('pending', 'paid');
+CREATE UNIQUE INDEX uniq_payments_usage_active ON payments(usage_id) WHERE status IN ('pending', 'paid');
+CREATE INDEX idx_payments_user_id ON payments(user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS payments;
+
+ALTER TABLE bookings
+    DROP COLUMN IF EXISTS paid_at;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE pricing_rules (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    dumpster_id UUID NOT NULL,
+    label VARCHAR(100) NOT NULL DEFAULT '',
+    day_of_week SMALLINT,
+    start_date DATE,
+    end_date DATE,
+    multiplier DECIMAL(6, 3),
+    price_override DECIMAL(10, 2),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_pricing_rules_dumpster FOREIGN KEY (dumpster_id) REFERENCES dumpsters(id) ON DELETE CASCADE,
+    CONSTRAINT chk_pricing_rules_when CHECK (
+        (day_of_week IS NOT NULL AND start_date IS NULL AND end_date IS NULL)
+        OR (day_of_week IS NULL AND start_date IS NOT NULL AND end_date IS NOT NULL AND end_date >= start_date)
+    ),
+    CONSTRAINT chk_pricing_rules_day_of_week CHECK (day_of_week BETWEEN 0 AND 6),
+    CONSTRAINT chk_pricing_rules_price CHECK ((multiplier IS NULL) <> (price_override IS NULL))
+);
+
+CREATE INDEX idx_pricing_rules_dumpster_id ON pricing_rules(dumpster_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS pricing_rules;
+-- +goose StatementEnd
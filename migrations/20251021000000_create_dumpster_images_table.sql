@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE dumpster_images (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    dumpster_id UUID NOT NULL,
+    object_key VARCHAR(255) NOT NULL,
+    url TEXT NOT NULL,
+    position INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_dumpster_images_dumpster FOREIGN KEY (dumpster_id) REFERENCES dumpsters(id) ON DELETE CASCADE,
+    CONSTRAINT uniq_dumpster_images_object_key UNIQUE (object_key)
+);
+
+CREATE INDEX idx_dumpster_images_dumpster_id ON dumpster_images(dumpster_id, position);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS dumpster_images;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE dumpsters ADD COLUMN share_secret VARCHAR(64);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE dumpsters DROP COLUMN IF EXISTS share_secret;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE dumpsters ADD COLUMN is_featured BOOLEAN NOT NULL DEFAULT false;
+
+CREATE INDEX idx_dumpsters_featured ON dumpsters(rating DESC) WHERE is_featured AND deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_dumpsters_featured;
+ALTER TABLE dumpsters DROP COLUMN IF EXISTS is_featured;
+-- +goose StatementEnd
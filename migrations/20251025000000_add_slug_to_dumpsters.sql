@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE dumpsters ADD COLUMN slug VARCHAR(80);
+
+UPDATE dumpsters
+SET slug = COALESCE(NULLIF(TRIM(BOTH '-' FROM LEFT(REGEXP_REPLACE(LOWER(title), '[^a-z0-9]+', '-', 'g'), 60)), ''), 'dumpster')
+    || '-' || SUBSTRING(MD5(id::text) FROM 1 FOR 6);
+
+ALTER TABLE dumpsters ALTER COLUMN slug SET NOT NULL;
+
+CREATE UNIQUE INDEX idx_dumpsters_slug ON dumpsters(slug);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_dumpsters_slug;
+
+ALTER TABLE dumpsters DROP COLUMN IF EXISTS slug;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE bookings
+    ADD COLUMN ended_early_at TIMESTAMP;
+
+ALTER TABLE payments
+    ADD COLUMN refunded_amount DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN refund_reference VARCHAR(255),
+    ADD COLUMN refunded_at TIMESTAMP;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE payments
+    DROP COLUMN IF EXISTS refunded_at,
+    DROP COLUMN IF EXISTS refund_reference,
+    DROP COLUMN IF EXISTS refunded_amount;
+
+ALTER TABLE bookings
+    DROP COLUMN IF EXISTS ended_early_at;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE dumpster_revisions ADD COLUMN reverted_from INTEGER;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE dumpster_revisions DROP COLUMN IF EXISTS reverted_from;
+-- +goose StatementEnd
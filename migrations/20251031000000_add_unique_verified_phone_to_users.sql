@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Where several live accounts already share a verified number, only the
+-- oldest keeps the verification; the others have to verify again.
+UPDATE users SET is_phone_verified = false
+WHERE id IN (
+    SELECT id FROM (
+        SELECT id, ROW_NUMBER() OVER (PARTITION BY phone_number ORDER BY created_at, id) AS position
+        FROM users
+        WHERE is_phone_verified AND deleted_at IS NULL
+    ) verified
+    WHERE position > 1
+);
+
+CREATE UNIQUE INDEX uniq_users_verified_phone ON users(phone_number)
+WHERE is_phone_verified AND deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS uniq_users_verified_phone;
+-- +goose StatementEnd
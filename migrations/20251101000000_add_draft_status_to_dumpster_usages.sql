@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE dumpster_usages
+    DROP CONSTRAINT IF EXISTS chk_dumpster_usages_status,
+    ADD CONSTRAINT chk_dumpster_usages_status CHECK (status IN ('draft', 'active', 'completed', 'cancelled'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM dumpster_usages WHERE status = 'draft';
+
+ALTER TABLE dumpster_usages
+    DROP CONSTRAINT IF EXISTS chk_dumpster_usages_status,
+    ADD CONSTRAINT chk_dumpster_usages_status CHECK (status IN ('active', 'completed', 'cancelled'));
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE reviews
+    ADD COLUMN owner_reply TEXT,
+    ADD COLUMN owner_replied_at TIMESTAMP;
+
+CREATE INDEX idx_reviews_unanswered ON reviews(dumpster_id, created_at)
+WHERE owner_reply IS NULL AND deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_reviews_unanswered;
+
+ALTER TABLE reviews
+    DROP COLUMN IF EXISTS owner_replied_at,
+    DROP COLUMN IF EXISTS owner_reply;
+-- +goose StatementEnd
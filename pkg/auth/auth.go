@@ -7,18 +7,26 @@ import (
 )
 
 type Claims struct {
-	UserID uuid.UUID `json:"user_id"`
-	Email  string    `json:"email"`
+	UserID    uuid.UUID `json:"user_id"`
+	SessionID uuid.UUID `json:"session_id"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	ExpiresAt time.Time `json:"expires_at"`
 }
 
 type TokenPair struct {
-	AccessToken  string    `json:"access_token"`
-	RefreshToken string    `json:"refresh_token"`
-	ExpiresAt    time.Time `json:"expires_at"`
+	AccessToken      string    `json:"access_token"`
+	RefreshToken     string    `json:"refresh_token"`
+	ExpiresAt        time.Time `json:"expires_at"`
+	RefreshExpiresAt time.Time `json:"refresh_expires_at"`
 }
 
 type TokenService interface {
-	GenerateTokenPair(userID uuid.UUID, email string) (*TokenPair, error)
+	GenerateTokenPair(userID, sessionID uuid.UUID, email, role string) (*TokenPair, error)
+	// GenerateTokenPairWithRefreshTTL is GenerateTokenPair with the refresh
+	// token lifetime overridden; a non-positive ttl uses the default.
+	GenerateTokenPairWithRefreshTTL(userID, sessionID uuid.UUID, email, role string, refreshTTL time.Duration) (*TokenPair, error)
 	ValidateToken(token string) (*Claims, error)
+	ValidateRefreshToken(token string) (*Claims, error)
 	RefreshAccessToken(refreshToken string) (string, error)
 }
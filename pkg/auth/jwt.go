@@ -13,6 +13,9 @@ import (
 const (
 	defaultAccessTokenExpiry  = 15 * time.Minute
 	defaultRefreshTokenExpiry = 7 * 24 * time.Hour
+
+	accessTokenType  = "access"
+	refreshTokenType = "refresh"
 )
 
 type jwtService struct {
@@ -22,9 +25,11 @@ type jwtService struct {
 }
 
 type tokenClaims struct {
-	UserID uuid.UUID `json:"user_id"`
-	Email  string    `json:"email"`
-	Type   string    `json:"type"`
+	UserID    uuid.UUID `json:"user_id"`
+	SessionID uuid.UUID `json:"sid"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	Type      string    `json:"type"`
 	jwt.RegisteredClaims
 }
 
@@ -44,63 +49,62 @@ func NewJWTServiceWithTTL(secretKey string, accessTTL, refreshTTL time.Duration)
 	}
 }
 
-func (s *jwtService) GenerateTokenPair(userID uuid.UUID, email string) (*TokenPair, error) {
+func (s *jwtService) GenerateTokenPair(userID, sessionID uuid.UUID, email, role string) (*TokenPair, error) {
+	return s.GenerateTokenPairWithRefreshTTL(userID, sessionID, email, role, s.refreshTokenTTL)
+}
+
+func (s *jwtService) GenerateTokenPairWithRefreshTTL(
+	userID, sessionID uuid.UUID,
+	email, role string,
+	refreshTTL time.Duration) (*TokenPair, error) {
+	if refreshTTL <= 0 {
+		refreshTTL = s.refreshTokenTTL
+	}
+
 	now := time.Now()
 	accessExpiry := now.Add(s.accessTokenTTL)
-	refreshExpiry := now.Add(s.refreshTokenTTL)
+	refreshExpiry := now.Add(refreshTTL)
 
-	accessToken, err := s.generateToken(userID, email, "access", accessExpiry)
+	accessToken, err := s.generateToken(userID, sessionID, email, role, accessTokenType, accessExpiry)
 	if err != nil {
 		return nil, err
 	}
 
-	refreshToken, err := s.generateToken(userID, email, "refresh", refreshExpiry)
+	refreshToken, err := s.generateToken(userID, sessionID, email, role, refreshTokenType, refreshExpiry)
 	if err != nil {
 		return nil, err
 	}
 
 	return &TokenPair{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-		ExpiresAt:    accessExpiry,
+		AccessToken:      accessToken,
+		RefreshToken:     refreshToken,
+		ExpiresAt:        accessExpiry,
+		RefreshExpiresAt: refreshExpiry,
 	}, nil
 }
 
 func (s *jwtService) ValidateToken(token string) (*Claims, error) {
-	claims := &tokenClaims{}
+	return s.parseToken(token, accessTokenType)
+}
 
-	t, err := jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (any, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, apperrors.Unauthorized("invalid token")
-		}
-		return s.secretKey, nil
-	})
+func (s *jwtService) ValidateRefreshToken(token string) (*Claims, error) {
+	return s.parseToken(token, refreshTokenType)
+}
 
+func (s *jwtService) RefreshAccessToken(refreshToken string) (string, error) {
+	claims, err := s.parseToken(refreshToken, refreshTokenType)
 	if err != nil {
-		if errors.Is(err, jwt.ErrTokenExpired) {
-			return nil, apperrors.Unauthorized("token has expired")
-		}
-		return nil, apperrors.Unauthorized("invalid token")
+		return "", err
 	}
 
-	if !t.Valid {
-		return nil, apperrors.Unauthorized("invalid token")
-	}
-
-	if claims.Type != "access" {
-		return nil, apperrors.Unauthorized("invalid token")
-	}
-
-	return &Claims{
-		UserID: claims.UserID,
-		Email:  claims.Email,
-	}, nil
+	accessExpiry := time.Now().Add(s.accessTokenTTL)
+	return s.generateToken(claims.UserID, claims.SessionID, claims.Email, claims.Role, accessTokenType, accessExpiry)
 }
 
-func (s *jwtService) RefreshAccessToken(refreshToken string) (string, error) {
+func (s *jwtService) parseToken(token, tokenType string) (*Claims, error) {
 	claims := &tokenClaims{}
 
-	t, err := jwt.ParseWithClaims(refreshToken, claims, func(token *jwt.Token) (any, error) {
+	t, err := jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (any, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, apperrors.Unauthorized("invalid token")
 		}
@@ -109,24 +113,34 @@ func (s *jwtService) RefreshAccessToken(refreshToken string) (string, error) {
 
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
-			return "", apperrors.Unauthorized("token has expired")
+			return nil, apperrors.Unauthorized("token has expired")
 		}
-		return "", apperrors.Unauthorized("invalid token")
+		return nil, apperrors.Unauthorized("invalid token")
 	}
 
-	if !t.Valid || claims.Type != "refresh" {
-		return "", apperrors.Unauthorized("invalid token")
+	if !t.Valid || claims.Type != tokenType {
+		return nil, apperrors.Unauthorized("invalid token")
 	}
 
-	accessExpiry := time.Now().Add(s.accessTokenTTL)
-	return s.generateToken(claims.UserID, claims.Email, "access", accessExpiry)
+	return &Claims{
+		UserID:    claims.UserID,
+		SessionID: claims.SessionID,
+		Email:     claims.Email,
+		Role:      claims.Role,
+		ExpiresAt: claims.ExpiresAt.Time,
+	}, nil
 }
 
-func (s *jwtService) generateToken(userID uuid.UUID, email, tokenType string, expiresAt time.Time) (string, error) {
+func (s *jwtService) generateToken(
+	userID, sessionID uuid.UUID,
+	email, role, tokenType string,
+	expiresAt time.Time) (string, error) {
 	claims := tokenClaims{
-		UserID: userID,
-		Email:  email,
-		Type:   tokenType,
+		UserID:    userID,
+		SessionID: sessionID,
+		Email:     email,
+		Role:      role,
+		Type:      tokenType,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expiresAt),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -30,6 +30,12 @@ func NewPostgres(cfg Config) (*gorm.DB, error) {
 		cfg.Host, cfg.Port, cfg.User, cfg.Password, cfg.DBName, cfg.SSLMode,
 	)
 
+	return NewPostgresDSN(dsn)
+}
+
+// NewPostgresDSN connects with a full connection string, e.g. for a read
+// replica configured as a single DSN.
+func NewPostgresDSN(dsn string) (*gorm.DB, error) {
 	gormLogger := logger.New(
 		log.New(os.Stdout, "\r\n", log.LstdFlags),
 		logger.Config{
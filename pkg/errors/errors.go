@@ -17,6 +17,7 @@ const (
 	ErrorTypeForbidden     ErrorType = "FORBIDDEN"
 	ErrorTypeInternal      ErrorType = "INTERNAL"
 	ErrorTypeBadRequest    ErrorType = "BAD_REQUEST"
+	ErrorTypeLocked        ErrorType = "LOCKED"
 )
 
 // AppError represents an application error with additional context
@@ -54,6 +55,8 @@ func (e *AppError) HTTPStatus() int {
 		return http.StatusForbidden
 	case ErrorTypeBadRequest:
 		return http.StatusBadRequest
+	case ErrorTypeLocked:
+		return http.StatusLocked
 	case ErrorTypeInternal:
 		return http.StatusInternalServerError
 	default:
@@ -113,6 +116,11 @@ func BadRequest(message string) *AppError {
 	return New(ErrorTypeBadRequest, message)
 }
 
+// Locked creates a locked error
+func Locked(message string) *AppError {
+	return New(ErrorTypeLocked, message)
+}
+
 // Is checks if the error matches the given type
 func Is(err error, errType ErrorType) bool {
 	var appErr *AppError
@@ -138,4 +146,4 @@ func GetHTTPStatus(err error) int {
 		return appErr.HTTPStatus()
 	}
 	return http.StatusInternalServerError
-}
\ No newline at end of file
+}
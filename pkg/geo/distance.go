@@ -0,0 +1,51 @@
+package geo
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+)
+
+const earthRadiusKm = 6371.0
+
+var ErrNoRoute = errors.New("no route available")
+
+// Route is a driving route between two points as reported by a routing
+// provider.
+type Route struct {
+	DistanceKm float64
+	Duration   time.Duration
+}
+
+// RouteEstimator computes driving routes. Implementations return ErrNoRoute
+// when they cannot route between the two points.
+type RouteEstimator interface {
+	Route(ctx context.Context, from, to Coordinates) (*Route, error)
+}
+
+type straightLineEstimator struct{}
+
+// NewStraightLineEstimator returns a RouteEstimator that never routes, for
+// deployments without a routing provider; callers fall back to Distance.
+func NewStraightLineEstimator() RouteEstimator {
+	return straightLineEstimator{}
+}
+
+func (straightLineEstimator) Route(context.Context, Coordinates, Coordinates) (*Route, error) {
+	return nil, ErrNoRoute
+}
+
+// Distance returns the great-circle distance between two points in
+// kilometres using the haversine formula.
+func Distance(from, to Coordinates) float64 {
+	lat1 := from.Latitude * math.Pi / 180
+	lat2 := to.Latitude * math.Pi / 180
+	dLat := lat2 - lat1
+	dLng := (to.Longitude - from.Longitude) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
@@ -0,0 +1,112 @@
+package geo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrNotFound = errors.New("address not found")
+
+type Address struct {
+	Street  string
+	City    string
+	State   string
+	ZipCode string
+}
+
+type Coordinates struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Geocoder resolves a postal address into coordinates. Implementations return
+// ErrNotFound when the address cannot be resolved.
+type Geocoder interface {
+	Geocode(ctx context.Context, address Address) (*Coordinates, error)
+}
+
+type nopGeocoder struct{}
+
+// NewNopGeocoder returns a Geocoder that never resolves anything, for
+// deployments without a geocoding provider configured.
+func NewNopGeocoder() Geocoder {
+	return nopGeocoder{}
+}
+
+func (nopGeocoder) Geocode(context.Context, Address) (*Coordinates, error) {
+	return nil, ErrNotFound
+}
+
+type nominatimGeocoder struct {
+	baseURL   string
+	userAgent string
+	client    *http.Client
+}
+
+// NewNominatimGeocoder returns a Geocoder backed by a Nominatim-compatible
+// search API such as https://nominatim.openstreetmap.org.
+func NewNominatimGeocoder(baseURL, userAgent string, timeout time.Duration) Geocoder {
+	return &nominatimGeocoder{
+		baseURL:   strings.TrimRight(baseURL, "/"),
+		userAgent: userAgent,
+		client:    &http.Client{Timeout: timeout},
+	}
+}
+
+func (g *nominatimGeocoder) Geocode(ctx context.Context, address Address) (*Coordinates, error) {
+	params := url.Values{}
+	params.Set("format", "json")
+	params.Set("limit", "1")
+	params.Set("countrycodes", "us")
+	params.Set("street", address.Street)
+	params.Set("city", address.City)
+	params.Set("state", address.State)
+	params.Set("postalcode", address.ZipCode)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, g.baseURL+"/search?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", g.userAgent)
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("geocoder returned status %d", resp.StatusCode)
+	}
+
+	var results []struct {
+		Lat string `json:"lat"`
+		Lon string `json:"lon"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&results); err != nil {
+		return nil, err
+	}
+
+	if len(results) == 0 {
+		return nil, ErrNotFound
+	}
+
+	lat, err := strconv.ParseFloat(results[0].Lat, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	lng, err := strconv.ParseFloat(results[0].Lon, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Coordinates{Latitude: lat, Longitude: lng}, nil
+}
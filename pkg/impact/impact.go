@@ -0,0 +1,118 @@
+package impact
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	kgPerPound    = 0.45359237
+	kgPerShortTon = 907.18474
+	kgPerTonne    = 1000
+)
+
+// Estimate is the estimated impact of one or more completed usages, in
+// kilograms.
+type Estimate struct {
+	WasteDivertedKg float64
+	CO2eAvoidedKg   float64
+}
+
+// Estimator turns a dumpster's size and listed weight into the estimated
+// impact of one completed usage. A weight it can't read yields a zero
+// Estimate and false.
+type Estimator interface {
+	Estimate(size, weight string) (Estimate, bool)
+}
+
+type tableEstimator struct {
+	rates       map[string]float64
+	defaultRate float64
+	co2ePerKg   float64
+}
+
+// NewTableEstimator assumes a usage fills its dumpster to the listed weight.
+// rates gives, per dumpster size, the share of that weight diverted from
+// landfill; sizes not in the table use defaultRate. co2ePerKg is the CO2e
+// avoided per diverted kilogram.
+func NewTableEstimator(rates map[string]float64, defaultRate, co2ePerKg float64) Estimator {
+	normalized := make(map[string]float64, len(rates))
+	for size, rate := range rates {
+		normalized[strings.ToLower(strings.TrimSpace(size))] = rate
+	}
+
+	return &tableEstimator{
+		rates:       normalized,
+		defaultRate: defaultRate,
+		co2ePerKg:   co2ePerKg,
+	}
+}
+
+func (e *tableEstimator) Estimate(size, weight string) (Estimate, bool) {
+	kg, ok := ParseWeightKg(weight)
+	if !ok {
+		return Estimate{}, false
+	}
+
+	rate, ok := e.rates[strings.ToLower(strings.TrimSpace(size))]
+	if !ok {
+		rate = e.defaultRate
+	}
+
+	diverted := kg * rate
+	return Estimate{
+		WasteDivertedKg: diverted,
+		CO2eAvoidedKg:   diverted * e.co2ePerKg,
+	}, true
+}
+
+var weightPattern = regexp.MustCompile(`(?i)(\d[\d,]*(?:\.\d+)?)\s*(kilograms?|kgs?|tonnes?|tons?|t|pounds?|lbs?)\b`)
+
+// ParseWeightKg reads a free-form listing weight such as "2 tons",
+// "4,000 lbs" or "1.5t" as kilograms. "ton" is a US short ton and "t" or
+// "tonne" a metric tonne; a bare number is taken as kilograms. For ranges
+// like "2-3 tons" the last figure is used. It reports false for anything
+// without a positive weight.
+func ParseWeightKg(weight string) (float64, bool) {
+	weight = strings.TrimSpace(weight)
+	if weight == "" {
+		return 0, false
+	}
+
+	if kg, err := strconv.ParseFloat(strings.ReplaceAll(weight, ",", ""), 64); err == nil {
+		return kg, kg > 0 && !math.IsInf(kg, 0)
+	}
+
+	matches := weightPattern.FindAllStringSubmatch(weight, -1)
+	if len(matches) == 0 {
+		return 0, false
+	}
+	match := matches[len(matches)-1]
+
+	value, err := strconv.ParseFloat(strings.ReplaceAll(match[1], ",", ""), 64)
+	if err != nil || value <= 0 || math.IsInf(value, 0) {
+		return 0, false
+	}
+
+	unit := strings.ToLower(match[2])
+	switch {
+	case strings.HasPrefix(unit, "k"):
+		return value, true
+	case strings.HasPrefix(unit, "tonne"), unit == "t":
+		return value * kgPerTonne, true
+	case strings.HasPrefix(unit, "ton"):
+		return value * kgPerShortTon, true
+	default:
+		return value * kgPerPound, true
+	}
+}
+
+// Times scales the estimate to n usages.
+func (e Estimate) Times(n int64) Estimate {
+	return Estimate{
+		WasteDivertedKg: e.WasteDivertedKg * float64(n),
+		CO2eAvoidedKg:   e.CO2eAvoidedKg * float64(n),
+	}
+}
@@ -0,0 +1,89 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+)
+
+// numberFormat describes how a locale writes amounts: the grouping and
+// decimal separators and whether the currency symbol leads or trails.
+type numberFormat struct {
+	group       string
+	decimal     string
+	symbolFirst bool
+}
+
+var locales = map[string]numberFormat{
+	"en-US": {group: ",", decimal: ".", symbolFirst: true},
+	"en-GB": {group: ",", decimal: ".", symbolFirst: true},
+	"de-DE": {group: ".", decimal: ",", symbolFirst: false},
+	"fr-FR": {group: " ", decimal: ",", symbolFirst: false},
+	"es-ES": {group: ".", decimal: ",", symbolFirst: false},
+	"pl-PL": {group: " ", decimal: ",", symbolFirst: false},
+	"uk-UA": {group: " ", decimal: ",", symbolFirst: false},
+}
+
+var symbols = map[string]string{
+	"USD": "$",
+	"EUR": "€",
+	"GBP": "£",
+	"PLN": "zł",
+	"UAH": "₴",
+}
+
+var locale = "en-US"
+
+// SetLocale sets the locale used by Format. Only the locales listed in this
+// package are supported; like SetCurrency it is meant to be called at startup.
+func SetLocale(tag string) error {
+	tag = strings.TrimSpace(tag)
+	if _, ok := locales[tag]; !ok {
+		return fmt.Errorf("unsupported locale %q", tag)
+	}
+
+	locale = tag
+	return nil
+}
+
+// Locale returns the configured formatting locale.
+func Locale() string {
+	return locale
+}
+
+// Format renders the amount for display in the configured locale and
+// currency, e.g. "$1,234.50" or "1.234,50 €". Currencies without a known
+// symbol fall back to their ISO code.
+func Format(a Amount) string {
+	nf := locales[locale]
+
+	cents := int64(a)
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+
+	whole := fmt.Sprintf("%d", cents/centsPerUnit)
+	var grouped strings.Builder
+	for i, r := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			grouped.WriteString(nf.group)
+		}
+		grouped.WriteRune(r)
+	}
+
+	number := fmt.Sprintf("%s%s%02d", grouped.String(), nf.decimal, cents%centsPerUnit)
+
+	symbol, ok := symbols[currency]
+	if !ok {
+		symbol = currency
+	}
+
+	if nf.symbolFirst {
+		if !ok {
+			return sign + symbol + " " + number
+		}
+		return sign + symbol + number
+	}
+	return sign + number + " " + symbol
+}
@@ -0,0 +1,189 @@
+package money
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Amount is a monetary value held as an integer number of cents, so sums of
+// many small amounts never drift the way float64 dollars do. It marshals to
+// JSON as a dollar number (12.5 -> 12.50) and round-trips through NUMERIC
+// columns. Every amount is in the currency configured with SetCurrency.
+type Amount int64
+
+const centsPerUnit = 100
+
+var currency = "USD"
+
+// SetCurrency sets the ISO 4217 code reported alongside amounts. It is meant
+// to be called once at startup; amounts always carry two decimal places.
+func SetCurrency(code string) error {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if len(code) != 3 {
+		return fmt.Errorf("invalid currency code %q", code)
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return fmt.Errorf("invalid currency code %q", code)
+		}
+	}
+
+	currency = code
+	return nil
+}
+
+// Currency returns the configured ISO 4217 currency code.
+func Currency() string {
+	return currency
+}
+
+func FromCents(cents int64) Amount {
+	return Amount(cents)
+}
+
+// FromFloat converts a dollar value to an Amount, rounding to the nearest cent.
+func FromFloat(dollars float64) Amount {
+	return Amount(math.Round(dollars * centsPerUnit))
+}
+
+// Parse reads a decimal dollar string such as "12.5" or "-0.075" exactly,
+// rounding half away from zero past the second decimal place.
+func Parse(s string) (Amount, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, errors.New("empty amount")
+	}
+
+	if strings.ContainsAny(s, "eE") {
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid amount %q", s)
+		}
+		return FromFloat(f), nil
+	}
+
+	negative := false
+	switch s[0] {
+	case '-':
+		negative = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+
+	whole, frac, _ := strings.Cut(s, ".")
+	if whole == "" && frac == "" {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+	if whole == "" {
+		whole = "0"
+	}
+	if !isDigits(whole) || !isDigits(frac) {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+
+	units, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || units > math.MaxInt64/centsPerUnit-1 {
+		return 0, fmt.Errorf("amount %q out of range", s)
+	}
+
+	padded := frac + "00"
+	cents := units*centsPerUnit + int64(padded[0]-'0')*10 + int64(padded[1]-'0')
+	if len(frac) > 2 && frac[2] >= '5' {
+		cents++
+	}
+
+	if negative {
+		cents = -cents
+	}
+
+	return Amount(cents), nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func (a Amount) Cents() int64 {
+	return int64(a)
+}
+
+func (a Amount) Float64() float64 {
+	return float64(a) / centsPerUnit
+}
+
+// Mul scales the amount by factor, rounding once to the nearest cent. Use it
+// for prorating and percentage rates; add Amounts directly to sum them.
+func (a Amount) Mul(factor float64) Amount {
+	return Amount(math.Round(float64(a) * factor))
+}
+
+func (a Amount) String() string {
+	sign := ""
+	cents := int64(a)
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/centsPerUnit, cents%centsPerUnit)
+}
+
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+
+	parsed, err := Parse(strings.Trim(s, `"`))
+	if err != nil {
+		return err
+	}
+
+	*a = parsed
+	return nil
+}
+
+func (a Amount) Value() (driver.Value, error) {
+	return a.String(), nil
+}
+
+func (a *Amount) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*a = 0
+	case int64:
+		*a = Amount(v * centsPerUnit)
+	case float64:
+		*a = FromFloat(v)
+	case []byte:
+		return a.scanString(string(v))
+	case string:
+		return a.scanString(v)
+	default:
+		return fmt.Errorf("cannot scan %T into money.Amount", src)
+	}
+	return nil
+}
+
+func (a *Amount) scanString(s string) error {
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+
+	*a = parsed
+	return nil
+}
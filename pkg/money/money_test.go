@@ -0,0 +1,122 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAmountSumDoesNotDrift(t *testing.T) {
+	var total Amount
+	for range 100000 {
+		total += FromFloat(0.01)
+	}
+
+	if total != FromCents(100000) {
+		t.Errorf("sum = %s, want 1000.00", total)
+	}
+}
+
+func TestParseRounding(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Amount
+	}{
+		{in: "12.5", want: 1250},
+		{in: "12.344", want: 1234},
+		{in: "12.345", want: 1235},
+		{in: "12.349", want: 1235},
+		{in: "0.005", want: 1},
+		{in: "0.004", want: 0},
+		{in: "-12.344", want: -1234},
+		{in: "-12.345", want: -1235},
+		{in: "-0.075", want: -8},
+		{in: ".5", want: 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := Parse(tt.in)
+			if err != nil {
+				t.Fatalf("Parse(%q) error: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Errorf("Parse(%q) = %d cents, want %d", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRejectsInvalid(t *testing.T) {
+	for _, in := range []string{"", "abc", "1.2.3", "-", "1,50"} {
+		if _, err := Parse(in); err == nil {
+			t.Errorf("Parse(%q) succeeded, want an error", in)
+		}
+	}
+}
+
+func TestAmountString(t *testing.T) {
+	tests := []struct {
+		in   Amount
+		want string
+	}{
+		{in: 0, want: "0.00"},
+		{in: 5, want: "0.05"},
+		{in: 1250, want: "12.50"},
+		{in: -5, want: "-0.05"},
+		{in: -1250, want: "-12.50"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.in.String(); got != tt.want {
+			t.Errorf("Amount(%d).String() = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestAmountJSONRoundTrip(t *testing.T) {
+	type payload struct {
+		Price Amount  `json:"price"`
+		Fee   *Amount `json:"fee"`
+	}
+
+	fee := Amount(-75)
+	in := payload{Price: 123456, Fee: &fee}
+
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if want := `{"price":1234.56,"fee":-0.75}`; string(data) != want {
+		t.Errorf("Marshal = %s, want %s", data, want)
+	}
+
+	var out payload
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if out.Price != in.Price || out.Fee == nil || *out.Fee != fee {
+		t.Errorf("round trip = %+v, want %+v", out, in)
+	}
+}
+
+func TestAmountUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Amount
+	}{
+		{in: `12.5`, want: 1250},
+		{in: `"12.5"`, want: 1250},
+		{in: `0.125`, want: 13},
+		{in: `-3`, want: -300},
+	}
+
+	for _, tt := range tests {
+		var got Amount
+		if err := json.Unmarshal([]byte(tt.in), &got); err != nil {
+			t.Fatalf("Unmarshal(%s) error: %v", tt.in, err)
+		}
+		if got != tt.want {
+			t.Errorf("Unmarshal(%s) = %d cents, want %d", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package objectstore
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// Upload is a presigned request the client performs itself to put an object
+// straight into storage, sending Headers exactly as given.
+type Upload struct {
+	URL       string
+	Method    string
+	Headers   map[string]string
+	ExpiresAt time.Time
+}
+
+// Store hands out direct-upload URLs for objects and resolves where they are
+// served from. Swap the stub for an S3-compatible bucket, or a fake in tests,
+// by satisfying this interface.
+type Store interface {
+	PresignUpload(ctx context.Context, key, contentType string, ttl time.Duration) (*Upload, error)
+	Exists(ctx context.Context, key string) (bool, error)
+	URL(key string) string
+}
+
+type stubStore struct {
+	baseURL string
+}
+
+// NewStubStore returns a Store that presigns nothing and trusts every key to
+// exist, for development without a bucket. Objects resolve under baseURL.
+func NewStubStore(baseURL string) Store {
+	return &stubStore{baseURL: strings.TrimRight(baseURL, "/")}
+}
+
+func (s *stubStore) PresignUpload(_ context.Context, key, contentType string, ttl time.Duration) (*Upload, error) {
+	return &Upload{
+		URL:       s.URL(key),
+		Method:    "PUT",
+		Headers:   map[string]string{"Content-Type": contentType},
+		ExpiresAt: time.Now().Add(ttl),
+	}, nil
+}
+
+func (s *stubStore) Exists(context.Context, string) (bool, error) {
+	return true, nil
+}
+
+func (s *stubStore) URL(key string) string {
+	return s.baseURL + "/" + key
+}
@@ -0,0 +1,211 @@
+package objectstore
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	signingAlgorithm = "AWS4-HMAC-SHA256"
+	unsignedPayload  = "UNSIGNED-PAYLOAD"
+	existsTTL        = time.Minute
+)
+
+type S3Config struct {
+	// Endpoint is the bucket host's base URL, e.g. https://s3.us-east-1.amazonaws.com
+	// or a MinIO address. Buckets are addressed path-style.
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	// PublicURL is where uploaded objects are served from. Defaults to the
+	// bucket's path on Endpoint.
+	PublicURL string
+	Timeout   time.Duration
+}
+
+type s3Store struct {
+	cfg    S3Config
+	host   string
+	scheme string
+	client *http.Client
+}
+
+// NewS3Store returns a Store backed by an S3-compatible bucket. Requests are
+// signed with AWS Signature Version 4 query parameters, so no SDK is needed.
+func NewS3Store(cfg S3Config) (Store, error) {
+	endpoint, err := url.Parse(strings.TrimRight(cfg.Endpoint, "/"))
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid object storage endpoint %q", cfg.Endpoint)
+	}
+	if cfg.Bucket == "" {
+		return nil, fmt.Errorf("object storage bucket is required")
+	}
+
+	if cfg.PublicURL == "" {
+		cfg.PublicURL = endpoint.String() + "/" + cfg.Bucket
+	}
+	cfg.PublicURL = strings.TrimRight(cfg.PublicURL, "/")
+
+	return &s3Store{
+		cfg:    cfg,
+		host:   endpoint.Host,
+		scheme: endpoint.Scheme,
+		client: &http.Client{Timeout: cfg.Timeout},
+	}, nil
+}
+
+func (s *s3Store) PresignUpload(_ context.Context, key, contentType string, ttl time.Duration) (*Upload, error) {
+	now := time.Now().UTC()
+	headers := map[string]string{"Content-Type": contentType}
+
+	return &Upload{
+		URL:       s.presign(http.MethodPut, key, headers, ttl, now),
+		Method:    http.MethodPut,
+		Headers:   headers,
+		ExpiresAt: now.Add(ttl),
+	}, nil
+}
+
+func (s *s3Store) Exists(ctx context.Context, key string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, s.presign(http.MethodHead, key, nil, existsTTL, time.Now().UTC()), nil)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("object storage returned status %d", resp.StatusCode)
+	}
+}
+
+func (s *s3Store) URL(key string) string {
+	return s.cfg.PublicURL + "/" + encodePath(key)
+}
+
+// presign builds a SigV4 query-signed URL for method on key. Every header
+// passed is signed, so the client must send it unchanged.
+func (s *s3Store) presign(method, key string, headers map[string]string, ttl time.Duration, now time.Time) string {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	scope := date + "/" + s.cfg.Region + "/s3/aws4_request"
+	path := "/" + encodePath(s.cfg.Bucket) + "/" + encodePath(key)
+
+	signed := map[string]string{"host": s.host}
+	for name, value := range headers {
+		signed[strings.ToLower(name)] = strings.TrimSpace(value)
+	}
+
+	names := make([]string, 0, len(signed))
+	for name := range signed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + signed[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	query := map[string]string{
+		"X-Amz-Algorithm":     signingAlgorithm,
+		"X-Amz-Credential":    s.cfg.AccessKey + "/" + scope,
+		"X-Amz-Date":          amzDate,
+		"X-Amz-Expires":       strconv.Itoa(int(ttl.Seconds())),
+		"X-Amz-SignedHeaders": signedHeaders,
+	}
+	canonicalQuery := encodeQuery(query)
+
+	canonicalRequest := strings.Join([]string{
+		method,
+		path,
+		canonicalQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		unsignedPayload,
+	}, "\n")
+
+	stringToSign := strings.Join([]string{
+		signingAlgorithm,
+		amzDate,
+		scope,
+		hexSHA256(canonicalRequest),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.cfg.SecretKey), date)
+	signingKey = hmacSHA256(signingKey, s.cfg.Region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	return s.scheme + "://" + s.host + path + "?" + canonicalQuery + "&X-Amz-Signature=" + signature
+}
+
+func encodeQuery(params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		pairs[i] = uriEncode(key, true) + "=" + uriEncode(params[key], true)
+	}
+	return strings.Join(pairs, "&")
+}
+
+func encodePath(path string) string {
+	return uriEncode(path, false)
+}
+
+// uriEncode percent-encodes everything but RFC 3986 unreserved characters,
+// as SigV4 requires; slashes are kept in paths.
+func uriEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func hexSHA256(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
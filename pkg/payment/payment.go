@@ -0,0 +1,61 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"waste-space/pkg/money"
+)
+
+// ErrDeclined is returned by processors when the charge was refused, as
+// opposed to failing to reach the gateway.
+var ErrDeclined = errors.New("payment declined")
+
+type ChargeRequest struct {
+	Amount   money.Amount
+	Currency string
+	// IdempotencyKey is forwarded to the gateway so a retried charge with
+	// the same key is never captured twice.
+	IdempotencyKey string
+	Description    string
+}
+
+type ChargeResult struct {
+	Reference string
+}
+
+type RefundRequest struct {
+	// ChargeReference is the gateway reference of the charge being refunded.
+	ChargeReference string
+	Amount          money.Amount
+	Currency        string
+	IdempotencyKey  string
+	Description     string
+}
+
+type RefundResult struct {
+	Reference string
+}
+
+// Processor charges a payer through a payment gateway. Swap the stub for a
+// real gateway client, or a fake in tests, by satisfying this interface.
+type Processor interface {
+	Charge(ctx context.Context, req ChargeRequest) (*ChargeResult, error)
+	// Refund returns part or all of an earlier charge to the payer.
+	Refund(ctx context.Context, req RefundRequest) (*RefundResult, error)
+}
+
+type stubProcessor struct{}
+
+// NewStubProcessor returns a Processor that accepts every charge without
+// contacting a gateway, for development and deployments that settle offline.
+func NewStubProcessor() Processor {
+	return stubProcessor{}
+}
+
+func (stubProcessor) Charge(_ context.Context, req ChargeRequest) (*ChargeResult, error) {
+	return &ChargeResult{Reference: "stub_" + req.IdempotencyKey}, nil
+}
+
+func (stubProcessor) Refund(_ context.Context, req RefundRequest) (*RefundResult, error) {
+	return &RefundResult{Reference: "stub_" + req.IdempotencyKey}, nil
+}
@@ -0,0 +1,104 @@
+package seed
+
+import (
+	"time"
+	"waste-space/pkg/money"
+)
+
+// Password is shared by every seeded account so demo logins are easy to
+// remember. It is only ever loaded into non-production databases.
+const Password = "demo-password"
+
+type userSeed struct {
+	FirstName string
+	LastName  string
+	Email     string
+	Phone     string
+	Address   string
+	City      string
+	State     string
+	ZipCode   string
+}
+
+type dumpsterSeed struct {
+	OwnerEmail  string
+	Title       string
+	Description string
+	Address     string
+	City        string
+	State       string
+	ZipCode     string
+	Latitude    float64
+	Longitude   float64
+	PricePerDay money.Amount
+	Size        string
+	Tags        []string
+}
+
+// usageSeed is a finished rental. StartedAgo and Duration are relative to
+// the time the seed runs so the demo data always looks recent.
+type usageSeed struct {
+	UserEmail     string
+	DumpsterTitle string
+	StartedAgo    time.Duration
+	Duration      time.Duration
+}
+
+type reviewSeed struct {
+	UserEmail     string
+	DumpsterTitle string
+	Rating        int
+	Comment       string
+}
+
+var users = []userSeed{
+	{FirstName: "Olivia", LastName: "Owner", Email: "owner@demo.waste-space.dev", Phone: "+15125550100",
+		Address: "100 Congress Ave", City: "Austin", State: "TX", ZipCode: "78701"},
+	{FirstName: "Oscar", LastName: "Hauler", Email: "hauler@demo.waste-space.dev", Phone: "+15125550101",
+		Address: "2100 E 6th St", City: "Austin", State: "TX", ZipCode: "78702"},
+	{FirstName: "Rita", LastName: "Renter", Email: "renter@demo.waste-space.dev", Phone: "+15125550102",
+		Address: "1500 S Lamar Blvd", City: "Austin", State: "TX", ZipCode: "78704"},
+	{FirstName: "Ray", LastName: "Builder", Email: "builder@demo.waste-space.dev", Phone: "+15125550103",
+		Address: "4001 N Lamar Blvd", City: "Austin", State: "TX", ZipCode: "78756"},
+}
+
+var dumpsters = []dumpsterSeed{
+	{OwnerEmail: "owner@demo.waste-space.dev", Title: "Downtown 10-yard roll-off",
+		Description: "Compact roll-off that fits most driveways.", Address: "300 W 2nd St",
+		City: "Austin", State: "TX", ZipCode: "78701", Latitude: 30.2649, Longitude: -97.7472,
+		PricePerDay: money.FromCents(4500), Size: "small", Tags: []string{"residential", "driveway"}},
+	{OwnerEmail: "owner@demo.waste-space.dev", Title: "East Side 20-yard container",
+		Description: "Good for kitchen and bathroom remodels.", Address: "1800 E Cesar Chavez St",
+		City: "Austin", State: "TX", ZipCode: "78702", Latitude: 30.2583, Longitude: -97.7256,
+		PricePerDay: money.FromCents(6500), Size: "medium", Tags: []string{"renovation"}},
+	{OwnerEmail: "hauler@demo.waste-space.dev", Title: "South Congress 30-yard bin",
+		Description: "Large bin for construction debris.", Address: "1600 S Congress Ave",
+		City: "Austin", State: "TX", ZipCode: "78704", Latitude: 30.2480, Longitude: -97.7500,
+		PricePerDay: money.FromCents(8900), Size: "large", Tags: []string{"construction"}},
+	{OwnerEmail: "hauler@demo.waste-space.dev", Title: "North Loop 40-yard container",
+		Description: "Our biggest container for demolition jobs.", Address: "5300 N Lamar Blvd",
+		City: "Austin", State: "TX", ZipCode: "78751", Latitude: 30.3196, Longitude: -97.7277,
+		PricePerDay: money.FromCents(11900), Size: "extraLarge", Tags: []string{"construction", "demolition"}},
+}
+
+var usages = []usageSeed{
+	{UserEmail: "renter@demo.waste-space.dev", DumpsterTitle: "Downtown 10-yard roll-off",
+		StartedAgo: 40 * 24 * time.Hour, Duration: 3 * 24 * time.Hour},
+	{UserEmail: "renter@demo.waste-space.dev", DumpsterTitle: "East Side 20-yard container",
+		StartedAgo: 20 * 24 * time.Hour, Duration: 5 * 24 * time.Hour},
+	{UserEmail: "builder@demo.waste-space.dev", DumpsterTitle: "South Congress 30-yard bin",
+		StartedAgo: 30 * 24 * time.Hour, Duration: 7 * 24 * time.Hour},
+	{UserEmail: "builder@demo.waste-space.dev", DumpsterTitle: "North Loop 40-yard container",
+		StartedAgo: 10 * 24 * time.Hour, Duration: 4 * 24 * time.Hour},
+}
+
+var reviews = []reviewSeed{
+	{UserEmail: "renter@demo.waste-space.dev", DumpsterTitle: "Downtown 10-yard roll-off",
+		Rating: 5, Comment: "Dropped off on time and fit in the driveway with room to spare."},
+	{UserEmail: "renter@demo.waste-space.dev", DumpsterTitle: "East Side 20-yard container",
+		Rating: 4, Comment: "Plenty of space for our kitchen remodel. Pickup ran a day late."},
+	{UserEmail: "builder@demo.waste-space.dev", DumpsterTitle: "South Congress 30-yard bin",
+		Rating: 3, Comment: "Did the job, but the gate latch was stiff."},
+	{UserEmail: "builder@demo.waste-space.dev", DumpsterTitle: "North Loop 40-yard container",
+		Rating: 5, Comment: "Handled a full garage demolition without a second haul."},
+}
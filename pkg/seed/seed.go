@@ -0,0 +1,226 @@
+// Package seed loads a small demo dataset of users, dumpsters, usages and
+// reviews for local development and demos. Every record goes through the
+// regular services, so it passes the same validation and side effects as
+// data created over the API. Loading is idempotent: records that already
+// exist are looked up and reused rather than created again.
+package seed
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"waste-space/internal/dto"
+	"waste-space/internal/service"
+	"waste-space/internal/storage/repository"
+	apperrors "waste-space/pkg/errors"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Result counts the records the run created; anything already present is
+// not counted.
+type Result struct {
+	Users     int
+	Dumpsters int
+	Usages    int
+	Reviews   int
+}
+
+type Loader struct {
+	userService     service.UserService
+	dumpsterService service.DumpsterService
+	usageService    service.UsageService
+	reviewService   service.ReviewService
+	userRepo        repository.UserRepository
+	dumpsterRepo    repository.DumpsterRepository
+	logger          *zap.Logger
+}
+
+// NewLoader takes the services used to write seed data and the
+// repositories used to find what an earlier run already wrote.
+func NewLoader(
+	userService service.UserService,
+	dumpsterService service.DumpsterService,
+	usageService service.UsageService,
+	reviewService service.ReviewService,
+	userRepo repository.UserRepository,
+	dumpsterRepo repository.DumpsterRepository,
+	logger *zap.Logger) *Loader {
+	return &Loader{
+		userService:     userService,
+		dumpsterService: dumpsterService,
+		usageService:    usageService,
+		reviewService:   reviewService,
+		userRepo:        userRepo,
+		dumpsterRepo:    dumpsterRepo,
+		logger:          logger,
+	}
+}
+
+func (l *Loader) Load(ctx context.Context) (*Result, error) {
+	result := &Result{}
+
+	userIDs := make(map[string]string, len(users))
+	for _, u := range users {
+		id, created, err := l.ensureUser(ctx, u)
+		if err != nil {
+			return nil, fmt.Errorf("seed user %s: %w", u.Email, err)
+		}
+		userIDs[u.Email] = id
+		if created {
+			result.Users++
+		}
+	}
+
+	dumpsterIDs := make(map[string]string, len(dumpsters))
+	for _, d := range dumpsters {
+		id, created, err := l.ensureDumpster(ctx, userIDs[d.OwnerEmail], d)
+		if err != nil {
+			return nil, fmt.Errorf("seed dumpster %q: %w", d.Title, err)
+		}
+		dumpsterIDs[d.Title] = id
+		if created {
+			result.Dumpsters++
+		}
+	}
+
+	now := time.Now().UTC()
+	for _, u := range usages {
+		created, err := l.ensureUsage(ctx, userIDs[u.UserEmail], dumpsterIDs[u.DumpsterTitle], u, now)
+		if err != nil {
+			return nil, fmt.Errorf("seed usage of %q by %s: %w", u.DumpsterTitle, u.UserEmail, err)
+		}
+		if created {
+			result.Usages++
+		}
+	}
+
+	for _, r := range reviews {
+		created, err := l.ensureReview(ctx, userIDs[r.UserEmail], dumpsterIDs[r.DumpsterTitle], r)
+		if err != nil {
+			return nil, fmt.Errorf("seed review of %q by %s: %w", r.DumpsterTitle, r.UserEmail, err)
+		}
+		if created {
+			result.Reviews++
+		}
+	}
+
+	l.logger.Info("seed data loaded",
+		zap.Int("users", result.Users),
+		zap.Int("dumpsters", result.Dumpsters),
+		zap.Int("usages", result.Usages),
+		zap.Int("reviews", result.Reviews))
+
+	return result, nil
+}
+
+func (l *Loader) ensureUser(ctx context.Context, u userSeed) (string, bool, error) {
+	existing, err := l.userRepo.GetByEmail(ctx, u.Email)
+	if err == nil {
+		return existing.ID.String(), false, nil
+	}
+	if !apperrors.Is(err, apperrors.ErrorTypeNotFound) {
+		return "", false, err
+	}
+
+	user, err := l.userService.Register(ctx, dto.CreateUserRequest{
+		FirstName:   u.FirstName,
+		LastName:    u.LastName,
+		Email:       u.Email,
+		Password:    Password,
+		PhoneNumber: u.Phone,
+		DateOfBirth: time.Date(1985, time.June, 15, 0, 0, 0, 0, time.UTC),
+		Address:     u.Address,
+		City:        u.City,
+		State:       u.State,
+		ZipCode:     u.ZipCode,
+	})
+	if err != nil {
+		return "", false, err
+	}
+	return user.ID, true, nil
+}
+
+// ensureDumpster matches existing listings by owner and title.
+func (l *Loader) ensureDumpster(ctx context.Context, ownerID string, d dumpsterSeed) (string, bool, error) {
+	ownerUUID, err := uuid.Parse(ownerID)
+	if err != nil {
+		return "", false, err
+	}
+
+	owned, err := l.dumpsterRepo.ListByOwner(ctx, ownerUUID)
+	if err != nil {
+		return "", false, err
+	}
+	for _, existing := range owned {
+		if existing.Title == d.Title {
+			return existing.ID.String(), false, nil
+		}
+	}
+
+	dumpster, err := l.dumpsterService.Create(ctx, ownerID, dto.CreateDumpsterRequest{
+		Title:       d.Title,
+		Description: d.Description,
+		Location:    d.Address + ", " + d.City + ", " + d.State,
+		Latitude:    d.Latitude,
+		Longitude:   d.Longitude,
+		Address:     d.Address,
+		City:        d.City,
+		State:       d.State,
+		ZipCode:     d.ZipCode,
+		PricePerDay: d.PricePerDay,
+		Size:        d.Size,
+		Tags:        d.Tags,
+	})
+	if err != nil {
+		return "", false, err
+	}
+	return dumpster.ID, true, nil
+}
+
+// ensureUsage records a completed rental unless the user already has any
+// usage of the dumpster.
+func (l *Loader) ensureUsage(ctx context.Context, userID, dumpsterID string, u usageSeed, now time.Time) (bool, error) {
+	existing, err := l.usageService.GetMyUsagesForDumpster(ctx, userID, dumpsterID, dto.UsageListRequest{Page: 1, Limit: 1})
+	if err != nil {
+		return false, err
+	}
+	if existing.Meta.Total > 0 {
+		return false, nil
+	}
+
+	start := now.Add(-u.StartedAgo)
+	usage, err := l.usageService.StartUsage(ctx, userID, dumpsterID, dto.StartUsageRequest{
+		StartTime: start,
+		Notes:     "Seeded demo rental",
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := l.usageService.EndUsage(ctx, userID, usage.ID, dto.EndUsageRequest{
+		EndTime: start.Add(u.Duration),
+	}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (l *Loader) ensureReview(ctx context.Context, userID, dumpsterID string, r reviewSeed) (bool, error) {
+	_, err := l.reviewService.GetMine(ctx, userID, dumpsterID)
+	if err == nil {
+		return false, nil
+	}
+	if !apperrors.Is(err, apperrors.ErrorTypeNotFound) {
+		return false, err
+	}
+
+	if _, err := l.reviewService.Create(ctx, userID, dumpsterID, dto.CreateReviewRequest{
+		Rating:  r.Rating,
+		Comment: r.Comment,
+	}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,52 @@
+package tax
+
+import (
+	"context"
+	"strings"
+	"waste-space/pkg/money"
+)
+
+type Location struct {
+	State   string
+	ZipCode string
+}
+
+type Result struct {
+	Rate   float64
+	Amount money.Amount
+}
+
+// Calculator computes the tax owed on a subtotal for a location. Swap the
+// table implementation for an external tax service by satisfying this interface.
+type Calculator interface {
+	Calculate(ctx context.Context, location Location, subtotal money.Amount) (*Result, error)
+}
+
+type tableCalculator struct {
+	rates       map[string]float64
+	defaultRate float64
+}
+
+func NewTableCalculator(rates map[string]float64, defaultRate float64) Calculator {
+	normalized := make(map[string]float64, len(rates))
+	for state, rate := range rates {
+		normalized[strings.ToUpper(strings.TrimSpace(state))] = rate
+	}
+
+	return &tableCalculator{
+		rates:       normalized,
+		defaultRate: defaultRate,
+	}
+}
+
+func (c *tableCalculator) Calculate(_ context.Context, location Location, subtotal money.Amount) (*Result, error) {
+	rate, ok := c.rates[strings.ToUpper(strings.TrimSpace(location.State))]
+	if !ok {
+		rate = c.defaultRate
+	}
+
+	return &Result{
+		Rate:   rate,
+		Amount: subtotal.Mul(rate),
+	}, nil
+}